@@ -31,8 +31,8 @@ var (
 
 // GridironERC20MetaData contains all meta data concerning the GridironERC20 contract.
 var GridironERC20MetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_denom\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"denom\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
-	Bin: "0x60c06040523480156200001157600080fd5b50604051620026e3380380620026e3833981810160405281019062000037919062000292565b80600090816200004891906200052e565b504660808181525050620000616200006f60201b60201c565b60a0818152505050620007ab565b60007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f6000604051620000a39190620006c4565b60405180910390207fc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc64630604051602001620000e49594939291906200074e565b60405160208183030381529060405280519060200120905090565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b62000168826200011d565b810181811067ffffffffffffffff821117156200018a57620001896200012e565b5b80604052505050565b60006200019f620000ff565b9050620001ad82826200015d565b919050565b600067ffffffffffffffff821115620001d057620001cf6200012e565b5b620001db826200011d565b9050602081019050919050565b60005b8381101562000208578082015181840152602081019050620001eb565b60008484015250505050565b60006200022b6200022584620001b2565b62000193565b9050828152602081018484840111156200024a576200024962000118565b5b62000257848285620001e8565b509392505050565b600082601f83011262000277576200027662000113565b5b81516200028984826020860162000214565b91505092915050565b600060208284031215620002ab57620002aa62000109565b5b600082015167ffffffffffffffff811115620002cc57620002cb6200010e565b5b620002da848285016200025f565b91505092915050565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200033657607f821691505b6020821081036200034c576200034b620002ee565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620003b67fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000377565b620003c2868362000377565b95508019841693508086168417925050509392505050565b6000819050919050565b6000819050919050565b60006200040f620004096200040384620003da565b620003e4565b620003da565b9050919050565b6000819050919050565b6200042b83620003ee565b620004436200043a8262000416565b84845462000384565b825550505050565b600090565b6200045a6200044b565b6200046781848462000420565b505050565b5b818110156200048f576200048360008262000450565b6001810190506200046d565b5050565b601f821115620004de57620004a88162000352565b620004b38462000367565b81016020851015620004c3578190505b620004db620004d28562000367565b8301826200046c565b50505b505050565b600082821c905092915050565b60006200050360001984600802620004e3565b1980831691505092915050565b60006200051e8383620004f0565b9150826002028217905092915050565b6200053982620002e3565b67ffffffffffffffff8111156200055557620005546200012e565b5b6200056182546200031d565b6200056e82828562000493565b600060209050601f831160018114620005a6576000841562000591578287015190505b6200059d858262000510565b8655506200060d565b601f198416620005b68662000352565b60005b82811015620005e057848901518255600182019150602085019450602081019050620005b9565b86831015620006005784890151620005fc601f891682620004f0565b8355505b6001600288020188555050505b505050505050565b600081905092915050565b60008190508160005260206000209050919050565b6000815462000644816200031d565b62000650818662000615565b945060018216600081146200066e57600181146200068457620006bb565b60ff1983168652811515820286019350620006bb565b6200068f8562000620565b60005b83811015620006b35781548189015260018201915060208101905062000692565b838801955050505b50505092915050565b6000620006d2828462000635565b915081905092915050565b6000819050919050565b620006f281620006dd565b82525050565b6200070381620003da565b82525050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000620007368262000709565b9050919050565b620007488162000729565b82525050565b600060a082019050620007656000830188620006e7565b620007746020830187620006e7565b620007836040830186620006e7565b620007926060830185620006f8565b620007a160808301846200073d565b9695505050505050565b60805160a051611f12620007d1600039600061076a015260006107360152611f126000f3fe608060405234801561001057600080fd5b50600436106100cf5760003560e01c806370a082311161008c578063a9059cbb11610066578063a9059cbb1461022a578063c370b0421461025a578063d505accf14610278578063dd62ed3e14610294576100cf565b806370a08231146101ac5780637ecebe00146101dc57806395d89b411461020c576100cf565b806306fdde03146100d4578063095ea7b3146100f257806318160ddd1461012257806323b872dd14610140578063313ce567146101705780633644e5151461018e575b600080fd5b6100dc6102c4565b6040516100e991906110fc565b60405180910390f35b61010c600480360381019061010791906111b7565b610356565b6040516101199190611212565b60405180910390f35b61012a610498565b604051610137919061123c565b60405180910390f35b61015a60048036038101906101559190611257565b610521565b6040516101679190611212565b60405180910390f35b610178610729565b60405161018591906112c6565b60405180910390f35b610196610732565b6040516101a391906112fa565b60405180910390f35b6101c660048036038101906101c19190611315565b61078f565b6040516101d3919061123c565b60405180910390f35b6101f660048036038101906101f19190611315565b61081c565b604051610203919061123c565b60405180910390f35b610214610834565b60405161022191906110fc565b60405180910390f35b610244600480360381019061023f91906111b7565b6108c6565b6040516102519190611212565b60405180910390f35b610262610a05565b60405161026f91906110fc565b60405180910390f35b610292600480360381019061028d919061139a565b610a93565b005b6102ae60048036038101906102a9919061143c565b610ddc565b6040516102bb919061123c565b60405180910390f35b6060600080546102d3906114ab565b80601f01602080910402602001604051908101604052809291908181526020018280546102ff906114ab565b801561034c5780601f106103215761010080835404028352916020019161034c565b820191906000526020600020905b81548152906001019060200180831161032f57829003601f168201915b5050505050905090565b6000610360610e6c565b73ffffffffffffffffffffffffffffffffffffffff16632b6b7ab5338561038686610e88565b60006040518563ffffffff1660e01b81526004016103a79493929190611688565b6020604051808303816000875af11580156103c6573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906103ea9190611700565b610429576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016104209061179f565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92584604051610486919061123c565b60405180910390a36001905092915050565b60006104a2610faa565b73ffffffffffffffffffffffffffffffffffffffff1663fe3b2b8860006040518263ffffffff1660e01b81526004016104db9190611858565b602060405180830381865afa1580156104f8573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061051c919061188f565b905090565b600061052b610e6c565b73ffffffffffffffffffffffffffffffffffffffff1663fbdb0e87853360006040518463ffffffff1660e01b8152600401610568939291906118bc565b602060405180830381865afa158015610585573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105a9919061188f565b8211156105eb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105e29061196c565b60405180910390fd5b6105f3610faa565b73ffffffffffffffffffffffffffffffffffffffff166384404811858561061986610e88565b6040518463ffffffff1660e01b81526004016106379392919061198c565b6020604051808303816000875af1158015610656573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061067a9190611700565b6106b9576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106b090611a3c565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051610716919061123c565b60405180910390a3600190509392505050565b60006012905090565b60007f0000000000000000000000000000000000000000000000000000000000000000461461076857610763610fc6565b61078a565b7f00000000000000000000000000000000000000000000000000000000000000005b905090565b6000610799610faa565b73ffffffffffffffffffffffffffffffffffffffff166334d1fdaf8360006040518363ffffffff1660e01b81526004016107d4929190611a5c565b602060405180830381865afa1580156107f1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610815919061188f565b9050919050565b60016020528060005260406000206000915090505481565b606060008054610843906114ab565b80601f016020809104026020016040519081016040528092919081815260200182805461086f906114ab565b80156108bc5780601f10610891576101008083540402835291602001916108bc565b820191906000526020600020905b81548152906001019060200180831161089f57829003601f168201915b5050505050905090565b60006108d0610faa565b73ffffffffffffffffffffffffffffffffffffffff16638440481133856108f686610e88565b6040518463ffffffff1660e01b81526004016109149392919061198c565b6020604051808303816000875af1158015610933573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109579190611700565b610996576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161098d90611afe565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516109f3919061123c565b60405180910390a36001905092915050565b60008054610a12906114ab565b80601f0160208091040260200160405190810160405280929190818152602001828054610a3e906114ab565b8015610a8b5780601f10610a6057610100808354040283529160200191610a8b565b820191906000526020600020905b815481529060010190602001808311610a6e57829003601f168201915b505050505081565b42841015610ad6576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610acd90611b90565b60405180910390fd5b60006001610ae2610732565b7f6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c98a8a8a600160008f73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000815480929190600101919050558b604051602001610b6a96959493929190611bb0565b60405160208183030381529060405280519060200120604051602001610b91929190611c89565b6040516020818303038152906040528051906020012085858560405160008152602001604052604051610bc79493929190611cc0565b6020604051602081039080840390855afa158015610be9573d6000803e3d6000fd5b505050602060405103519050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1614158015610c5d57508773ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16145b610c9c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c9390611d51565b60405180910390fd5b610ca4610e6c565b73ffffffffffffffffffffffffffffffffffffffff16632b6b7ab58289610cca8a610e88565b60006040518563ffffffff1660e01b8152600401610ceb9493929190611688565b6020604051808303816000875af1158015610d0a573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d2e9190611700565b610d6d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d649061179f565b60405180910390fd5b508573ffffffffffffffffffffffffffffffffffffffff168773ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92587604051610dcb919061123c565b60405180910390a350505050505050565b6000610de6610e6c565b73ffffffffffffffffffffffffffffffffffffffff1663fbdb0e87848460006040518463ffffffff1660e01b8152600401610e23939291906118bc565b602060405180830381865afa158015610e40573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e64919061188f565b905092915050565b600073bdf49c3c3882102fc017ffb661108c63a836d065905090565b60606000600167ffffffffffffffff811115610ea757610ea6611d71565b5b604051908082528060200260200182016040528015610ee057816020015b610ecd611052565b815260200190600190039081610ec55790505b509050604051806040016040528084815260200160008054610f01906114ab565b80601f0160208091040260200160405190810160405280929190818152602001828054610f2d906114ab565b8015610f7a5780601f10610f4f57610100808354040283529160200191610f7a565b820191906000526020600020905b815481529060010190602001808311610f5d57829003601f168201915b505050505081525081600081518110610f9657610f95611da0565b5b602002602001018190525080915050919050565b6000734381dc2ab14285160c808659aee005d51255add7905090565b60007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f6000604051610ff89190611e72565b60405180910390207fc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc64630604051602001611037959493929190611e89565b60405160208183030381529060405280519060200120905090565b604051806040016040528060008152602001606081525090565b600081519050919050565b600082825260208201905092915050565b60005b838110156110a657808201518184015260208101905061108b565b60008484015250505050565b6000601f19601f8301169050919050565b60006110ce8261106c565b6110d88185611077565b93506110e8818560208601611088565b6110f1816110b2565b840191505092915050565b6000602082019050818103600083015261111681846110c3565b905092915050565b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b600061114e82611123565b9050919050565b61115e81611143565b811461116957600080fd5b50565b60008135905061117b81611155565b92915050565b6000819050919050565b61119481611181565b811461119f57600080fd5b50565b6000813590506111b18161118b565b92915050565b600080604083850312156111ce576111cd61111e565b5b60006111dc8582860161116c565b92505060206111ed858286016111a2565b9150509250929050565b60008115159050919050565b61120c816111f7565b82525050565b60006020820190506112276000830184611203565b92915050565b61123681611181565b82525050565b6000602082019050611251600083018461122d565b92915050565b6000806000606084860312156112705761126f61111e565b5b600061127e8682870161116c565b935050602061128f8682870161116c565b92505060406112a0868287016111a2565b9150509250925092565b600060ff82169050919050565b6112c0816112aa565b82525050565b60006020820190506112db60008301846112b7565b92915050565b6000819050919050565b6112f4816112e1565b82525050565b600060208201905061130f60008301846112eb565b92915050565b60006020828403121561132b5761132a61111e565b5b60006113398482850161116c565b91505092915050565b61134b816112aa565b811461135657600080fd5b50565b60008135905061136881611342565b92915050565b611377816112e1565b811461138257600080fd5b50565b6000813590506113948161136e565b92915050565b600080600080600080600060e0888a0312156113b9576113b861111e565b5b60006113c78a828b0161116c565b97505060206113d88a828b0161116c565b96505060406113e98a828b016111a2565b95505060606113fa8a828b016111a2565b945050608061140b8a828b01611359565b93505060a061141c8a828b01611385565b92505060c061142d8a828b01611385565b91505092959891949750929550565b600080604083850312156114535761145261111e565b5b60006114618582860161116c565b92505060206114728582860161116c565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806114c357607f821691505b6020821081036114d6576114d561147c565b5b50919050565b6114e581611143565b82525050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b61152081611181565b82525050565b600082825260208201905092915050565b60006115428261106c565b61154c8185611526565b935061155c818560208601611088565b611565816110b2565b840191505092915050565b60006040830160008301516115886000860182611517565b50602083015184820360208601526115a08282611537565b9150508091505092915050565b60006115b98383611570565b905092915050565b6000602082019050919050565b60006115d9826114eb565b6115e381856114f6565b9350836020820285016115f585611507565b8060005b85811015611631578484038952815161161285826115ad565b945061161d836115c1565b925060208a019950506001810190506115f9565b50829750879550505050505092915050565b6000819050919050565b6000819050919050565b600061167261166d61166884611643565b61164d565b611181565b9050919050565b61168281611657565b82525050565b600060808201905061169d60008301876114dc565b6116aa60208301866114dc565b81810360408301526116bc81856115ce565b90506116cb6060830184611679565b95945050505050565b6116dd816111f7565b81146116e857600080fd5b50565b6000815190506116fa816116d4565b92915050565b6000602082840312156117165761171561111e565b5b6000611724848285016116eb565b91505092915050565b7f506f6c6172697345524332303a206661696c656420746f20617070726f76652060008201527f7370656e64000000000000000000000000000000000000000000000000000000602082015250565b6000611789602583611077565b91506117948261172d565b604082019050919050565b600060208201905081810360008301526117b88161177c565b9050919050565b60008190508160005260206000209050919050565b600081546117e1816114ab565b6117eb8186611077565b94506001821660008114611806576001811461181c5761184f565b60ff19831686528115156020028601935061184f565b611825856117bf565b60005b8381101561184757815481890152600182019150602081019050611828565b808801955050505b50505092915050565b6000602082019050818103600083015261187281846117d4565b905092915050565b6000815190506118898161118b565b92915050565b6000602082840312156118a5576118a461111e565b5b60006118b38482850161187a565b91505092915050565b60006060820190506118d160008301866114dc565b6118de60208301856114dc565b81810360408301526118f081846117d4565b9050949350505050565b7f506f6c6172697345524332303a20696e73756666696369656e7420617070726f60008201527f76616c0000000000000000000000000000000000000000000000000000000000602082015250565b6000611956602383611077565b9150611961826118fa565b604082019050919050565b6000602082019050818103600083015261198581611949565b9050919050565b60006060820190506119a160008301866114dc565b6119ae60208301856114dc565b81810360408301526119c081846115ce565b9050949350505050565b7f506f6c6172697345524332303a206661696c656420746f2073656e642062616e60008201527f6b20746f6b656e73000000000000000000000000000000000000000000000000602082015250565b6000611a26602883611077565b9150611a31826119ca565b604082019050919050565b60006020820190508181036000830152611a5581611a19565b9050919050565b6000604082019050611a7160008301856114dc565b8181036020830152611a8381846117d4565b90509392505050565b7f506f6c6172697345524332303a206661696c656420746f2073656e6420746f6b60008201527f656e730000000000000000000000000000000000000000000000000000000000602082015250565b6000611ae8602383611077565b9150611af382611a8c565b604082019050919050565b60006020820190508181036000830152611b1781611adb565b9050919050565b7f506f6c6172697345524332303a205045524d49545f444541444c494e455f455860008201527f5049524544000000000000000000000000000000000000000000000000000000602082015250565b6000611b7a602583611077565b9150611b8582611b1e565b604082019050919050565b60006020820190508181036000830152611ba981611b6d565b9050919050565b600060c082019050611bc560008301896112eb565b611bd260208301886114dc565b611bdf60408301876114dc565b611bec606083018661122d565b611bf9608083018561122d565b611c0660a083018461122d565b979650505050505050565b600081905092915050565b7f1901000000000000000000000000000000000000000000000000000000000000600082015250565b6000611c52600283611c11565b9150611c5d82611c1c565b600282019050919050565b6000819050919050565b611c83611c7e826112e1565b611c68565b82525050565b6000611c9482611c45565b9150611ca08285611c72565b602082019150611cb08284611c72565b6020820191508190509392505050565b6000608082019050611cd560008301876112eb565b611ce260208301866112b7565b611cef60408301856112eb565b611cfc60608301846112eb565b95945050505050565b7f506f6c6172697345524332303a20494e56414c49445f5349474e455200000000600082015250565b6000611d3b601c83611077565b9150611d4682611d05565b602082019050919050565b60006020820190508181036000830152611d6a81611d2e565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600081905092915050565b60008190508160005260206000209050919050565b60008154611dfc816114ab565b611e068186611dcf565b94506001821660008114611e215760018114611e3657611e69565b60ff1983168652811515820286019350611e69565b611e3f85611dda565b60005b83811015611e6157815481890152600182019150602081019050611e42565b838801955050505b50505092915050565b6000611e7e8284611def565b915081905092915050565b600060a082019050611e9e60008301886112eb565b611eab60208301876112eb565b611eb860408301866112eb565b611ec5606083018561122d565b611ed260808301846114dc565b969550505050505056fea264697066735822122020dfcce5b13876f5d8e9ab09537141735409d2830eed167304868cf12785b40964736f6c63430008130033",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_denom\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"authorizer\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"bytes32\",\"name\":\"nonce\",\"type\":\"bytes32\",\"indexed\":true}],\"name\":\"AuthorizationCanceled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"authorizer\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"bytes32\",\"name\":\"nonce\",\"type\":\"bytes32\",\"indexed\":true}],\"name\":\"AuthorizationUsed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"CANCEL_AUTHORIZATION_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RECEIVE_WITH_AUTHORIZATION_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFER_WITH_AUTHORIZATION_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"authorizationState\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"authorizer\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"nonce\",\"type\":\"bytes32\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"cancelAuthorization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"denom\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validAfter\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validBefore\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"nonce\",\"type\":\"bytes32\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"receiveWithAuthorization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validAfter\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validBefore\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"nonce\",\"type\":\"bytes32\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"transferWithAuthorization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60e06040523480156200001157600080fd5b50604051620035fa380380620035fa833981810160405281019062000037919062000305565b83600090816200004891906200061f565b5082600190816200005a91906200061f565b5081600290816200006c91906200061f565b508060ff1660808160ff16815250504660a0818152505062000093620000a460201b60201c565b60c08181525050505050506200089c565b60007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f6001604051620000d89190620007b5565b60405180910390207fc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc64630604051602001620001199594939291906200083f565b60405160208183030381529060405280519060200120905090565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6200019d8262000152565b810181811067ffffffffffffffff82111715620001bf57620001be62000163565b5b80604052505050565b6000620001d462000134565b9050620001e2828262000192565b919050565b600067ffffffffffffffff82111562000205576200020462000163565b5b620002108262000152565b9050602081019050919050565b60005b838110156200023d57808201518184015260208101905062000220565b60008484015250505050565b6000620002606200025a84620001e7565b620001c8565b9050828152602081018484840111156200027f576200027e6200014d565b5b6200028c8482856200021d565b509392505050565b600082601f830112620002ac57620002ab62000148565b5b8151620002be84826020860162000249565b91505092915050565b600060ff82169050919050565b620002df81620002c7565b8114620002eb57600080fd5b50565b600081519050620002ff81620002d4565b92915050565b600080600080608085870312156200032257620003216200013e565b5b600085015167ffffffffffffffff81111562000343576200034262000143565b5b620003518782880162000294565b945050602085015167ffffffffffffffff81111562000375576200037462000143565b5b620003838782880162000294565b935050604085015167ffffffffffffffff811115620003a757620003a662000143565b5b620003b58782880162000294565b9250506060620003c887828801620002ee565b91505092959194509250565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200042757607f821691505b6020821081036200043d576200043c620003df565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620004a77fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000468565b620004b3868362000468565b95508019841693508086168417925050509392505050565b6000819050919050565b6000819050919050565b600062000500620004fa620004f484620004cb565b620004d5565b620004cb565b9050919050565b6000819050919050565b6200051c83620004df565b620005346200052b8262000507565b84845462000475565b825550505050565b600090565b6200054b6200053c565b6200055881848462000511565b505050565b5b8181101562000580576200057460008262000541565b6001810190506200055e565b5050565b601f821115620005cf57620005998162000443565b620005a48462000458565b81016020851015620005b4578190505b620005cc620005c38562000458565b8301826200055d565b50505b505050565b600082821c905092915050565b6000620005f460001984600802620005d4565b1980831691505092915050565b60006200060f8383620005e1565b9150826002028217905092915050565b6200062a82620003d4565b67ffffffffffffffff81111562000646576200064562000163565b5b6200065282546200040e565b6200065f82828562000584565b600060209050601f83116001811462000697576000841562000682578287015190505b6200068e858262000601565b865550620006fe565b601f198416620006a78662000443565b60005b82811015620006d157848901518255600182019150602085019450602081019050620006aa565b86831015620006f15784890151620006ed601f891682620005e1565b8355505b6001600288020188555050505b505050505050565b600081905092915050565b60008190508160005260206000209050919050565b6000815462000735816200040e565b62000741818662000706565b945060018216600081146200075f57600181146200077557620007ac565b60ff1983168652811515820286019350620007ac565b620007808562000711565b60005b83811015620007a45781548189015260018201915060208101905062000783565b838801955050505b50505092915050565b6000620007c3828462000726565b915081905092915050565b6000819050919050565b620007e381620007ce565b82525050565b620007f481620004cb565b82525050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006200082782620007fa565b9050919050565b62000839816200081a565b82525050565b600060a082019050620008566000830188620007d8565b620008656020830187620007d8565b620008746040830186620007d8565b620008836060830185620007e9565b6200089260808301846200082e565b9695505050505050565b60805160a05160c051612d2e620008cc60003960006108c401526000610890015260006108680152612d2e6000f3fe608060405234801561001057600080fd5b506004361061012c5760003560e01c806395d89b41116100ad578063d916948711610071578063d916948714610349578063dd62ed3e14610367578063e3ee160e14610397578063e94a0102146103b3578063ef55bec6146103e35761012c565b806395d89b41146102a3578063a0cc6a68146102c1578063a9059cbb146102df578063c370b0421461030f578063d505accf1461032d5761012c565b80633644e515116100f45780633644e515146101eb5780635a049a701461020957806370a08231146102255780637ecebe00146102555780637f2eecc3146102855761012c565b806306fdde0314610131578063095ea7b31461014f57806318160ddd1461017f57806323b872dd1461019d578063313ce567146101cd575b600080fd5b6101396103ff565b6040516101469190611aa5565b60405180910390f35b61016960048036038101906101649190611b60565b610491565b6040516101769190611bbb565b60405180910390f35b6101876105d3565b6040516101949190611be5565b60405180910390f35b6101b760048036038101906101b29190611c00565b61065c565b6040516101c49190611bbb565b60405180910390f35b6101d5610864565b6040516101e29190611c6f565b60405180910390f35b6101f361088c565b6040516102009190611ca3565b60405180910390f35b610223600480360381019061021e9190611d16565b6108e9565b005b61023f600480360381019061023a9190611d91565b610afe565b60405161024c9190611be5565b60405180910390f35b61026f600480360381019061026a9190611d91565b610b8b565b60405161027c9190611be5565b60405180910390f35b61028d610ba3565b60405161029a9190611ca3565b60405180910390f35b6102ab610bc7565b6040516102b89190611aa5565b60405180910390f35b6102c9610c59565b6040516102d69190611ca3565b60405180910390f35b6102f960048036038101906102f49190611b60565b610c7d565b6040516103069190611bbb565b60405180910390f35b610317610dbc565b6040516103249190611aa5565b60405180910390f35b61034760048036038101906103429190611dbe565b610e4a565b005b610351611193565b60405161035e9190611ca3565b60405180910390f35b610381600480360381019061037c9190611e60565b6111b7565b60405161038e9190611be5565b60405180910390f35b6103b160048036038101906103ac9190611ea0565b611247565b005b6103cd60048036038101906103c89190611f6a565b611284565b6040516103da9190611bbb565b60405180910390f35b6103fd60048036038101906103f89190611ea0565b6112b3565b005b60606001805461040e90611fd9565b80601f016020809104026020016040519081016040528092919081815260200182805461043a90611fd9565b80156104875780601f1061045c57610100808354040283529160200191610487565b820191906000526020600020905b81548152906001019060200180831161046a57829003601f168201915b5050505050905090565b600061049b61135e565b73ffffffffffffffffffffffffffffffffffffffff16632b6b7ab533856104c18661137a565b60006040518563ffffffff1660e01b81526004016104e294939291906121b6565b6020604051808303816000875af1158015610501573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610525919061222e565b610564576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161055b906122cd565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925846040516105c19190611be5565b60405180910390a36001905092915050565b60006105dd61149c565b73ffffffffffffffffffffffffffffffffffffffff1663fe3b2b8860006040518263ffffffff1660e01b81526004016106169190612386565b602060405180830381865afa158015610633573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061065791906123bd565b905090565b600061066661135e565b73ffffffffffffffffffffffffffffffffffffffff1663fbdb0e87853360006040518463ffffffff1660e01b81526004016106a3939291906123ea565b602060405180830381865afa1580156106c0573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106e491906123bd565b821115610726576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161071d9061249a565b60405180910390fd5b61072e61149c565b73ffffffffffffffffffffffffffffffffffffffff16638440481185856107548661137a565b6040518463ffffffff1660e01b8152600401610772939291906124ba565b6020604051808303816000875af1158015610791573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107b5919061222e565b6107f4576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107eb9061256a565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516108519190611be5565b60405180910390a3600190509392505050565b60007f0000000000000000000000000000000000000000000000000000000000000000905090565b60007f000000000000000000000000000000000000000000000000000000000000000046146108c2576108bd6114b8565b6108e4565b7f00000000000000000000000000000000000000000000000000000000000000005b905090565b600460008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600085815260200190815260200160002060009054906101000a900460ff1615610987576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161097e906125fc565b60405180910390fd5b8473ffffffffffffffffffffffffffffffffffffffff166109f47f158b0a9edf7a828aad02f63cd515c68ef2f50ba807396f6d12842833a159742987876040516020016109d69392919061261c565b60405160208183030381529060405280519060200120858585611544565b73ffffffffffffffffffffffffffffffffffffffff1614610a4a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a419061269f565b60405180910390fd5b6001600460008773ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600086815260200190815260200160002060006101000a81548160ff021916908315150217905550838573ffffffffffffffffffffffffffffffffffffffff167f1cdd46ff242716cdaa72d159d339a485b3438398348d68f09d7c8c0a59353d8160405160405180910390a35050505050565b6000610b0861149c565b73ffffffffffffffffffffffffffffffffffffffff166334d1fdaf8360006040518363ffffffff1660e01b8152600401610b439291906126bf565b602060405180830381865afa158015610b60573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610b8491906123bd565b9050919050565b60036020528060005260406000206000915090505481565b7fd099cc98ef71107a616c4f0f941f04c322d8e254fe26b3c6668db87aae413de881565b606060028054610bd690611fd9565b80601f0160208091040260200160405190810160405280929190818152602001828054610c0290611fd9565b8015610c4f5780601f10610c2457610100808354040283529160200191610c4f565b820191906000526020600020905b815481529060010190602001808311610c3257829003601f168201915b5050505050905090565b7f7c7c6cdb67a18743f49ec6fa9b35f50d52ed05cbed4cc592e13b44501c1a226781565b6000610c8761149c565b73ffffffffffffffffffffffffffffffffffffffff1663844048113385610cad8661137a565b6040518463ffffffff1660e01b8152600401610ccb939291906124ba565b6020604051808303816000875af1158015610cea573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d0e919061222e565b610d4d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d4490612761565b60405180910390fd5b8273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051610daa9190611be5565b60405180910390a36001905092915050565b60008054610dc990611fd9565b80601f0160208091040260200160405190810160405280929190818152602001828054610df590611fd9565b8015610e425780601f10610e1757610100808354040283529160200191610e42565b820191906000526020600020905b815481529060010190602001808311610e2557829003601f168201915b505050505081565b42841015610e8d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610e84906127f3565b60405180910390fd5b60006001610e9961088c565b7f6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c98a8a8a600360008f73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000815480929190600101919050558b604051602001610f2196959493929190612813565b60405160208183030381529060405280519060200120604051602001610f489291906128ec565b6040516020818303038152906040528051906020012085858560405160008152602001604052604051610f7e9493929190612923565b6020604051602081039080840390855afa158015610fa0573d6000803e3d6000fd5b505050602060405103519050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff161415801561101457508773ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16145b611053576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161104a9061269f565b60405180910390fd5b61105b61135e565b73ffffffffffffffffffffffffffffffffffffffff16632b6b7ab582896110818a61137a565b60006040518563ffffffff1660e01b81526004016110a294939291906121b6565b6020604051808303816000875af11580156110c1573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906110e5919061222e565b611124576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161111b906122cd565b60405180910390fd5b508573ffffffffffffffffffffffffffffffffffffffff168773ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925876040516111829190611be5565b60405180910390a350505050505050565b7f158b0a9edf7a828aad02f63cd515c68ef2f50ba807396f6d12842833a159742981565b60006111c161135e565b73ffffffffffffffffffffffffffffffffffffffff1663fbdb0e87848460006040518463ffffffff1660e01b81526004016111fe939291906123ea565b602060405180830381865afa15801561121b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061123f91906123bd565b905092915050565b6112797f7c7c6cdb67a18743f49ec6fa9b35f50d52ed05cbed4cc592e13b44501c1a22678a8a8a8a8a8a8a8a8a611642565b505050505050505050565b60046020528160005260406000206020528060005260406000206000915091509054906101000a900460ff1681565b3373ffffffffffffffffffffffffffffffffffffffff168873ffffffffffffffffffffffffffffffffffffffff1614611321576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611318906129da565b60405180910390fd5b6113537fd099cc98ef71107a616c4f0f941f04c322d8e254fe26b3c6668db87aae413de88a8a8a8a8a8a8a8a8a611642565b505050505050505050565b600073bdf49c3c3882102fc017ffb661108c63a836d065905090565b60606000600167ffffffffffffffff811115611399576113986129fa565b5b6040519080825280602002602001820160405280156113d257816020015b6113bf6119fb565b8152602001906001900390816113b75790505b5090506040518060400160405280848152602001600080546113f390611fd9565b80601f016020809104026020016040519081016040528092919081815260200182805461141f90611fd9565b801561146c5780601f106114415761010080835404028352916020019161146c565b820191906000526020600020905b81548152906001019060200180831161144f57829003601f168201915b50505050508152508160008151811061148857611487612a29565b5b602002602001018190525080915050919050565b6000734381dc2ab14285160c808659aee005d51255add7905090565b60007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60016040516114ea9190612afb565b60405180910390207fc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc64630604051602001611529959493929190612b12565b60405160208183030381529060405280519060200120905090565b600080600161155161088c565b876040516020016115639291906128ec565b60405160208183030381529060405280519060200120868686604051600081526020016040526040516115999493929190612923565b6020604051602081039080840390855afa1580156115bb573d6000803e3d6000fd5b505050602060405103519050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1603611636576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161162d9061269f565b60405180910390fd5b80915050949350505050565b854211611684576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161167b90612bd7565b60405180910390fd5b8442106116c6576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016116bd90612c69565b60405180910390fd5b600460008a73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600085815260200190815260200160002060009054906101000a900460ff1615611764576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161175b906125fc565b60405180910390fd5b8873ffffffffffffffffffffffffffffffffffffffff166117b98b8b8b8b8b8b8b60405160200161179b9796959493929190612c89565b60405160208183030381529060405280519060200120858585611544565b73ffffffffffffffffffffffffffffffffffffffff161461180f576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118069061269f565b60405180910390fd5b6001600460008b73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600086815260200190815260200160002060006101000a81548160ff021916908315150217905550838973ffffffffffffffffffffffffffffffffffffffff167f98de503528ee59b575ef0c0a2576a82497bfc029a5685b209e9ec333479b10a560405160405180910390a36118c461149c565b73ffffffffffffffffffffffffffffffffffffffff1663844048118a8a6118ea8b61137a565b6040518463ffffffff1660e01b8152600401611908939291906124ba565b6020604051808303816000875af1158015611927573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061194b919061222e565b61198a576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119819061256a565b60405180910390fd5b8773ffffffffffffffffffffffffffffffffffffffff168973ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef896040516119e79190611be5565b60405180910390a350505050505050505050565b604051806040016040528060008152602001606081525090565b600081519050919050565b600082825260208201905092915050565b60005b83811015611a4f578082015181840152602081019050611a34565b60008484015250505050565b6000601f19601f8301169050919050565b6000611a7782611a15565b611a818185611a20565b9350611a91818560208601611a31565b611a9a81611a5b565b840191505092915050565b60006020820190508181036000830152611abf8184611a6c565b905092915050565b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000611af782611acc565b9050919050565b611b0781611aec565b8114611b1257600080fd5b50565b600081359050611b2481611afe565b92915050565b6000819050919050565b611b3d81611b2a565b8114611b4857600080fd5b50565b600081359050611b5a81611b34565b92915050565b60008060408385031215611b7757611b76611ac7565b5b6000611b8585828601611b15565b9250506020611b9685828601611b4b565b9150509250929050565b60008115159050919050565b611bb581611ba0565b82525050565b6000602082019050611bd06000830184611bac565b92915050565b611bdf81611b2a565b82525050565b6000602082019050611bfa6000830184611bd6565b92915050565b600080600060608486031215611c1957611c18611ac7565b5b6000611c2786828701611b15565b9350506020611c3886828701611b15565b9250506040611c4986828701611b4b565b9150509250925092565b600060ff82169050919050565b611c6981611c53565b82525050565b6000602082019050611c846000830184611c60565b92915050565b6000819050919050565b611c9d81611c8a565b82525050565b6000602082019050611cb86000830184611c94565b92915050565b611cc781611c8a565b8114611cd257600080fd5b50565b600081359050611ce481611cbe565b92915050565b611cf381611c53565b8114611cfe57600080fd5b50565b600081359050611d1081611cea565b92915050565b600080600080600060a08688031215611d3257611d31611ac7565b5b6000611d4088828901611b15565b9550506020611d5188828901611cd5565b9450506040611d6288828901611d01565b9350506060611d7388828901611cd5565b9250506080611d8488828901611cd5565b9150509295509295909350565b600060208284031215611da757611da6611ac7565b5b6000611db584828501611b15565b91505092915050565b600080600080600080600060e0888a031215611ddd57611ddc611ac7565b5b6000611deb8a828b01611b15565b9750506020611dfc8a828b01611b15565b9650506040611e0d8a828b01611b4b565b9550506060611e1e8a828b01611b4b565b9450506080611e2f8a828b01611d01565b93505060a0611e408a828b01611cd5565b92505060c0611e518a828b01611cd5565b91505092959891949750929550565b60008060408385031215611e7757611e76611ac7565b5b6000611e8585828601611b15565b9250506020611e9685828601611b15565b9150509250929050565b60008060008060008060008060006101208a8c031215611ec357611ec2611ac7565b5b6000611ed18c828d01611b15565b9950506020611ee28c828d01611b15565b9850506040611ef38c828d01611b4b565b9750506060611f048c828d01611b4b565b9650506080611f158c828d01611b4b565b95505060a0611f268c828d01611cd5565b94505060c0611f378c828d01611d01565b93505060e0611f488c828d01611cd5565b925050610100611f5a8c828d01611cd5565b9150509295985092959850929598565b60008060408385031215611f8157611f80611ac7565b5b6000611f8f85828601611b15565b9250506020611fa085828601611cd5565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b60006002820490506001821680611ff157607f821691505b60208210810361200457612003611faa565b5b50919050565b61201381611aec565b82525050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b61204e81611b2a565b82525050565b600082825260208201905092915050565b600061207082611a15565b61207a8185612054565b935061208a818560208601611a31565b61209381611a5b565b840191505092915050565b60006040830160008301516120b66000860182612045565b50602083015184820360208601526120ce8282612065565b9150508091505092915050565b60006120e7838361209e565b905092915050565b6000602082019050919050565b600061210782612019565b6121118185612024565b93508360208202850161212385612035565b8060005b8581101561215f578484038952815161214085826120db565b945061214b836120ef565b925060208a01995050600181019050612127565b50829750879550505050505092915050565b6000819050919050565b6000819050919050565b60006121a061219b61219684612171565b61217b565b611b2a565b9050919050565b6121b081612185565b82525050565b60006080820190506121cb600083018761200a565b6121d8602083018661200a565b81810360408301526121ea81856120fc565b90506121f960608301846121a7565b95945050505050565b61220b81611ba0565b811461221657600080fd5b50565b60008151905061222881612202565b92915050565b60006020828403121561224457612243611ac7565b5b600061225284828501612219565b91505092915050565b7f4772696469726f6e45524332303a206661696c656420746f20617070726f766560008201527f207370656e640000000000000000000000000000000000000000000000000000602082015250565b60006122b7602683611a20565b91506122c28261225b565b604082019050919050565b600060208201905081810360008301526122e6816122aa565b9050919050565b60008190508160005260206000209050919050565b6000815461230f81611fd9565b6123198186611a20565b94506001821660008114612334576001811461234a5761237d565b60ff19831686528115156020028601935061237d565b612353856122ed565b60005b8381101561237557815481890152600182019150602081019050612356565b808801955050505b50505092915050565b600060208201905081810360008301526123a08184612302565b905092915050565b6000815190506123b781611b34565b92915050565b6000602082840312156123d3576123d2611ac7565b5b60006123e1848285016123a8565b91505092915050565b60006060820190506123ff600083018661200a565b61240c602083018561200a565b818103604083015261241e8184612302565b9050949350505050565b7f4772696469726f6e45524332303a20696e73756666696369656e74206170707260008201527f6f76616c00000000000000000000000000000000000000000000000000000000602082015250565b6000612484602483611a20565b915061248f82612428565b604082019050919050565b600060208201905081810360008301526124b381612477565b9050919050565b60006060820190506124cf600083018661200a565b6124dc602083018561200a565b81810360408301526124ee81846120fc565b9050949350505050565b7f4772696469726f6e45524332303a206661696c656420746f2073656e6420626160008201527f6e6b20746f6b656e730000000000000000000000000000000000000000000000602082015250565b6000612554602983611a20565b915061255f826124f8565b604082019050919050565b6000602082019050818103600083015261258381612547565b9050919050565b7f4772696469726f6e45524332303a20415554484f52495a4154494f4e5f55534560008201527f4400000000000000000000000000000000000000000000000000000000000000602082015250565b60006125e6602183611a20565b91506125f18261258a565b604082019050919050565b60006020820190508181036000830152612615816125d9565b9050919050565b60006060820190506126316000830186611c94565b61263e602083018561200a565b61264b6040830184611c94565b949350505050565b7f4772696469726f6e45524332303a20494e56414c49445f5349474e4552000000600082015250565b6000612689601d83611a20565b915061269482612653565b602082019050919050565b600060208201905081810360008301526126b88161267c565b9050919050565b60006040820190506126d4600083018561200a565b81810360208301526126e68184612302565b90509392505050565b7f4772696469726f6e45524332303a206661696c656420746f2073656e6420746f60008201527f6b656e7300000000000000000000000000000000000000000000000000000000602082015250565b600061274b602483611a20565b9150612756826126ef565b604082019050919050565b6000602082019050818103600083015261277a8161273e565b9050919050565b7f4772696469726f6e45524332303a205045524d49545f444541444c494e455f4560008201527f5850495245440000000000000000000000000000000000000000000000000000602082015250565b60006127dd602683611a20565b91506127e882612781565b604082019050919050565b6000602082019050818103600083015261280c816127d0565b9050919050565b600060c0820190506128286000830189611c94565b612835602083018861200a565b612842604083018761200a565b61284f6060830186611bd6565b61285c6080830185611bd6565b61286960a0830184611bd6565b979650505050505050565b600081905092915050565b7f1901000000000000000000000000000000000000000000000000000000000000600082015250565b60006128b5600283612874565b91506128c08261287f565b600282019050919050565b6000819050919050565b6128e66128e182611c8a565b6128cb565b82525050565b60006128f7826128a8565b915061290382856128d5565b60208201915061291382846128d5565b6020820191508190509392505050565b60006080820190506129386000830187611c94565b6129456020830186611c60565b6129526040830185611c94565b61295f6060830184611c94565b95945050505050565b7f4772696469726f6e45524332303a2063616c6c6572206d75737420626520746860008201527f6520706179656500000000000000000000000000000000000000000000000000602082015250565b60006129c4602783611a20565b91506129cf82612968565b604082019050919050565b600060208201905081810360008301526129f3816129b7565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600081905092915050565b60008190508160005260206000209050919050565b60008154612a8581611fd9565b612a8f8186612a58565b94506001821660008114612aaa5760018114612abf57612af2565b60ff1983168652811515820286019350612af2565b612ac885612a63565b60005b83811015612aea57815481890152600182019150602081019050612acb565b838801955050505b50505092915050565b6000612b078284612a78565b915081905092915050565b600060a082019050612b276000830188611c94565b612b346020830187611c94565b612b416040830186611c94565b612b4e6060830185611bd6565b612b5b608083018461200a565b9695505050505050565b7f4772696469726f6e45524332303a20415554484f52495a4154494f4e5f4e4f5460008201527f5f5945545f56414c494400000000000000000000000000000000000000000000602082015250565b6000612bc1602a83611a20565b9150612bcc82612b65565b604082019050919050565b60006020820190508181036000830152612bf081612bb4565b9050919050565b7f4772696469726f6e45524332303a20415554484f52495a4154494f4e5f45585060008201527f4952454400000000000000000000000000000000000000000000000000000000602082015250565b6000612c53602483611a20565b9150612c5e82612bf7565b604082019050919050565b60006020820190508181036000830152612c8281612c46565b9050919050565b600060e082019050612c9e600083018a611c94565b612cab602083018961200a565b612cb8604083018861200a565b612cc56060830187611bd6565b612cd26080830186611bd6565b612cdf60a0830185611bd6565b612cec60c0830184611c94565b9897505050505050505056fea26469706673582212207fa18947cdc33405d0baa80eca989ff4f328765072492c9459590c2870a7df2c64736f6c63430008150033",
 }
 
 // GridironERC20ABI is the input ABI used to generate the binding from.
@@ -44,7 +44,7 @@ var GridironERC20ABI = GridironERC20MetaData.ABI
 var GridironERC20Bin = GridironERC20MetaData.Bin
 
 // DeployGridironERC20 deploys a new Ethereum contract, binding an instance of GridironERC20 to it.
-func DeployGridironERC20(auth *bind.TransactOpts, backend bind.ContractBackend, _denom string) (common.Address, *types.Transaction, *GridironERC20, error) {
+func DeployGridironERC20(auth *bind.TransactOpts, backend bind.ContractBackend, _denom string, name_ string, symbol_ string, decimals_ uint8) (common.Address, *types.Transaction, *GridironERC20, error) {
 	parsed, err := GridironERC20MetaData.GetAbi()
 	if err != nil {
 		return common.Address{}, nil, nil, err
@@ -53,7 +53,7 @@ func DeployGridironERC20(auth *bind.TransactOpts, backend bind.ContractBackend,
 		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
 	}
 
-	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GridironERC20Bin), backend, _denom)
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GridironERC20Bin), backend, _denom, name_, symbol_, decimals_)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -202,6 +202,37 @@ func (_GridironERC20 *GridironERC20TransactorRaw) Transact(opts *bind.TransactOp
 	return _GridironERC20.Contract.contract.Transact(opts, method, params...)
 }
 
+// CANCELAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xd9169487.
+//
+// Solidity: function CANCEL_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Caller) CANCELAUTHORIZATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GridironERC20.contract.Call(opts, &out, "CANCEL_AUTHORIZATION_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CANCELAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xd9169487.
+//
+// Solidity: function CANCEL_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Session) CANCELAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.CANCELAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
+// CANCELAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xd9169487.
+//
+// Solidity: function CANCEL_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20CallerSession) CANCELAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.CANCELAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
 // DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
 //
 // Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
@@ -233,6 +264,68 @@ func (_GridironERC20 *GridironERC20CallerSession) DOMAINSEPARATOR() ([32]byte, e
 	return _GridironERC20.Contract.DOMAINSEPARATOR(&_GridironERC20.CallOpts)
 }
 
+// RECEIVEWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0x7f2eecc3.
+//
+// Solidity: function RECEIVE_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Caller) RECEIVEWITHAUTHORIZATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GridironERC20.contract.Call(opts, &out, "RECEIVE_WITH_AUTHORIZATION_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// RECEIVEWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0x7f2eecc3.
+//
+// Solidity: function RECEIVE_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Session) RECEIVEWITHAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.RECEIVEWITHAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
+// RECEIVEWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0x7f2eecc3.
+//
+// Solidity: function RECEIVE_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20CallerSession) RECEIVEWITHAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.RECEIVEWITHAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
+// TRANSFERWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xa0cc6a68.
+//
+// Solidity: function TRANSFER_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Caller) TRANSFERWITHAUTHORIZATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GridironERC20.contract.Call(opts, &out, "TRANSFER_WITH_AUTHORIZATION_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xa0cc6a68.
+//
+// Solidity: function TRANSFER_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20Session) TRANSFERWITHAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.TRANSFERWITHAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
+// TRANSFERWITHAUTHORIZATIONTYPEHASH is a free data retrieval call binding the contract method 0xa0cc6a68.
+//
+// Solidity: function TRANSFER_WITH_AUTHORIZATION_TYPEHASH() view returns(bytes32)
+func (_GridironERC20 *GridironERC20CallerSession) TRANSFERWITHAUTHORIZATIONTYPEHASH() ([32]byte, error) {
+	return _GridironERC20.Contract.TRANSFERWITHAUTHORIZATIONTYPEHASH(&_GridironERC20.CallOpts)
+}
+
 // Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
 //
 // Solidity: function allowance(address owner, address spender) view returns(uint256)
@@ -264,6 +357,37 @@ func (_GridironERC20 *GridironERC20CallerSession) Allowance(owner common.Address
 	return _GridironERC20.Contract.Allowance(&_GridironERC20.CallOpts, owner, spender)
 }
 
+// AuthorizationState is a free data retrieval call binding the contract method 0xe94a0102.
+//
+// Solidity: function authorizationState(address, bytes32) view returns(bool)
+func (_GridironERC20 *GridironERC20Caller) AuthorizationState(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _GridironERC20.contract.Call(opts, &out, "authorizationState", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AuthorizationState is a free data retrieval call binding the contract method 0xe94a0102.
+//
+// Solidity: function authorizationState(address, bytes32) view returns(bool)
+func (_GridironERC20 *GridironERC20Session) AuthorizationState(arg0 common.Address, arg1 [32]byte) (bool, error) {
+	return _GridironERC20.Contract.AuthorizationState(&_GridironERC20.CallOpts, arg0, arg1)
+}
+
+// AuthorizationState is a free data retrieval call binding the contract method 0xe94a0102.
+//
+// Solidity: function authorizationState(address, bytes32) view returns(bool)
+func (_GridironERC20 *GridironERC20CallerSession) AuthorizationState(arg0 common.Address, arg1 [32]byte) (bool, error) {
+	return _GridironERC20.Contract.AuthorizationState(&_GridironERC20.CallOpts, arg0, arg1)
+}
+
 // BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
 // Solidity: function balanceOf(address user) view returns(uint256)
@@ -297,7 +421,7 @@ func (_GridironERC20 *GridironERC20CallerSession) BalanceOf(user common.Address)
 
 // Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function decimals() pure returns(uint8)
+// Solidity: function decimals() view returns(uint8)
 func (_GridironERC20 *GridironERC20Caller) Decimals(opts *bind.CallOpts) (uint8, error) {
 	var out []interface{}
 	err := _GridironERC20.contract.Call(opts, &out, "decimals")
@@ -314,14 +438,14 @@ func (_GridironERC20 *GridironERC20Caller) Decimals(opts *bind.CallOpts) (uint8,
 
 // Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function decimals() pure returns(uint8)
+// Solidity: function decimals() view returns(uint8)
 func (_GridironERC20 *GridironERC20Session) Decimals() (uint8, error) {
 	return _GridironERC20.Contract.Decimals(&_GridironERC20.CallOpts)
 }
 
 // Decimals is a free data retrieval call binding the contract method 0x313ce567.
 //
-// Solidity: function decimals() pure returns(uint8)
+// Solidity: function decimals() view returns(uint8)
 func (_GridironERC20 *GridironERC20CallerSession) Decimals() (uint8, error) {
 	return _GridironERC20.Contract.Decimals(&_GridironERC20.CallOpts)
 }
@@ -502,6 +626,27 @@ func (_GridironERC20 *GridironERC20TransactorSession) Approve(spender common.Add
 	return _GridironERC20.Contract.Approve(&_GridironERC20.TransactOpts, spender, amount)
 }
 
+// CancelAuthorization is a paid mutator transaction binding the contract method 0x5a049a70.
+//
+// Solidity: function cancelAuthorization(address authorizer, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Transactor) CancelAuthorization(opts *bind.TransactOpts, authorizer common.Address, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.contract.Transact(opts, "cancelAuthorization", authorizer, nonce, v, r, s)
+}
+
+// CancelAuthorization is a paid mutator transaction binding the contract method 0x5a049a70.
+//
+// Solidity: function cancelAuthorization(address authorizer, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Session) CancelAuthorization(authorizer common.Address, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.CancelAuthorization(&_GridironERC20.TransactOpts, authorizer, nonce, v, r, s)
+}
+
+// CancelAuthorization is a paid mutator transaction binding the contract method 0x5a049a70.
+//
+// Solidity: function cancelAuthorization(address authorizer, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20TransactorSession) CancelAuthorization(authorizer common.Address, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.CancelAuthorization(&_GridironERC20.TransactOpts, authorizer, nonce, v, r, s)
+}
+
 // Permit is a paid mutator transaction binding the contract method 0xd505accf.
 //
 // Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
@@ -523,6 +668,27 @@ func (_GridironERC20 *GridironERC20TransactorSession) Permit(owner common.Addres
 	return _GridironERC20.Contract.Permit(&_GridironERC20.TransactOpts, owner, spender, value, deadline, v, r, s)
 }
 
+// ReceiveWithAuthorization is a paid mutator transaction binding the contract method 0xef55bec6.
+//
+// Solidity: function receiveWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Transactor) ReceiveWithAuthorization(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.contract.Transact(opts, "receiveWithAuthorization", from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
+// ReceiveWithAuthorization is a paid mutator transaction binding the contract method 0xef55bec6.
+//
+// Solidity: function receiveWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Session) ReceiveWithAuthorization(from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.ReceiveWithAuthorization(&_GridironERC20.TransactOpts, from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
+// ReceiveWithAuthorization is a paid mutator transaction binding the contract method 0xef55bec6.
+//
+// Solidity: function receiveWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20TransactorSession) ReceiveWithAuthorization(from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.ReceiveWithAuthorization(&_GridironERC20.TransactOpts, from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
 // Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
 //
 // Solidity: function transfer(address to, uint256 amount) returns(bool)
@@ -565,6 +731,27 @@ func (_GridironERC20 *GridironERC20TransactorSession) TransferFrom(from common.A
 	return _GridironERC20.Contract.TransferFrom(&_GridironERC20.TransactOpts, from, to, amount)
 }
 
+// TransferWithAuthorization is a paid mutator transaction binding the contract method 0xe3ee160e.
+//
+// Solidity: function transferWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Transactor) TransferWithAuthorization(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.contract.Transact(opts, "transferWithAuthorization", from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
+// TransferWithAuthorization is a paid mutator transaction binding the contract method 0xe3ee160e.
+//
+// Solidity: function transferWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20Session) TransferWithAuthorization(from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.TransferWithAuthorization(&_GridironERC20.TransactOpts, from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
+// TransferWithAuthorization is a paid mutator transaction binding the contract method 0xe3ee160e.
+//
+// Solidity: function transferWithAuthorization(address from, address to, uint256 value, uint256 validAfter, uint256 validBefore, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) returns()
+func (_GridironERC20 *GridironERC20TransactorSession) TransferWithAuthorization(from common.Address, to common.Address, value *big.Int, validAfter *big.Int, validBefore *big.Int, nonce [32]byte, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _GridironERC20.Contract.TransferWithAuthorization(&_GridironERC20.TransactOpts, from, to, value, validAfter, validBefore, nonce, v, r, s)
+}
+
 // GridironERC20ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the GridironERC20 contract.
 type GridironERC20ApprovalIterator struct {
 	Event *GridironERC20Approval // Event containing the contract specifics and raw log
@@ -719,6 +906,312 @@ func (_GridironERC20 *GridironERC20Filterer) ParseApproval(log types.Log) (*Grid
 	return event, nil
 }
 
+// GridironERC20AuthorizationCanceledIterator is returned from FilterAuthorizationCanceled and is used to iterate over the raw logs and unpacked data for AuthorizationCanceled events raised by the GridironERC20 contract.
+type GridironERC20AuthorizationCanceledIterator struct {
+	Event *GridironERC20AuthorizationCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GridironERC20AuthorizationCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GridironERC20AuthorizationCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GridironERC20AuthorizationCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GridironERC20AuthorizationCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GridironERC20AuthorizationCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GridironERC20AuthorizationCanceled represents a AuthorizationCanceled event raised by the GridironERC20 contract.
+type GridironERC20AuthorizationCanceled struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuthorizationCanceled is a free log retrieval operation binding the contract event 0x1cdd46ff242716cdaa72d159d339a485b3438398348d68f09d7c8c0a59353d81.
+//
+// Solidity: event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) FilterAuthorizationCanceled(opts *bind.FilterOpts, authorizer []common.Address, nonce [][32]byte) (*GridironERC20AuthorizationCanceledIterator, error) {
+
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _GridironERC20.contract.FilterLogs(opts, "AuthorizationCanceled", authorizerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GridironERC20AuthorizationCanceledIterator{contract: _GridironERC20.contract, event: "AuthorizationCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchAuthorizationCanceled is a free log subscription operation binding the contract event 0x1cdd46ff242716cdaa72d159d339a485b3438398348d68f09d7c8c0a59353d81.
+//
+// Solidity: event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) WatchAuthorizationCanceled(opts *bind.WatchOpts, sink chan<- *GridironERC20AuthorizationCanceled, authorizer []common.Address, nonce [][32]byte) (event.Subscription, error) {
+
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _GridironERC20.contract.WatchLogs(opts, "AuthorizationCanceled", authorizerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GridironERC20AuthorizationCanceled)
+				if err := _GridironERC20.contract.UnpackLog(event, "AuthorizationCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuthorizationCanceled is a log parse operation binding the contract event 0x1cdd46ff242716cdaa72d159d339a485b3438398348d68f09d7c8c0a59353d81.
+//
+// Solidity: event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) ParseAuthorizationCanceled(log types.Log) (*GridironERC20AuthorizationCanceled, error) {
+	event := new(GridironERC20AuthorizationCanceled)
+	if err := _GridironERC20.contract.UnpackLog(event, "AuthorizationCanceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GridironERC20AuthorizationUsedIterator is returned from FilterAuthorizationUsed and is used to iterate over the raw logs and unpacked data for AuthorizationUsed events raised by the GridironERC20 contract.
+type GridironERC20AuthorizationUsedIterator struct {
+	Event *GridironERC20AuthorizationUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GridironERC20AuthorizationUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GridironERC20AuthorizationUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GridironERC20AuthorizationUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GridironERC20AuthorizationUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GridironERC20AuthorizationUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GridironERC20AuthorizationUsed represents a AuthorizationUsed event raised by the GridironERC20 contract.
+type GridironERC20AuthorizationUsed struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuthorizationUsed is a free log retrieval operation binding the contract event 0x98de503528ee59b575ef0c0a2576a82497bfc029a5685b209e9ec333479b10a5.
+//
+// Solidity: event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) FilterAuthorizationUsed(opts *bind.FilterOpts, authorizer []common.Address, nonce [][32]byte) (*GridironERC20AuthorizationUsedIterator, error) {
+
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _GridironERC20.contract.FilterLogs(opts, "AuthorizationUsed", authorizerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GridironERC20AuthorizationUsedIterator{contract: _GridironERC20.contract, event: "AuthorizationUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchAuthorizationUsed is a free log subscription operation binding the contract event 0x98de503528ee59b575ef0c0a2576a82497bfc029a5685b209e9ec333479b10a5.
+//
+// Solidity: event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) WatchAuthorizationUsed(opts *bind.WatchOpts, sink chan<- *GridironERC20AuthorizationUsed, authorizer []common.Address, nonce [][32]byte) (event.Subscription, error) {
+
+	var authorizerRule []interface{}
+	for _, authorizerItem := range authorizer {
+		authorizerRule = append(authorizerRule, authorizerItem)
+	}
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _GridironERC20.contract.WatchLogs(opts, "AuthorizationUsed", authorizerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GridironERC20AuthorizationUsed)
+				if err := _GridironERC20.contract.UnpackLog(event, "AuthorizationUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuthorizationUsed is a log parse operation binding the contract event 0x98de503528ee59b575ef0c0a2576a82497bfc029a5685b209e9ec333479b10a5.
+//
+// Solidity: event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce)
+func (_GridironERC20 *GridironERC20Filterer) ParseAuthorizationUsed(log types.Log) (*GridironERC20AuthorizationUsed, error) {
+	event := new(GridironERC20AuthorizationUsed)
+	if err := _GridironERC20.contract.UnpackLog(event, "AuthorizationUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // GridironERC20TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the GridironERC20 contract.
 type GridironERC20TransferIterator struct {
 	Event *GridironERC20Transfer // Event containing the contract specifics and raw log
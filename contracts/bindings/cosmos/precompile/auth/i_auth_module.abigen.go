@@ -35,9 +35,34 @@ type CosmosCoin struct {
 	Denom  string
 }
 
+// IAuthModuleGrant is an auto generated low-level Go binding around an user-defined struct.
+type IAuthModuleGrant struct {
+	Granter              common.Address
+	Grantee              common.Address
+	MsgTypeUrl           string
+	AuthorizationTypeUrl string
+	Authorization        []byte
+	Expiration           *big.Int
+}
+
+// IAuthModulePageRequest is an auto generated low-level Go binding around an user-defined struct.
+type IAuthModulePageRequest struct {
+	Key        []byte
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// IAuthModulePageResponse is an auto generated low-level Go binding around an user-defined struct.
+type IAuthModulePageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
 // AuthModuleMetaData contains all meta data concerning the AuthModule contract.
 var AuthModuleMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"convertBech32ToHexAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"convertHexToBech32\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"setSendAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"}],\"name\":\"ExecAuthorization\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"}],\"name\":\"GrantAuthorization\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"}],\"name\":\"RevokeAuthorization\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"convertBech32ToHexAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"convertHexToBech32\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"typeUrls\",\"type\":\"string[]\"},{\"internalType\":\"bytes[]\",\"name\":\"msgs\",\"type\":\"bytes[]\"}],\"name\":\"exec\",\"outputs\":[{\"internalType\":\"bytes[]\",\"name\":\"\",\"type\":\"bytes[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIAuthModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getGranteeGrants\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"authorizationTypeUrl\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"authorization\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"internalType\":\"structIAuthModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIAuthModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getGranterGrants\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"authorizationTypeUrl\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"authorization\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"internalType\":\"structIAuthModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIAuthModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getGrants\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"authorizationTypeUrl\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"authorization\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"internalType\":\"structIAuthModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"grantGenericAuthorization\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"spendLimit\",\"type\":\"tuple[]\"},{\"internalType\":\"address[]\",\"name\":\"allowList\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"grantSendAuthorization\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"int32\",\"name\":\"authorizationType\",\"type\":\"int32\"},{\"internalType\":\"address[]\",\"name\":\"allowList\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"denyList\",\"type\":\"address[]\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin\",\"name\":\"maxTokens\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"grantStakeAuthorization\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"setSendAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // AuthModuleABI is the input ABI used to generate the binding from.
@@ -248,6 +273,141 @@ func (_AuthModule *AuthModuleCallerSession) ConvertHexToBech32(account common.Ad
 	return _AuthModule.Contract.ConvertHexToBech32(&_AuthModule.CallOpts, account)
 }
 
+// GetGranteeGrants is a free data retrieval call binding the contract method 0x08545123.
+//
+// Solidity: function getGranteeGrants(address grantee, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCaller) GetGranteeGrants(opts *bind.CallOpts, grantee common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _AuthModule.contract.Call(opts, &out, "getGranteeGrants", grantee, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IAuthModuleGrant
+		Arg1 IAuthModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IAuthModuleGrant)).(*[]IAuthModuleGrant)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IAuthModulePageResponse)).(*IAuthModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetGranteeGrants is a free data retrieval call binding the contract method 0x08545123.
+//
+// Solidity: function getGranteeGrants(address grantee, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleSession) GetGranteeGrants(grantee common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGranteeGrants(&_AuthModule.CallOpts, grantee, pagination)
+}
+
+// GetGranteeGrants is a free data retrieval call binding the contract method 0x08545123.
+//
+// Solidity: function getGranteeGrants(address grantee, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCallerSession) GetGranteeGrants(grantee common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGranteeGrants(&_AuthModule.CallOpts, grantee, pagination)
+}
+
+// GetGranterGrants is a free data retrieval call binding the contract method 0xaa01dce7.
+//
+// Solidity: function getGranterGrants(address granter, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCaller) GetGranterGrants(opts *bind.CallOpts, granter common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _AuthModule.contract.Call(opts, &out, "getGranterGrants", granter, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IAuthModuleGrant
+		Arg1 IAuthModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IAuthModuleGrant)).(*[]IAuthModuleGrant)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IAuthModulePageResponse)).(*IAuthModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetGranterGrants is a free data retrieval call binding the contract method 0xaa01dce7.
+//
+// Solidity: function getGranterGrants(address granter, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleSession) GetGranterGrants(granter common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGranterGrants(&_AuthModule.CallOpts, granter, pagination)
+}
+
+// GetGranterGrants is a free data retrieval call binding the contract method 0xaa01dce7.
+//
+// Solidity: function getGranterGrants(address granter, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCallerSession) GetGranterGrants(granter common.Address, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGranterGrants(&_AuthModule.CallOpts, granter, pagination)
+}
+
+// GetGrants is a free data retrieval call binding the contract method 0x43fb1ccf.
+//
+// Solidity: function getGrants(address granter, address grantee, string msgTypeUrl, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCaller) GetGrants(opts *bind.CallOpts, granter common.Address, grantee common.Address, msgTypeUrl string, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _AuthModule.contract.Call(opts, &out, "getGrants", granter, grantee, msgTypeUrl, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IAuthModuleGrant
+		Arg1 IAuthModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IAuthModuleGrant)).(*[]IAuthModuleGrant)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IAuthModulePageResponse)).(*IAuthModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetGrants is a free data retrieval call binding the contract method 0x43fb1ccf.
+//
+// Solidity: function getGrants(address granter, address grantee, string msgTypeUrl, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleSession) GetGrants(granter common.Address, grantee common.Address, msgTypeUrl string, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGrants(&_AuthModule.CallOpts, granter, grantee, msgTypeUrl, pagination)
+}
+
+// GetGrants is a free data retrieval call binding the contract method 0x43fb1ccf.
+//
+// Solidity: function getGrants(address granter, address grantee, string msgTypeUrl, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,string,string,bytes,uint256)[], (bytes,uint64))
+func (_AuthModule *AuthModuleCallerSession) GetGrants(granter common.Address, grantee common.Address, msgTypeUrl string, pagination IAuthModulePageRequest) (struct {
+	Arg0 []IAuthModuleGrant
+	Arg1 IAuthModulePageResponse
+}, error) {
+	return _AuthModule.Contract.GetGrants(&_AuthModule.CallOpts, granter, grantee, msgTypeUrl, pagination)
+}
+
 // GetSendAllowance is a free data retrieval call binding the contract method 0xfbdb0e87.
 //
 // Solidity: function getSendAllowance(address owner, address spender, string denom) view returns(uint256)
@@ -279,6 +439,111 @@ func (_AuthModule *AuthModuleCallerSession) GetSendAllowance(owner common.Addres
 	return _AuthModule.Contract.GetSendAllowance(&_AuthModule.CallOpts, owner, spender, denom)
 }
 
+// Exec is a paid mutator transaction binding the contract method 0xd3e79695.
+//
+// Solidity: function exec(string[] typeUrls, bytes[] msgs) returns(bytes[])
+func (_AuthModule *AuthModuleTransactor) Exec(opts *bind.TransactOpts, typeUrls []string, msgs [][]byte) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "exec", typeUrls, msgs)
+}
+
+// Exec is a paid mutator transaction binding the contract method 0xd3e79695.
+//
+// Solidity: function exec(string[] typeUrls, bytes[] msgs) returns(bytes[])
+func (_AuthModule *AuthModuleSession) Exec(typeUrls []string, msgs [][]byte) (*types.Transaction, error) {
+	return _AuthModule.Contract.Exec(&_AuthModule.TransactOpts, typeUrls, msgs)
+}
+
+// Exec is a paid mutator transaction binding the contract method 0xd3e79695.
+//
+// Solidity: function exec(string[] typeUrls, bytes[] msgs) returns(bytes[])
+func (_AuthModule *AuthModuleTransactorSession) Exec(typeUrls []string, msgs [][]byte) (*types.Transaction, error) {
+	return _AuthModule.Contract.Exec(&_AuthModule.TransactOpts, typeUrls, msgs)
+}
+
+// GrantGenericAuthorization is a paid mutator transaction binding the contract method 0xc0cd1451.
+//
+// Solidity: function grantGenericAuthorization(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactor) GrantGenericAuthorization(opts *bind.TransactOpts, grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "grantGenericAuthorization", grantee, msgTypeUrl, expiration)
+}
+
+// GrantGenericAuthorization is a paid mutator transaction binding the contract method 0xc0cd1451.
+//
+// Solidity: function grantGenericAuthorization(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleSession) GrantGenericAuthorization(grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantGenericAuthorization(&_AuthModule.TransactOpts, grantee, msgTypeUrl, expiration)
+}
+
+// GrantGenericAuthorization is a paid mutator transaction binding the contract method 0xc0cd1451.
+//
+// Solidity: function grantGenericAuthorization(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) GrantGenericAuthorization(grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantGenericAuthorization(&_AuthModule.TransactOpts, grantee, msgTypeUrl, expiration)
+}
+
+// GrantSendAuthorization is a paid mutator transaction binding the contract method 0xdc522fca.
+//
+// Solidity: function grantSendAuthorization(address grantee, (uint256,string)[] spendLimit, address[] allowList, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactor) GrantSendAuthorization(opts *bind.TransactOpts, grantee common.Address, spendLimit []CosmosCoin, allowList []common.Address, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "grantSendAuthorization", grantee, spendLimit, allowList, expiration)
+}
+
+// GrantSendAuthorization is a paid mutator transaction binding the contract method 0xdc522fca.
+//
+// Solidity: function grantSendAuthorization(address grantee, (uint256,string)[] spendLimit, address[] allowList, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleSession) GrantSendAuthorization(grantee common.Address, spendLimit []CosmosCoin, allowList []common.Address, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantSendAuthorization(&_AuthModule.TransactOpts, grantee, spendLimit, allowList, expiration)
+}
+
+// GrantSendAuthorization is a paid mutator transaction binding the contract method 0xdc522fca.
+//
+// Solidity: function grantSendAuthorization(address grantee, (uint256,string)[] spendLimit, address[] allowList, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) GrantSendAuthorization(grantee common.Address, spendLimit []CosmosCoin, allowList []common.Address, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantSendAuthorization(&_AuthModule.TransactOpts, grantee, spendLimit, allowList, expiration)
+}
+
+// GrantStakeAuthorization is a paid mutator transaction binding the contract method 0x0026e5d8.
+//
+// Solidity: function grantStakeAuthorization(address grantee, int32 authorizationType, address[] allowList, address[] denyList, (uint256,string) maxTokens, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactor) GrantStakeAuthorization(opts *bind.TransactOpts, grantee common.Address, authorizationType int32, allowList []common.Address, denyList []common.Address, maxTokens CosmosCoin, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "grantStakeAuthorization", grantee, authorizationType, allowList, denyList, maxTokens, expiration)
+}
+
+// GrantStakeAuthorization is a paid mutator transaction binding the contract method 0x0026e5d8.
+//
+// Solidity: function grantStakeAuthorization(address grantee, int32 authorizationType, address[] allowList, address[] denyList, (uint256,string) maxTokens, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleSession) GrantStakeAuthorization(grantee common.Address, authorizationType int32, allowList []common.Address, denyList []common.Address, maxTokens CosmosCoin, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantStakeAuthorization(&_AuthModule.TransactOpts, grantee, authorizationType, allowList, denyList, maxTokens, expiration)
+}
+
+// GrantStakeAuthorization is a paid mutator transaction binding the contract method 0x0026e5d8.
+//
+// Solidity: function grantStakeAuthorization(address grantee, int32 authorizationType, address[] allowList, address[] denyList, (uint256,string) maxTokens, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) GrantStakeAuthorization(grantee common.Address, authorizationType int32, allowList []common.Address, denyList []common.Address, maxTokens CosmosCoin, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.GrantStakeAuthorization(&_AuthModule.TransactOpts, grantee, authorizationType, allowList, denyList, maxTokens, expiration)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleTransactor) Revoke(opts *bind.TransactOpts, grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "revoke", grantee, msgTypeUrl)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleSession) Revoke(grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.Contract.Revoke(&_AuthModule.TransactOpts, grantee, msgTypeUrl)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) Revoke(grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.Contract.Revoke(&_AuthModule.TransactOpts, grantee, msgTypeUrl)
+}
+
 // SetSendAllowance is a paid mutator transaction binding the contract method 0x2b6b7ab5.
 //
 // Solidity: function setSendAllowance(address owner, address spender, (uint256,string)[] amount, uint256 expiration) returns(bool)
@@ -299,3 +564,456 @@ func (_AuthModule *AuthModuleSession) SetSendAllowance(owner common.Address, spe
 func (_AuthModule *AuthModuleTransactorSession) SetSendAllowance(owner common.Address, spender common.Address, amount []CosmosCoin, expiration *big.Int) (*types.Transaction, error) {
 	return _AuthModule.Contract.SetSendAllowance(&_AuthModule.TransactOpts, owner, spender, amount, expiration)
 }
+
+// AuthModuleExecAuthorizationIterator is returned from FilterExecAuthorization and is used to iterate over the raw logs and unpacked data for ExecAuthorization events raised by the AuthModule contract.
+type AuthModuleExecAuthorizationIterator struct {
+	Event *AuthModuleExecAuthorization // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthModuleExecAuthorizationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthModuleExecAuthorization)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthModuleExecAuthorization)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthModuleExecAuthorizationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthModuleExecAuthorizationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthModuleExecAuthorization represents a ExecAuthorization event raised by the AuthModule contract.
+type AuthModuleExecAuthorization struct {
+	Grantee    common.Address
+	MsgTypeUrl string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecAuthorization is a free log retrieval operation binding the contract event 0xba4813b6e6535595f93eea4af0d897facf2da3e977380b6b6c1a3d6bedea131e.
+//
+// Solidity: event ExecAuthorization(address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) FilterExecAuthorization(opts *bind.FilterOpts, grantee []common.Address) (*AuthModuleExecAuthorizationIterator, error) {
+
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.FilterLogs(opts, "ExecAuthorization", granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthModuleExecAuthorizationIterator{contract: _AuthModule.contract, event: "ExecAuthorization", logs: logs, sub: sub}, nil
+}
+
+// WatchExecAuthorization is a free log subscription operation binding the contract event 0xba4813b6e6535595f93eea4af0d897facf2da3e977380b6b6c1a3d6bedea131e.
+//
+// Solidity: event ExecAuthorization(address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) WatchExecAuthorization(opts *bind.WatchOpts, sink chan<- *AuthModuleExecAuthorization, grantee []common.Address) (event.Subscription, error) {
+
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.WatchLogs(opts, "ExecAuthorization", granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthModuleExecAuthorization)
+				if err := _AuthModule.contract.UnpackLog(event, "ExecAuthorization", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecAuthorization is a log parse operation binding the contract event 0xba4813b6e6535595f93eea4af0d897facf2da3e977380b6b6c1a3d6bedea131e.
+//
+// Solidity: event ExecAuthorization(address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) ParseExecAuthorization(log types.Log) (*AuthModuleExecAuthorization, error) {
+	event := new(AuthModuleExecAuthorization)
+	if err := _AuthModule.contract.UnpackLog(event, "ExecAuthorization", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuthModuleGrantAuthorizationIterator is returned from FilterGrantAuthorization and is used to iterate over the raw logs and unpacked data for GrantAuthorization events raised by the AuthModule contract.
+type AuthModuleGrantAuthorizationIterator struct {
+	Event *AuthModuleGrantAuthorization // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthModuleGrantAuthorizationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthModuleGrantAuthorization)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthModuleGrantAuthorization)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthModuleGrantAuthorizationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthModuleGrantAuthorizationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthModuleGrantAuthorization represents a GrantAuthorization event raised by the AuthModule contract.
+type AuthModuleGrantAuthorization struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterGrantAuthorization is a free log retrieval operation binding the contract event 0xb2236d5caf69043976eb279b793d0bcde692e8fd052cd748c7efa86c6af6fd57.
+//
+// Solidity: event GrantAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) FilterGrantAuthorization(opts *bind.FilterOpts, granter []common.Address, grantee []common.Address) (*AuthModuleGrantAuthorizationIterator, error) {
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.FilterLogs(opts, "GrantAuthorization", granterRule, granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthModuleGrantAuthorizationIterator{contract: _AuthModule.contract, event: "GrantAuthorization", logs: logs, sub: sub}, nil
+}
+
+// WatchGrantAuthorization is a free log subscription operation binding the contract event 0xb2236d5caf69043976eb279b793d0bcde692e8fd052cd748c7efa86c6af6fd57.
+//
+// Solidity: event GrantAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) WatchGrantAuthorization(opts *bind.WatchOpts, sink chan<- *AuthModuleGrantAuthorization, granter []common.Address, grantee []common.Address) (event.Subscription, error) {
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.WatchLogs(opts, "GrantAuthorization", granterRule, granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthModuleGrantAuthorization)
+				if err := _AuthModule.contract.UnpackLog(event, "GrantAuthorization", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGrantAuthorization is a log parse operation binding the contract event 0xb2236d5caf69043976eb279b793d0bcde692e8fd052cd748c7efa86c6af6fd57.
+//
+// Solidity: event GrantAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) ParseGrantAuthorization(log types.Log) (*AuthModuleGrantAuthorization, error) {
+	event := new(AuthModuleGrantAuthorization)
+	if err := _AuthModule.contract.UnpackLog(event, "GrantAuthorization", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuthModuleRevokeAuthorizationIterator is returned from FilterRevokeAuthorization and is used to iterate over the raw logs and unpacked data for RevokeAuthorization events raised by the AuthModule contract.
+type AuthModuleRevokeAuthorizationIterator struct {
+	Event *AuthModuleRevokeAuthorization // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthModuleRevokeAuthorizationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthModuleRevokeAuthorization)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthModuleRevokeAuthorization)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthModuleRevokeAuthorizationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthModuleRevokeAuthorizationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthModuleRevokeAuthorization represents a RevokeAuthorization event raised by the AuthModule contract.
+type AuthModuleRevokeAuthorization struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevokeAuthorization is a free log retrieval operation binding the contract event 0xce3b4a9b2b9297652dc52710f29b0831b2ab52db3fde8a3c0da5a893b8052724.
+//
+// Solidity: event RevokeAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) FilterRevokeAuthorization(opts *bind.FilterOpts, granter []common.Address, grantee []common.Address) (*AuthModuleRevokeAuthorizationIterator, error) {
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.FilterLogs(opts, "RevokeAuthorization", granterRule, granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthModuleRevokeAuthorizationIterator{contract: _AuthModule.contract, event: "RevokeAuthorization", logs: logs, sub: sub}, nil
+}
+
+// WatchRevokeAuthorization is a free log subscription operation binding the contract event 0xce3b4a9b2b9297652dc52710f29b0831b2ab52db3fde8a3c0da5a893b8052724.
+//
+// Solidity: event RevokeAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) WatchRevokeAuthorization(opts *bind.WatchOpts, sink chan<- *AuthModuleRevokeAuthorization, granter []common.Address, grantee []common.Address) (event.Subscription, error) {
+
+	var granterRule []interface{}
+	for _, granterItem := range granter {
+		granterRule = append(granterRule, granterItem)
+	}
+	var granteeRule []interface{}
+	for _, granteeItem := range grantee {
+		granteeRule = append(granteeRule, granteeItem)
+	}
+
+	logs, sub, err := _AuthModule.contract.WatchLogs(opts, "RevokeAuthorization", granterRule, granteeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthModuleRevokeAuthorization)
+				if err := _AuthModule.contract.UnpackLog(event, "RevokeAuthorization", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevokeAuthorization is a log parse operation binding the contract event 0xce3b4a9b2b9297652dc52710f29b0831b2ab52db3fde8a3c0da5a893b8052724.
+//
+// Solidity: event RevokeAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl)
+func (_AuthModule *AuthModuleFilterer) ParseRevokeAuthorization(log types.Log) (*AuthModuleRevokeAuthorization, error) {
+	event := new(AuthModuleRevokeAuthorization)
+	if err := _AuthModule.contract.UnpackLog(event, "RevokeAuthorization", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -35,9 +35,15 @@ type CosmosCoin struct {
 	Denom  string
 }
 
+// IDistributionModuleValidatorReward is an auto generated low-level Go binding around an user-defined struct.
+type IDistributionModuleValidatorReward struct {
+	Validator common.Address
+	Rewards   []CosmosCoin
+}
+
 // DistributionModuleMetaData contains all meta data concerning the DistributionModule contract.
 var DistributionModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawRewards\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getWithdrawEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"withdrawAddress\",\"type\":\"string\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validator\",\"type\":\"string\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawCommission\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawRewards\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"fundCommunityPool\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommunityPool\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getDelegationRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getDelegationTotalRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"rewards\",\"type\":\"tuple[]\"}],\"internalType\":\"structIDistributionModule.ValidatorReward[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorCommission\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorOutstandingRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getWithdrawEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"withdrawAddress\",\"type\":\"string\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawAllDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validator\",\"type\":\"string\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawValidatorCommission\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // DistributionModuleABI is the input ABI used to generate the binding from.
@@ -186,6 +192,175 @@ func (_DistributionModule *DistributionModuleTransactorRaw) Transact(opts *bind.
 	return _DistributionModule.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetCommunityPool is a free data retrieval call binding the contract method 0x382d823c.
+//
+// Solidity: function getCommunityPool() view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetCommunityPool(opts *bind.CallOpts) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getCommunityPool")
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetCommunityPool is a free data retrieval call binding the contract method 0x382d823c.
+//
+// Solidity: function getCommunityPool() view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetCommunityPool() ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetCommunityPool(&_DistributionModule.CallOpts)
+}
+
+// GetCommunityPool is a free data retrieval call binding the contract method 0x382d823c.
+//
+// Solidity: function getCommunityPool() view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetCommunityPool() ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetCommunityPool(&_DistributionModule.CallOpts)
+}
+
+// GetDelegationRewards is a free data retrieval call binding the contract method 0xc662fe64.
+//
+// Solidity: function getDelegationRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetDelegationRewards(opts *bind.CallOpts, delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getDelegationRewards", delegator, validator)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetDelegationRewards is a free data retrieval call binding the contract method 0xc662fe64.
+//
+// Solidity: function getDelegationRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetDelegationRewards(delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetDelegationRewards(&_DistributionModule.CallOpts, delegator, validator)
+}
+
+// GetDelegationRewards is a free data retrieval call binding the contract method 0xc662fe64.
+//
+// Solidity: function getDelegationRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetDelegationRewards(delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetDelegationRewards(&_DistributionModule.CallOpts, delegator, validator)
+}
+
+// GetDelegationTotalRewards is a free data retrieval call binding the contract method 0xe236c7a6.
+//
+// Solidity: function getDelegationTotalRewards(address delegator) view returns((address,(uint256,string)[])[], (uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetDelegationTotalRewards(opts *bind.CallOpts, delegator common.Address) (struct {
+	Arg0 []IDistributionModuleValidatorReward
+	Arg1 []CosmosCoin
+}, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getDelegationTotalRewards", delegator)
+
+	outstruct := new(struct {
+		Arg0 []IDistributionModuleValidatorReward
+		Arg1 []CosmosCoin
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IDistributionModuleValidatorReward)).(*[]IDistributionModuleValidatorReward)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return *outstruct, err
+
+}
+
+// GetDelegationTotalRewards is a free data retrieval call binding the contract method 0xe236c7a6.
+//
+// Solidity: function getDelegationTotalRewards(address delegator) view returns((address,(uint256,string)[])[], (uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetDelegationTotalRewards(delegator common.Address) (struct {
+	Arg0 []IDistributionModuleValidatorReward
+	Arg1 []CosmosCoin
+}, error) {
+	return _DistributionModule.Contract.GetDelegationTotalRewards(&_DistributionModule.CallOpts, delegator)
+}
+
+// GetDelegationTotalRewards is a free data retrieval call binding the contract method 0xe236c7a6.
+//
+// Solidity: function getDelegationTotalRewards(address delegator) view returns((address,(uint256,string)[])[], (uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetDelegationTotalRewards(delegator common.Address) (struct {
+	Arg0 []IDistributionModuleValidatorReward
+	Arg1 []CosmosCoin
+}, error) {
+	return _DistributionModule.Contract.GetDelegationTotalRewards(&_DistributionModule.CallOpts, delegator)
+}
+
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetValidatorCommission(opts *bind.CallOpts, validator common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getValidatorCommission", validator)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetValidatorCommission(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorCommission(&_DistributionModule.CallOpts, validator)
+}
+
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetValidatorCommission(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorCommission(&_DistributionModule.CallOpts, validator)
+}
+
+// GetValidatorOutstandingRewards is a free data retrieval call binding the contract method 0xa76d00a8.
+//
+// Solidity: function getValidatorOutstandingRewards(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetValidatorOutstandingRewards(opts *bind.CallOpts, validator common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getValidatorOutstandingRewards", validator)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetValidatorOutstandingRewards is a free data retrieval call binding the contract method 0xa76d00a8.
+//
+// Solidity: function getValidatorOutstandingRewards(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetValidatorOutstandingRewards(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorOutstandingRewards(&_DistributionModule.CallOpts, validator)
+}
+
+// GetValidatorOutstandingRewards is a free data retrieval call binding the contract method 0xa76d00a8.
+//
+// Solidity: function getValidatorOutstandingRewards(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetValidatorOutstandingRewards(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorOutstandingRewards(&_DistributionModule.CallOpts, validator)
+}
+
 // GetWithdrawEnabled is a free data retrieval call binding the contract method 0x39cc4c86.
 //
 // Solidity: function getWithdrawEnabled() view returns(bool)
@@ -217,6 +392,27 @@ func (_DistributionModule *DistributionModuleCallerSession) GetWithdrawEnabled()
 	return _DistributionModule.Contract.GetWithdrawEnabled(&_DistributionModule.CallOpts)
 }
 
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleTransactor) FundCommunityPool(opts *bind.TransactOpts, amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.contract.Transact(opts, "fundCommunityPool", amount)
+}
+
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleSession) FundCommunityPool(amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.Contract.FundCommunityPool(&_DistributionModule.TransactOpts, amount)
+}
+
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleTransactorSession) FundCommunityPool(amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.Contract.FundCommunityPool(&_DistributionModule.TransactOpts, amount)
+}
+
 // SetWithdrawAddress is a paid mutator transaction binding the contract method 0x3ab1a494.
 //
 // Solidity: function setWithdrawAddress(address withdrawAddress) returns(bool)
@@ -259,6 +455,27 @@ func (_DistributionModule *DistributionModuleTransactorSession) SetWithdrawAddre
 	return _DistributionModule.Contract.SetWithdrawAddress0(&_DistributionModule.TransactOpts, withdrawAddress)
 }
 
+// WithdrawAllDelegatorRewards is a paid mutator transaction binding the contract method 0x5810579d.
+//
+// Solidity: function withdrawAllDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactor) WithdrawAllDelegatorRewards(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DistributionModule.contract.Transact(opts, "withdrawAllDelegatorRewards")
+}
+
+// WithdrawAllDelegatorRewards is a paid mutator transaction binding the contract method 0x5810579d.
+//
+// Solidity: function withdrawAllDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) WithdrawAllDelegatorRewards() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawAllDelegatorRewards(&_DistributionModule.TransactOpts)
+}
+
+// WithdrawAllDelegatorRewards is a paid mutator transaction binding the contract method 0x5810579d.
+//
+// Solidity: function withdrawAllDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactorSession) WithdrawAllDelegatorRewards() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawAllDelegatorRewards(&_DistributionModule.TransactOpts)
+}
+
 // WithdrawDelegatorReward is a paid mutator transaction binding the contract method 0x562c67a4.
 //
 // Solidity: function withdrawDelegatorReward(address delegator, address validator) returns((uint256,string)[])
@@ -301,6 +518,27 @@ func (_DistributionModule *DistributionModuleTransactorSession) WithdrawDelegato
 	return _DistributionModule.Contract.WithdrawDelegatorReward0(&_DistributionModule.TransactOpts, delegator, validator)
 }
 
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactor) WithdrawValidatorCommission(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DistributionModule.contract.Transact(opts, "withdrawValidatorCommission")
+}
+
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) WithdrawValidatorCommission() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawValidatorCommission(&_DistributionModule.TransactOpts)
+}
+
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactorSession) WithdrawValidatorCommission() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawValidatorCommission(&_DistributionModule.TransactOpts)
+}
+
 // DistributionModuleSetWithdrawAddressIterator is returned from FilterSetWithdrawAddress and is used to iterate over the raw logs and unpacked data for SetWithdrawAddress events raised by the DistributionModule contract.
 type DistributionModuleSetWithdrawAddressIterator struct {
 	Event *DistributionModuleSetWithdrawAddress // Event containing the contract specifics and raw log
@@ -445,6 +683,140 @@ func (_DistributionModule *DistributionModuleFilterer) ParseSetWithdrawAddress(l
 	return event, nil
 }
 
+// DistributionModuleWithdrawCommissionIterator is returned from FilterWithdrawCommission and is used to iterate over the raw logs and unpacked data for WithdrawCommission events raised by the DistributionModule contract.
+type DistributionModuleWithdrawCommissionIterator struct {
+	Event *DistributionModuleWithdrawCommission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DistributionModuleWithdrawCommissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DistributionModuleWithdrawCommission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DistributionModuleWithdrawCommission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DistributionModuleWithdrawCommissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DistributionModuleWithdrawCommissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DistributionModuleWithdrawCommission represents a WithdrawCommission event raised by the DistributionModule contract.
+type DistributionModuleWithdrawCommission struct {
+	Amount []CosmosCoin
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawCommission is a free log retrieval operation binding the contract event 0x550e6baa26475c9853b64e83615a3be1331831f8e3477183db2ee49324970d01.
+//
+// Solidity: event WithdrawCommission((uint256,string)[] amount)
+func (_DistributionModule *DistributionModuleFilterer) FilterWithdrawCommission(opts *bind.FilterOpts) (*DistributionModuleWithdrawCommissionIterator, error) {
+
+	logs, sub, err := _DistributionModule.contract.FilterLogs(opts, "WithdrawCommission")
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionModuleWithdrawCommissionIterator{contract: _DistributionModule.contract, event: "WithdrawCommission", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawCommission is a free log subscription operation binding the contract event 0x550e6baa26475c9853b64e83615a3be1331831f8e3477183db2ee49324970d01.
+//
+// Solidity: event WithdrawCommission((uint256,string)[] amount)
+func (_DistributionModule *DistributionModuleFilterer) WatchWithdrawCommission(opts *bind.WatchOpts, sink chan<- *DistributionModuleWithdrawCommission) (event.Subscription, error) {
+
+	logs, sub, err := _DistributionModule.contract.WatchLogs(opts, "WithdrawCommission")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DistributionModuleWithdrawCommission)
+				if err := _DistributionModule.contract.UnpackLog(event, "WithdrawCommission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawCommission is a log parse operation binding the contract event 0x550e6baa26475c9853b64e83615a3be1331831f8e3477183db2ee49324970d01.
+//
+// Solidity: event WithdrawCommission((uint256,string)[] amount)
+func (_DistributionModule *DistributionModuleFilterer) ParseWithdrawCommission(log types.Log) (*DistributionModuleWithdrawCommission, error) {
+	event := new(DistributionModuleWithdrawCommission)
+	if err := _DistributionModule.contract.UnpackLog(event, "WithdrawCommission", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // DistributionModuleWithdrawRewardsIterator is returned from FilterWithdrawRewards and is used to iterate over the raw logs and unpacked data for WithdrawRewards events raised by the DistributionModule contract.
 type DistributionModuleWithdrawRewardsIterator struct {
 	Event *DistributionModuleWithdrawRewards // Event containing the contract specifics and raw log
@@ -35,6 +35,60 @@ type CosmosCoin struct {
 	Denom  string
 }
 
+// IGovernanceModuleBankSend is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleBankSend struct {
+	ToAddress common.Address
+	Amount    []CosmosCoin
+}
+
+// IGovernanceModuleCommunityPoolSpend is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleCommunityPoolSpend struct {
+	Recipient common.Address
+	Amount    []CosmosCoin
+}
+
+// IGovernanceModuleDeposit is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleDeposit struct {
+	ProposalId uint64
+	Depositor  common.Address
+	Amount     []CosmosCoin
+}
+
+// IGovernanceModuleMessage is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleMessage struct {
+	TypeUrl string
+	Value   []byte
+}
+
+// IGovernanceModulePageRequest is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModulePageRequest struct {
+	Key        []byte
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// IGovernanceModulePageResponse is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModulePageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
+// IGovernanceModuleParams is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleParams struct {
+	MinDeposit                 []CosmosCoin
+	MaxDepositPeriod           uint64
+	VotingPeriod               uint64
+	Quorum                     string
+	Threshold                  string
+	VetoThreshold              string
+	MinInitialDepositRatio     string
+	BurnVoteQuorum             bool
+	BurnProposalDepositPrevote bool
+	BurnVoteVeto               bool
+}
+
 // IGovernanceModuleProposal is an auto generated low-level Go binding around an user-defined struct.
 type IGovernanceModuleProposal struct {
 	Id               uint64
@@ -52,6 +106,15 @@ type IGovernanceModuleProposal struct {
 	Proposer         string
 }
 
+// IGovernanceModuleProposalDetails is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleProposalDetails struct {
+	Title          string
+	Summary        string
+	Metadata       string
+	InitialDeposit []CosmosCoin
+	Expedited      bool
+}
+
 // IGovernanceModuleTallyResult is an auto generated low-level Go binding around an user-defined struct.
 type IGovernanceModuleTallyResult struct {
 	YesCount        string
@@ -60,6 +123,14 @@ type IGovernanceModuleTallyResult struct {
 	NoWithVetoCount string
 }
 
+// IGovernanceModuleVote is an auto generated low-level Go binding around an user-defined struct.
+type IGovernanceModuleVote struct {
+	ProposalId uint64
+	Voter      common.Address
+	Options    []IGovernanceModuleWeightedVoteOption
+	Metadata   string
+}
+
 // IGovernanceModuleWeightedVoteOption is an auto generated low-level Go binding around an user-defined struct.
 type IGovernanceModuleWeightedVoteOption struct {
 	VoteOption int32
@@ -68,7 +139,7 @@ type IGovernanceModuleWeightedVoteOption struct {
 
 // GovernanceModuleMetaData contains all meta data concerning the GovernanceModule contract.
 var GovernanceModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"CancelProposal\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"ProposalDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"option\",\"type\":\"string\"}],\"name\":\"ProposalVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"SubmitProposal\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"cancelProposal\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"yesCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"abstainCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noWithVetoCount\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.TallyResult\",\"name\":\"finalTallyResult\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"submitTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"depositEndTime\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"totalDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"votingStartTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"votingEndTime\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"proposer\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Proposal\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int32\",\"name\":\"proposalStatus\",\"type\":\"int32\"}],\"name\":\"getProposals\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"yesCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"abstainCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noWithVetoCount\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.TallyResult\",\"name\":\"finalTallyResult\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"submitTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"depositEndTime\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"totalDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"votingStartTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"votingEndTime\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"proposer\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Proposal[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"proposal\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"submitProposal\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"int32\",\"name\":\"option\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"voteOption\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"weight\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"voteWeighted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"CancelProposal\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"ProposalDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"option\",\"type\":\"string\"}],\"name\":\"ProposalVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"SubmitProposal\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"cancelProposal\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIGovernanceModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getDeposits\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"depositor\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"internalType\":\"structIGovernanceModule.Deposit[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIGovernanceModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getParams\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"minDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"maxDepositPeriod\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"votingPeriod\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"quorum\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"threshold\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"vetoThreshold\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"minInitialDepositRatio\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"burnVoteQuorum\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"burnProposalDepositPrevote\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"burnVoteVeto\",\"type\":\"bool\"}],\"internalType\":\"structIGovernanceModule.Params\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"yesCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"abstainCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noWithVetoCount\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.TallyResult\",\"name\":\"finalTallyResult\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"submitTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"depositEndTime\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"totalDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"votingStartTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"votingEndTime\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"proposer\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Proposal\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int32\",\"name\":\"proposalStatus\",\"type\":\"int32\"}],\"name\":\"getProposals\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"yesCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"abstainCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noWithVetoCount\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.TallyResult\",\"name\":\"finalTallyResult\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"submitTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"depositEndTime\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"totalDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"votingStartTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"votingEndTime\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"proposer\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Proposal[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getTallyResult\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"yesCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"abstainCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noCount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"noWithVetoCount\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.TallyResult\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"getVote\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"voteOption\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"weight\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Vote\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIGovernanceModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getVotes\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"voteOption\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"weight\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.Vote[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIGovernanceModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"proposal\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"submitProposal\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"initialDeposit\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"expedited\",\"type\":\"bool\"}],\"internalType\":\"structIGovernanceModule.ProposalDetails\",\"name\":\"details\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"toAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"internalType\":\"structIGovernanceModule.BankSend[]\",\"name\":\"sends\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"internalType\":\"structIGovernanceModule.CommunityPoolSpend[]\",\"name\":\"communityPoolSpends\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"typeUrl\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"value\",\"type\":\"bytes\"}],\"internalType\":\"structIGovernanceModule.Message[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"name\":\"submitProposal\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"int32\",\"name\":\"option\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"voteOption\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"weight\",\"type\":\"string\"}],\"internalType\":\"structIGovernanceModule.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"voteWeighted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // GovernanceModuleABI is the input ABI used to generate the binding from.
@@ -217,6 +288,82 @@ func (_GovernanceModule *GovernanceModuleTransactorRaw) Transact(opts *bind.Tran
 	return _GovernanceModule.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetDeposits is a free data retrieval call binding the contract method 0x5e982a9b.
+//
+// Solidity: function getDeposits(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(uint256,string)[])[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleCaller) GetDeposits(opts *bind.CallOpts, proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleDeposit
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _GovernanceModule.contract.Call(opts, &out, "getDeposits", proposalId, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IGovernanceModuleDeposit
+		Arg1 IGovernanceModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IGovernanceModuleDeposit)).(*[]IGovernanceModuleDeposit)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IGovernanceModulePageResponse)).(*IGovernanceModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetDeposits is a free data retrieval call binding the contract method 0x5e982a9b.
+//
+// Solidity: function getDeposits(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(uint256,string)[])[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleSession) GetDeposits(proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleDeposit
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	return _GovernanceModule.Contract.GetDeposits(&_GovernanceModule.CallOpts, proposalId, pagination)
+}
+
+// GetDeposits is a free data retrieval call binding the contract method 0x5e982a9b.
+//
+// Solidity: function getDeposits(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(uint256,string)[])[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleCallerSession) GetDeposits(proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleDeposit
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	return _GovernanceModule.Contract.GetDeposits(&_GovernanceModule.CallOpts, proposalId, pagination)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(((uint256,string)[],uint64,uint64,string,string,string,string,bool,bool,bool))
+func (_GovernanceModule *GovernanceModuleCaller) GetParams(opts *bind.CallOpts) (IGovernanceModuleParams, error) {
+	var out []interface{}
+	err := _GovernanceModule.contract.Call(opts, &out, "getParams")
+
+	if err != nil {
+		return *new(IGovernanceModuleParams), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovernanceModuleParams)).(*IGovernanceModuleParams)
+
+	return out0, err
+
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(((uint256,string)[],uint64,uint64,string,string,string,string,bool,bool,bool))
+func (_GovernanceModule *GovernanceModuleSession) GetParams() (IGovernanceModuleParams, error) {
+	return _GovernanceModule.Contract.GetParams(&_GovernanceModule.CallOpts)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(((uint256,string)[],uint64,uint64,string,string,string,string,bool,bool,bool))
+func (_GovernanceModule *GovernanceModuleCallerSession) GetParams() (IGovernanceModuleParams, error) {
+	return _GovernanceModule.Contract.GetParams(&_GovernanceModule.CallOpts)
+}
+
 // GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
 //
 // Solidity: function getProposal(uint64 proposalId) view returns((uint64,bytes,int32,(string,string,string,string),uint64,uint64,(uint256,string)[],uint64,uint64,string,string,string,string))
@@ -279,6 +426,113 @@ func (_GovernanceModule *GovernanceModuleCallerSession) GetProposals(proposalSta
 	return _GovernanceModule.Contract.GetProposals(&_GovernanceModule.CallOpts, proposalStatus)
 }
 
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns((string,string,string,string))
+func (_GovernanceModule *GovernanceModuleCaller) GetTallyResult(opts *bind.CallOpts, proposalId uint64) (IGovernanceModuleTallyResult, error) {
+	var out []interface{}
+	err := _GovernanceModule.contract.Call(opts, &out, "getTallyResult", proposalId)
+
+	if err != nil {
+		return *new(IGovernanceModuleTallyResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovernanceModuleTallyResult)).(*IGovernanceModuleTallyResult)
+
+	return out0, err
+
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns((string,string,string,string))
+func (_GovernanceModule *GovernanceModuleSession) GetTallyResult(proposalId uint64) (IGovernanceModuleTallyResult, error) {
+	return _GovernanceModule.Contract.GetTallyResult(&_GovernanceModule.CallOpts, proposalId)
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns((string,string,string,string))
+func (_GovernanceModule *GovernanceModuleCallerSession) GetTallyResult(proposalId uint64) (IGovernanceModuleTallyResult, error) {
+	return _GovernanceModule.Contract.GetTallyResult(&_GovernanceModule.CallOpts, proposalId)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x335e4f9a.
+//
+// Solidity: function getVote(uint64 proposalId, address voter) view returns((uint64,address,(int32,string)[],string))
+func (_GovernanceModule *GovernanceModuleCaller) GetVote(opts *bind.CallOpts, proposalId uint64, voter common.Address) (IGovernanceModuleVote, error) {
+	var out []interface{}
+	err := _GovernanceModule.contract.Call(opts, &out, "getVote", proposalId, voter)
+
+	if err != nil {
+		return *new(IGovernanceModuleVote), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovernanceModuleVote)).(*IGovernanceModuleVote)
+
+	return out0, err
+
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x335e4f9a.
+//
+// Solidity: function getVote(uint64 proposalId, address voter) view returns((uint64,address,(int32,string)[],string))
+func (_GovernanceModule *GovernanceModuleSession) GetVote(proposalId uint64, voter common.Address) (IGovernanceModuleVote, error) {
+	return _GovernanceModule.Contract.GetVote(&_GovernanceModule.CallOpts, proposalId, voter)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x335e4f9a.
+//
+// Solidity: function getVote(uint64 proposalId, address voter) view returns((uint64,address,(int32,string)[],string))
+func (_GovernanceModule *GovernanceModuleCallerSession) GetVote(proposalId uint64, voter common.Address) (IGovernanceModuleVote, error) {
+	return _GovernanceModule.Contract.GetVote(&_GovernanceModule.CallOpts, proposalId, voter)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0xe2bb86da.
+//
+// Solidity: function getVotes(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(int32,string)[],string)[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleCaller) GetVotes(opts *bind.CallOpts, proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleVote
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _GovernanceModule.contract.Call(opts, &out, "getVotes", proposalId, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IGovernanceModuleVote
+		Arg1 IGovernanceModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IGovernanceModuleVote)).(*[]IGovernanceModuleVote)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IGovernanceModulePageResponse)).(*IGovernanceModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0xe2bb86da.
+//
+// Solidity: function getVotes(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(int32,string)[],string)[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleSession) GetVotes(proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleVote
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	return _GovernanceModule.Contract.GetVotes(&_GovernanceModule.CallOpts, proposalId, pagination)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0xe2bb86da.
+//
+// Solidity: function getVotes(uint64 proposalId, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,address,(int32,string)[],string)[], (bytes,uint64))
+func (_GovernanceModule *GovernanceModuleCallerSession) GetVotes(proposalId uint64, pagination IGovernanceModulePageRequest) (struct {
+	Arg0 []IGovernanceModuleVote
+	Arg1 IGovernanceModulePageResponse
+}, error) {
+	return _GovernanceModule.Contract.GetVotes(&_GovernanceModule.CallOpts, proposalId, pagination)
+}
+
 // CancelProposal is a paid mutator transaction binding the contract method 0x37a9a59e.
 //
 // Solidity: function cancelProposal(uint64 proposalId) returns(uint64, uint64)
@@ -300,6 +554,27 @@ func (_GovernanceModule *GovernanceModuleTransactorSession) CancelProposal(propo
 	return _GovernanceModule.Contract.CancelProposal(&_GovernanceModule.TransactOpts, proposalId)
 }
 
+// Deposit is a paid mutator transaction binding the contract method 0xa8adafdd.
+//
+// Solidity: function deposit(uint64 proposalId, (uint256,string)[] amount) returns(bool)
+func (_GovernanceModule *GovernanceModuleTransactor) Deposit(opts *bind.TransactOpts, proposalId uint64, amount []CosmosCoin) (*types.Transaction, error) {
+	return _GovernanceModule.contract.Transact(opts, "deposit", proposalId, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xa8adafdd.
+//
+// Solidity: function deposit(uint64 proposalId, (uint256,string)[] amount) returns(bool)
+func (_GovernanceModule *GovernanceModuleSession) Deposit(proposalId uint64, amount []CosmosCoin) (*types.Transaction, error) {
+	return _GovernanceModule.Contract.Deposit(&_GovernanceModule.TransactOpts, proposalId, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xa8adafdd.
+//
+// Solidity: function deposit(uint64 proposalId, (uint256,string)[] amount) returns(bool)
+func (_GovernanceModule *GovernanceModuleTransactorSession) Deposit(proposalId uint64, amount []CosmosCoin) (*types.Transaction, error) {
+	return _GovernanceModule.Contract.Deposit(&_GovernanceModule.TransactOpts, proposalId, amount)
+}
+
 // SubmitProposal is a paid mutator transaction binding the contract method 0x474d7f35.
 //
 // Solidity: function submitProposal(bytes proposal, bytes message) returns(uint64)
@@ -321,6 +596,27 @@ func (_GovernanceModule *GovernanceModuleTransactorSession) SubmitProposal(propo
 	return _GovernanceModule.Contract.SubmitProposal(&_GovernanceModule.TransactOpts, proposal, message)
 }
 
+// SubmitProposal0 is a paid mutator transaction binding the contract method 0x53afc2ba.
+//
+// Solidity: function submitProposal((string,string,string,(uint256,string)[],bool) details, (address,(uint256,string)[])[] sends, (address,(uint256,string)[])[] communityPoolSpends, (string,bytes)[] messages) returns(uint64)
+func (_GovernanceModule *GovernanceModuleTransactor) SubmitProposal0(opts *bind.TransactOpts, details IGovernanceModuleProposalDetails, sends []IGovernanceModuleBankSend, communityPoolSpends []IGovernanceModuleCommunityPoolSpend, messages []IGovernanceModuleMessage) (*types.Transaction, error) {
+	return _GovernanceModule.contract.Transact(opts, "submitProposal0", details, sends, communityPoolSpends, messages)
+}
+
+// SubmitProposal0 is a paid mutator transaction binding the contract method 0x53afc2ba.
+//
+// Solidity: function submitProposal((string,string,string,(uint256,string)[],bool) details, (address,(uint256,string)[])[] sends, (address,(uint256,string)[])[] communityPoolSpends, (string,bytes)[] messages) returns(uint64)
+func (_GovernanceModule *GovernanceModuleSession) SubmitProposal0(details IGovernanceModuleProposalDetails, sends []IGovernanceModuleBankSend, communityPoolSpends []IGovernanceModuleCommunityPoolSpend, messages []IGovernanceModuleMessage) (*types.Transaction, error) {
+	return _GovernanceModule.Contract.SubmitProposal0(&_GovernanceModule.TransactOpts, details, sends, communityPoolSpends, messages)
+}
+
+// SubmitProposal0 is a paid mutator transaction binding the contract method 0x53afc2ba.
+//
+// Solidity: function submitProposal((string,string,string,(uint256,string)[],bool) details, (address,(uint256,string)[])[] sends, (address,(uint256,string)[])[] communityPoolSpends, (string,bytes)[] messages) returns(uint64)
+func (_GovernanceModule *GovernanceModuleTransactorSession) SubmitProposal0(details IGovernanceModuleProposalDetails, sends []IGovernanceModuleBankSend, communityPoolSpends []IGovernanceModuleCommunityPoolSpend, messages []IGovernanceModuleMessage) (*types.Transaction, error) {
+	return _GovernanceModule.Contract.SubmitProposal0(&_GovernanceModule.TransactOpts, details, sends, communityPoolSpends, messages)
+}
+
 // Vote is a paid mutator transaction binding the contract method 0x19f7a0fb.
 //
 // Solidity: function vote(uint64 proposalId, int32 option, string metadata) returns(bool)
@@ -35,6 +35,61 @@ type CosmosCoin struct {
 	Denom  string
 }
 
+// IStakingModuleCommission is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleCommission struct {
+	CommissionRates IStakingModuleCommissionRates
+	UpdateTime      int64
+}
+
+// IStakingModuleCommissionRates is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleCommissionRates struct {
+	Rate          *big.Int
+	MaxRate       *big.Int
+	MaxChangeRate *big.Int
+}
+
+// IStakingModuleDelegation is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleDelegation struct {
+	Delegator common.Address
+	Validator common.Address
+	Shares    *big.Int
+	Balance   *big.Int
+}
+
+// IStakingModuleDescription is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleDescription struct {
+	Moniker         string
+	Identity        string
+	Website         string
+	SecurityContact string
+	Details         string
+}
+
+// IStakingModulePageRequest is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModulePageRequest struct {
+	Key        []byte
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// IStakingModulePageResponse is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModulePageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
+// IStakingModuleParams is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleParams struct {
+	UnbondingTime     int64
+	MaxValidators     uint32
+	MaxEntries        uint32
+	HistoricalEntries uint32
+	BondDenom         string
+	MinCommissionRate *big.Int
+}
+
 // IStakingModuleRedelegationEntry is an auto generated low-level Go binding around an user-defined struct.
 type IStakingModuleRedelegationEntry struct {
 	CreationHeight int64
@@ -53,9 +108,24 @@ type IStakingModuleUnbondingDelegationEntry struct {
 	UnbondingId    uint64
 }
 
+// IStakingModuleValidator is an auto generated low-level Go binding around an user-defined struct.
+type IStakingModuleValidator struct {
+	OperatorAddress   common.Address
+	ConsensusPubkey   []byte
+	Jailed            bool
+	Status            string
+	Tokens            *big.Int
+	DelegatorShares   *big.Int
+	Description       IStakingModuleDescription
+	UnbondingHeight   int64
+	UnbondingTime     int64
+	Commission        IStakingModuleCommission
+	MinSelfDelegation *big.Int
+}
+
 // StakingModuleMetaData contains all meta data concerning the StakingModule contract.
 var StakingModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"CancelUnbondingDelegation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CreateValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Delegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sourceValidator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationValidator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Redelegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Unbond\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"srcValidator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstValidator\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"beginRedelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"srcValidator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"dstValidator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"beginRedelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"delegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"delegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getActiveValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"}],\"name\":\"getDelegation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"}],\"name\":\"getDelegation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"srcValidator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"dstValidator\",\"type\":\"address\"}],\"name\":\"getRedelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"sharesDst\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.RedelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"srcValidator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstValidator\",\"type\":\"string\"}],\"name\":\"getRedelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"sharesDst\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.RedelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"}],\"name\":\"getUnbondingDelegation\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.UnbondingDelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"}],\"name\":\"getUnbondingDelegation\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.UnbondingDelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"CancelUnbondingDelegation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CreateValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Delegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"commissionRate\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"minSelfDelegation\",\"type\":\"string\"}],\"name\":\"EditValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sourceValidator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationValidator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Redelegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Unbond\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"srcValidator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstValidator\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"beginRedelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"srcValidator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"dstValidator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"beginRedelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"securityContact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structIStakingModule.Description\",\"name\":\"description\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxChangeRate\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.CommissionRates\",\"name\":\"commissionRates\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"minSelfDelegation\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"pubkey\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"createValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"delegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"delegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"securityContact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structIStakingModule.Description\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"int256\",\"name\":\"commissionRate\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"minSelfDelegation\",\"type\":\"int256\"}],\"name\":\"editValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getActiveValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"}],\"name\":\"getDelegation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"}],\"name\":\"getDelegation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIStakingModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getDelegatorDelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.Delegation[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getParams\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"unbondingTime\",\"type\":\"int64\"},{\"internalType\":\"uint32\",\"name\":\"maxValidators\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"maxEntries\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"historicalEntries\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"bondDenom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"minCommissionRate\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.Params\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPool\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"notBondedTokens\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedTokens\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"srcValidator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"dstValidator\",\"type\":\"address\"}],\"name\":\"getRedelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"sharesDst\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.RedelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"srcValidator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstValidator\",\"type\":\"string\"}],\"name\":\"getRedelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"sharesDst\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.RedelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatorAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"}],\"name\":\"getUnbondingDelegation\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.UnbondingDelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"delegatorAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"}],\"name\":\"getUnbondingDelegation\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"unbondingId\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.UnbondingDelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"consensusPubkey\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"jailed\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"status\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"tokens\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegatorShares\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"securityContact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structIStakingModule.Description\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"unbondingHeight\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"unbondingTime\",\"type\":\"int64\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxChangeRate\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.CommissionRates\",\"name\":\"commissionRates\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"updateTime\",\"type\":\"int64\"}],\"internalType\":\"structIStakingModule.Commission\",\"name\":\"commission\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"minSelfDelegation\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIStakingModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getValidatorDelegations\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.Delegation[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"status\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structIStakingModule.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getValidators\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"consensusPubkey\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"jailed\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"status\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"tokens\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegatorShares\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"securityContact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structIStakingModule.Description\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"unbondingHeight\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"unbondingTime\",\"type\":\"int64\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxChangeRate\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.CommissionRates\",\"name\":\"commissionRates\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"updateTime\",\"type\":\"int64\"}],\"internalType\":\"structIStakingModule.Commission\",\"name\":\"commission\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"minSelfDelegation\",\"type\":\"uint256\"}],\"internalType\":\"structIStakingModule.Validator[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structIStakingModule.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
 }
 
 // StakingModuleABI is the input ABI used to generate the binding from.
@@ -297,6 +367,127 @@ func (_StakingModule *StakingModuleCallerSession) GetDelegation0(delegatorAddres
 	return _StakingModule.Contract.GetDelegation0(&_StakingModule.CallOpts, delegatorAddress, validatorAddress)
 }
 
+// GetDelegatorDelegations is a free data retrieval call binding the contract method 0x768fcc2e.
+//
+// Solidity: function getDelegatorDelegations(address delegatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCaller) GetDelegatorDelegations(opts *bind.CallOpts, delegatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getDelegatorDelegations", delegatorAddress, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IStakingModuleDelegation
+		Arg1 IStakingModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IStakingModuleDelegation)).(*[]IStakingModuleDelegation)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IStakingModulePageResponse)).(*IStakingModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetDelegatorDelegations is a free data retrieval call binding the contract method 0x768fcc2e.
+//
+// Solidity: function getDelegatorDelegations(address delegatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleSession) GetDelegatorDelegations(delegatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetDelegatorDelegations(&_StakingModule.CallOpts, delegatorAddress, pagination)
+}
+
+// GetDelegatorDelegations is a free data retrieval call binding the contract method 0x768fcc2e.
+//
+// Solidity: function getDelegatorDelegations(address delegatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCallerSession) GetDelegatorDelegations(delegatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetDelegatorDelegations(&_StakingModule.CallOpts, delegatorAddress, pagination)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns((int64,uint32,uint32,uint32,string,uint256))
+func (_StakingModule *StakingModuleCaller) GetParams(opts *bind.CallOpts) (IStakingModuleParams, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getParams")
+
+	if err != nil {
+		return *new(IStakingModuleParams), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IStakingModuleParams)).(*IStakingModuleParams)
+
+	return out0, err
+
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns((int64,uint32,uint32,uint32,string,uint256))
+func (_StakingModule *StakingModuleSession) GetParams() (IStakingModuleParams, error) {
+	return _StakingModule.Contract.GetParams(&_StakingModule.CallOpts)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns((int64,uint32,uint32,uint32,string,uint256))
+func (_StakingModule *StakingModuleCallerSession) GetParams() (IStakingModuleParams, error) {
+	return _StakingModule.Contract.GetParams(&_StakingModule.CallOpts)
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x026b1d5f.
+//
+// Solidity: function getPool() view returns(uint256 notBondedTokens, uint256 bondedTokens)
+func (_StakingModule *StakingModuleCaller) GetPool(opts *bind.CallOpts) (struct {
+	NotBondedTokens *big.Int
+	BondedTokens    *big.Int
+}, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getPool")
+
+	outstruct := new(struct {
+		NotBondedTokens *big.Int
+		BondedTokens    *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.NotBondedTokens = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.BondedTokens = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x026b1d5f.
+//
+// Solidity: function getPool() view returns(uint256 notBondedTokens, uint256 bondedTokens)
+func (_StakingModule *StakingModuleSession) GetPool() (struct {
+	NotBondedTokens *big.Int
+	BondedTokens    *big.Int
+}, error) {
+	return _StakingModule.Contract.GetPool(&_StakingModule.CallOpts)
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x026b1d5f.
+//
+// Solidity: function getPool() view returns(uint256 notBondedTokens, uint256 bondedTokens)
+func (_StakingModule *StakingModuleCallerSession) GetPool() (struct {
+	NotBondedTokens *big.Int
+	BondedTokens    *big.Int
+}, error) {
+	return _StakingModule.Contract.GetPool(&_StakingModule.CallOpts)
+}
+
 // GetRedelegations is a free data retrieval call binding the contract method 0x2c02d2fd.
 //
 // Solidity: function getRedelegations(address delegatorAddress, address srcValidator, address dstValidator) view returns((int64,string,uint256,uint256,uint64)[])
@@ -421,6 +612,127 @@ func (_StakingModule *StakingModuleCallerSession) GetUnbondingDelegation0(delega
 	return _StakingModule.Contract.GetUnbondingDelegation0(&_StakingModule.CallOpts, delegatorAddress, validatorAddress)
 }
 
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address validatorAddress) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256))
+func (_StakingModule *StakingModuleCaller) GetValidator(opts *bind.CallOpts, validatorAddress common.Address) (IStakingModuleValidator, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getValidator", validatorAddress)
+
+	if err != nil {
+		return *new(IStakingModuleValidator), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IStakingModuleValidator)).(*IStakingModuleValidator)
+
+	return out0, err
+
+}
+
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address validatorAddress) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256))
+func (_StakingModule *StakingModuleSession) GetValidator(validatorAddress common.Address) (IStakingModuleValidator, error) {
+	return _StakingModule.Contract.GetValidator(&_StakingModule.CallOpts, validatorAddress)
+}
+
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address validatorAddress) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256))
+func (_StakingModule *StakingModuleCallerSession) GetValidator(validatorAddress common.Address) (IStakingModuleValidator, error) {
+	return _StakingModule.Contract.GetValidator(&_StakingModule.CallOpts, validatorAddress)
+}
+
+// GetValidatorDelegations is a free data retrieval call binding the contract method 0x0e7f6a0d.
+//
+// Solidity: function getValidatorDelegations(address validatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCaller) GetValidatorDelegations(opts *bind.CallOpts, validatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getValidatorDelegations", validatorAddress, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IStakingModuleDelegation
+		Arg1 IStakingModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IStakingModuleDelegation)).(*[]IStakingModuleDelegation)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IStakingModulePageResponse)).(*IStakingModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetValidatorDelegations is a free data retrieval call binding the contract method 0x0e7f6a0d.
+//
+// Solidity: function getValidatorDelegations(address validatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleSession) GetValidatorDelegations(validatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetValidatorDelegations(&_StakingModule.CallOpts, validatorAddress, pagination)
+}
+
+// GetValidatorDelegations is a free data retrieval call binding the contract method 0x0e7f6a0d.
+//
+// Solidity: function getValidatorDelegations(address validatorAddress, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,address,uint256,uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCallerSession) GetValidatorDelegations(validatorAddress common.Address, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleDelegation
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetValidatorDelegations(&_StakingModule.CallOpts, validatorAddress, pagination)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb13d4242.
+//
+// Solidity: function getValidators(string status, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCaller) GetValidators(opts *bind.CallOpts, status string, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleValidator
+	Arg1 IStakingModulePageResponse
+}, error) {
+	var out []interface{}
+	err := _StakingModule.contract.Call(opts, &out, "getValidators", status, pagination)
+
+	outstruct := new(struct {
+		Arg0 []IStakingModuleValidator
+		Arg1 IStakingModulePageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Arg0 = *abi.ConvertType(out[0], new([]IStakingModuleValidator)).(*[]IStakingModuleValidator)
+	outstruct.Arg1 = *abi.ConvertType(out[1], new(IStakingModulePageResponse)).(*IStakingModulePageResponse)
+
+	return *outstruct, err
+
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb13d4242.
+//
+// Solidity: function getValidators(string status, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleSession) GetValidators(status string, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleValidator
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetValidators(&_StakingModule.CallOpts, status, pagination)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb13d4242.
+//
+// Solidity: function getValidators(string status, (bytes,uint64,uint64,bool,bool) pagination) view returns((address,bytes,bool,string,uint256,uint256,(string,string,string,string,string),int64,int64,((uint256,uint256,uint256),int64),uint256)[], (bytes,uint64))
+func (_StakingModule *StakingModuleCallerSession) GetValidators(status string, pagination IStakingModulePageRequest) (struct {
+	Arg0 []IStakingModuleValidator
+	Arg1 IStakingModulePageResponse
+}, error) {
+	return _StakingModule.Contract.GetValidators(&_StakingModule.CallOpts, status, pagination)
+}
+
 // BeginRedelegate is a paid mutator transaction binding the contract method 0x2e436cf2.
 //
 // Solidity: function beginRedelegate(string srcValidator, string dstValidator, uint256 amount) payable returns(bool)
@@ -505,6 +817,27 @@ func (_StakingModule *StakingModuleTransactorSession) CancelUnbondingDelegation0
 	return _StakingModule.Contract.CancelUnbondingDelegation0(&_StakingModule.TransactOpts, validatorAddress, amount, creationHeight)
 }
 
+// CreateValidator is a paid mutator transaction binding the contract method 0x3ca00c4a.
+//
+// Solidity: function createValidator((string,string,string,string,string) description, (uint256,uint256,uint256) commissionRates, uint256 minSelfDelegation, bytes pubkey, uint256 amount) payable returns(bool)
+func (_StakingModule *StakingModuleTransactor) CreateValidator(opts *bind.TransactOpts, description IStakingModuleDescription, commissionRates IStakingModuleCommissionRates, minSelfDelegation *big.Int, pubkey []byte, amount *big.Int) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "createValidator", description, commissionRates, minSelfDelegation, pubkey, amount)
+}
+
+// CreateValidator is a paid mutator transaction binding the contract method 0x3ca00c4a.
+//
+// Solidity: function createValidator((string,string,string,string,string) description, (uint256,uint256,uint256) commissionRates, uint256 minSelfDelegation, bytes pubkey, uint256 amount) payable returns(bool)
+func (_StakingModule *StakingModuleSession) CreateValidator(description IStakingModuleDescription, commissionRates IStakingModuleCommissionRates, minSelfDelegation *big.Int, pubkey []byte, amount *big.Int) (*types.Transaction, error) {
+	return _StakingModule.Contract.CreateValidator(&_StakingModule.TransactOpts, description, commissionRates, minSelfDelegation, pubkey, amount)
+}
+
+// CreateValidator is a paid mutator transaction binding the contract method 0x3ca00c4a.
+//
+// Solidity: function createValidator((string,string,string,string,string) description, (uint256,uint256,uint256) commissionRates, uint256 minSelfDelegation, bytes pubkey, uint256 amount) payable returns(bool)
+func (_StakingModule *StakingModuleTransactorSession) CreateValidator(description IStakingModuleDescription, commissionRates IStakingModuleCommissionRates, minSelfDelegation *big.Int, pubkey []byte, amount *big.Int) (*types.Transaction, error) {
+	return _StakingModule.Contract.CreateValidator(&_StakingModule.TransactOpts, description, commissionRates, minSelfDelegation, pubkey, amount)
+}
+
 // Delegate is a paid mutator transaction binding the contract method 0x026e402b.
 //
 // Solidity: function delegate(address validatorAddress, uint256 amount) payable returns(bool)
@@ -547,6 +880,27 @@ func (_StakingModule *StakingModuleTransactorSession) Delegate0(validatorAddress
 	return _StakingModule.Contract.Delegate0(&_StakingModule.TransactOpts, validatorAddress, amount)
 }
 
+// EditValidator is a paid mutator transaction binding the contract method 0xe04b807d.
+//
+// Solidity: function editValidator((string,string,string,string,string) description, int256 commissionRate, int256 minSelfDelegation) payable returns(bool)
+func (_StakingModule *StakingModuleTransactor) EditValidator(opts *bind.TransactOpts, description IStakingModuleDescription, commissionRate *big.Int, minSelfDelegation *big.Int) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "editValidator", description, commissionRate, minSelfDelegation)
+}
+
+// EditValidator is a paid mutator transaction binding the contract method 0xe04b807d.
+//
+// Solidity: function editValidator((string,string,string,string,string) description, int256 commissionRate, int256 minSelfDelegation) payable returns(bool)
+func (_StakingModule *StakingModuleSession) EditValidator(description IStakingModuleDescription, commissionRate *big.Int, minSelfDelegation *big.Int) (*types.Transaction, error) {
+	return _StakingModule.Contract.EditValidator(&_StakingModule.TransactOpts, description, commissionRate, minSelfDelegation)
+}
+
+// EditValidator is a paid mutator transaction binding the contract method 0xe04b807d.
+//
+// Solidity: function editValidator((string,string,string,string,string) description, int256 commissionRate, int256 minSelfDelegation) payable returns(bool)
+func (_StakingModule *StakingModuleTransactorSession) EditValidator(description IStakingModuleDescription, commissionRate *big.Int, minSelfDelegation *big.Int) (*types.Transaction, error) {
+	return _StakingModule.Contract.EditValidator(&_StakingModule.TransactOpts, description, commissionRate, minSelfDelegation)
+}
+
 // Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
 //
 // Solidity: function undelegate(address validatorAddress, uint256 amount) payable returns(bool)
@@ -1034,6 +1388,141 @@ func (_StakingModule *StakingModuleFilterer) ParseDelegate(log types.Log) (*Stak
 	return event, nil
 }
 
+// StakingModuleEditValidatorIterator is returned from FilterEditValidator and is used to iterate over the raw logs and unpacked data for EditValidator events raised by the StakingModule contract.
+type StakingModuleEditValidatorIterator struct {
+	Event *StakingModuleEditValidator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleEditValidatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleEditValidator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleEditValidator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleEditValidatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleEditValidatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleEditValidator represents a EditValidator event raised by the StakingModule contract.
+type StakingModuleEditValidator struct {
+	CommissionRate    string
+	MinSelfDelegation string
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterEditValidator is a free log retrieval operation binding the contract event 0x4f1dceb72d6cae57ae0c8d20adba6dd41f1118ced5dae4a33962cdb9a89e2aba.
+//
+// Solidity: event EditValidator(string commissionRate, string minSelfDelegation)
+func (_StakingModule *StakingModuleFilterer) FilterEditValidator(opts *bind.FilterOpts) (*StakingModuleEditValidatorIterator, error) {
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "EditValidator")
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleEditValidatorIterator{contract: _StakingModule.contract, event: "EditValidator", logs: logs, sub: sub}, nil
+}
+
+// WatchEditValidator is a free log subscription operation binding the contract event 0x4f1dceb72d6cae57ae0c8d20adba6dd41f1118ced5dae4a33962cdb9a89e2aba.
+//
+// Solidity: event EditValidator(string commissionRate, string minSelfDelegation)
+func (_StakingModule *StakingModuleFilterer) WatchEditValidator(opts *bind.WatchOpts, sink chan<- *StakingModuleEditValidator) (event.Subscription, error) {
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "EditValidator")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleEditValidator)
+				if err := _StakingModule.contract.UnpackLog(event, "EditValidator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEditValidator is a log parse operation binding the contract event 0x4f1dceb72d6cae57ae0c8d20adba6dd41f1118ced5dae4a33962cdb9a89e2aba.
+//
+// Solidity: event EditValidator(string commissionRate, string minSelfDelegation)
+func (_StakingModule *StakingModuleFilterer) ParseEditValidator(log types.Log) (*StakingModuleEditValidator, error) {
+	event := new(StakingModuleEditValidator)
+	if err := _StakingModule.contract.UnpackLog(event, "EditValidator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // StakingModuleRedelegateIterator is returned from FilterRedelegate and is used to iterate over the raw logs and unpacked data for Redelegate events raised by the StakingModule contract.
 type StakingModuleRedelegateIterator struct {
 	Event *StakingModuleRedelegate // Event containing the contract specifics and raw log
@@ -31,6 +31,10 @@ package contracts
 //go:generate abigen --pkg distribution --abi ./out/Distribution.sol/IDistributionModule.abi.json --bin ./out/Distribution.sol/IDistributionModule.bin --out ./bindings/cosmos/precompile/distribution/i_distribution_module.abigen.go --type DistributionModule --exc "IBankModuleCoin"
 //go:generate abigen --pkg governance --abi ./out/Governance.sol/IGovernanceModule.abi.json --bin ./out/Governance.sol/IGovernanceModule.bin --out ./bindings/cosmos/precompile/governance/i_governance_module.abigen.go --type GovernanceModule
 //go:generate abigen --pkg erc20 --abi ./out/ERC20Module.sol/IERC20Module.abi.json --bin ./out/ERC20Module.sol/IERC20Module.bin --out ./bindings/cosmos/precompile/erc20/i_erc20_module.abigen.go --type ERC20Module
+//go:generate abigen --pkg dispatch --abi ./out/Dispatch.sol/IDispatchModule.abi.json --bin ./out/Dispatch.sol/IDispatchModule.bin --out ./bindings/cosmos/precompile/dispatch/i_dispatch_module.abigen.go --type DispatchModule
+//go:generate abigen --pkg bankerc20 --abi ./out/BankERC20.sol/IBankERC20.abi.json --bin ./out/BankERC20.sol/IBankERC20.bin --out ./bindings/cosmos/precompile/bankerc20/i_bank_erc20.abigen.go --type BankERC20
+//go:generate abigen --pkg feegrant --abi ./out/Feegrant.sol/IFeegrantModule.abi.json --bin ./out/Feegrant.sol/IFeegrantModule.bin --out ./bindings/cosmos/precompile/feegrant/i_feegrant_module.abigen.go --type FeegrantModule
+//go:generate abigen --pkg slashing --abi ./out/Slashing.sol/ISlashingModule.abi.json --bin ./out/Slashing.sol/ISlashingModule.bin --out ./bindings/cosmos/precompile/slashing/i_slashing_module.abigen.go --type SlashingModule
 
 //go:generate abigen --pkg cosmos --abi ./out/GridironERC20.sol/GridironERC20.abi.json --bin ./out/GridironERC20.sol/GridironERC20.bin --out ./bindings/cosmos/gridiron_erc20.abigen.go --type GridironERC20
 
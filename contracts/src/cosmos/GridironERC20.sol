@@ -8,7 +8,7 @@ import {IBankModule} from "./precompile/Bank.sol";
 import {Cosmos} from "./CosmosTypes.sol";
 
 /**
- * @notice Gridiron implementation of ERC20 + EIP-2612.
+ * @notice Gridiron implementation of ERC20 + EIP-2612 + EIP-3009.
  *
  * The GridironERC20 token is used as the ERC20 token representation of IBC-originated coins on
  * Cosmos SDK Gridiron chains. Uses the bank module to actually hold account balances and execute
@@ -24,13 +24,18 @@ contract GridironERC20 is IERC20 {
 
     string public denom;
 
+    string internal _name;
+
+    string internal _symbol;
+
+    uint8 internal immutable _decimals;
+
     /**
      * @dev name is a public view method for reading the `sdk.Coin` name for this erc20.
      * @return string the sdk.Coin name for this erc20.
      */
     function name() public view returns (string memory) {
-        // TODO: Get the name/display from the denom metadata.
-        return denom;
+        return _name;
     }
 
     /**
@@ -38,17 +43,15 @@ contract GridironERC20 is IERC20 {
      * @return string the sdk.Coin symbol for this erc20.
      */
     function symbol() public view returns (string memory) {
-        // TODO: Get the symbol from the denom metadata.
-        return denom;
+        return _symbol;
     }
 
     /**
      * @dev decimals is a public view method for reading the `sdk.Coin` decimals for this erc20.
      * @return uint8 the sdk.Coin decimals for this erc20.
      */
-    function decimals() public pure returns (uint8) {
-        // TODO: Get the max decimals from the denom units.
-        return 18;
+    function decimals() public view returns (uint8) {
+        return _decimals;
     }
 
     /*//////////////////////////////////////////////////////////////
@@ -61,13 +64,40 @@ contract GridironERC20 is IERC20 {
 
     mapping(address => uint256) public nonces;
 
+    /*//////////////////////////////////////////////////////////////
+                            EIP-3009 STORAGE
+    //////////////////////////////////////////////////////////////*/
+
+    bytes32 public constant TRANSFER_WITH_AUTHORIZATION_TYPEHASH = keccak256(
+        "TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"
+    );
+
+    bytes32 public constant RECEIVE_WITH_AUTHORIZATION_TYPEHASH = keccak256(
+        "ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"
+    );
+
+    bytes32 public constant CANCEL_AUTHORIZATION_TYPEHASH =
+        keccak256("CancelAuthorization(address authorizer,bytes32 nonce)");
+
+    mapping(address => mapping(bytes32 => bool)) public authorizationState;
+
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce);
+
     /*//////////////////////////////////////////////////////////////
                                CONSTRUCTOR
     //////////////////////////////////////////////////////////////*/
 
     /// @param _denom is the corresponding SDK Coin's denom.
-    constructor(string memory _denom) {
+    /// @param name_ is the name of the SDK Coin, taken from its denom metadata.
+    /// @param symbol_ is the symbol of the SDK Coin, taken from its denom metadata.
+    /// @param decimals_ is the exponent of the display denom unit of the SDK Coin.
+    constructor(string memory _denom, string memory name_, string memory symbol_, uint8 decimals_) {
         denom = _denom;
+        _name = name_;
+        _symbol = symbol_;
+        _decimals = decimals_;
 
         INITIAL_CHAIN_ID = block.chainid;
         INITIAL_DOMAIN_SEPARATOR = computeDomainSeparator();
@@ -199,7 +229,7 @@ contract GridironERC20 is IERC20 {
         return keccak256(
             abi.encode(
                 keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"),
-                keccak256(bytes(denom)),
+                keccak256(bytes(_name)),
                 keccak256("1"),
                 block.chainid,
                 address(this)
@@ -207,6 +237,111 @@ contract GridironERC20 is IERC20 {
         );
     }
 
+    /*//////////////////////////////////////////////////////////////
+                             EIP-3009 LOGIC
+    //////////////////////////////////////////////////////////////*/
+
+    /**
+     * @dev transferWithAuthorization executes a transfer signed off-chain by `from`.
+     * @param from the address to transfer tokens from (the authorizer).
+     * @param to the address to transfer tokens to.
+     * @param value the amount of tokens to transfer.
+     * @param validAfter the time after which the authorization is valid.
+     * @param validBefore the time before which the authorization is valid.
+     * @param nonce the unique nonce of the authorization.
+     */
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public virtual {
+        _transferWithAuthorization(
+            TRANSFER_WITH_AUTHORIZATION_TYPEHASH, from, to, value, validAfter, validBefore, nonce, v, r, s
+        );
+    }
+
+    /**
+     * @dev receiveWithAuthorization is transferWithAuthorization, but may only be called by the
+     * payee `to`, which protects against front-running of the signed authorization.
+     */
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public virtual {
+        require(to == msg.sender, "GridironERC20: caller must be the payee");
+        _transferWithAuthorization(
+            RECEIVE_WITH_AUTHORIZATION_TYPEHASH, from, to, value, validAfter, validBefore, nonce, v, r, s
+        );
+    }
+
+    /**
+     * @dev cancelAuthorization marks an unused authorization nonce of `authorizer` as used.
+     * @param authorizer the address that signed the authorization.
+     * @param nonce the nonce of the authorization to cancel.
+     */
+    function cancelAuthorization(address authorizer, bytes32 nonce, uint8 v, bytes32 r, bytes32 s) public virtual {
+        require(!authorizationState[authorizer][nonce], "GridironERC20: AUTHORIZATION_USED");
+        require(
+            recoverSigner(keccak256(abi.encode(CANCEL_AUTHORIZATION_TYPEHASH, authorizer, nonce)), v, r, s)
+                == authorizer,
+            "GridironERC20: INVALID_SIGNER"
+        );
+
+        authorizationState[authorizer][nonce] = true;
+        emit AuthorizationCanceled(authorizer, nonce);
+    }
+
+    function _transferWithAuthorization(
+        bytes32 typehash,
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) internal virtual {
+        require(block.timestamp > validAfter, "GridironERC20: AUTHORIZATION_NOT_YET_VALID");
+        require(block.timestamp < validBefore, "GridironERC20: AUTHORIZATION_EXPIRED");
+        require(!authorizationState[from][nonce], "GridironERC20: AUTHORIZATION_USED");
+        require(
+            recoverSigner(keccak256(abi.encode(typehash, from, to, value, validAfter, validBefore, nonce)), v, r, s)
+                == from,
+            "GridironERC20: INVALID_SIGNER"
+        );
+
+        authorizationState[from][nonce] = true;
+        emit AuthorizationUsed(from, nonce);
+
+        require(bank().send(from, to, amountToCoins(value)), "GridironERC20: failed to send bank tokens");
+        emit Transfer(from, to, value);
+    }
+
+    /**
+     * @dev recoverSigner recovers the signer of an EIP-712 struct hash under this token's domain.
+     * @return address the recovered signer, which is never the zero address.
+     */
+    function recoverSigner(bytes32 structHash, uint8 v, bytes32 r, bytes32 s) internal view returns (address) {
+        address signer = ecrecover(keccak256(abi.encodePacked("\x19\x01", DOMAIN_SEPARATOR(), structHash)), v, r, s);
+        require(signer != address(0), "GridironERC20: INVALID_SIGNER");
+        return signer;
+    }
+
     /*//////////////////////////////////////////////////////////////
                               SDK HELPERS
     //////////////////////////////////////////////////////////////*/
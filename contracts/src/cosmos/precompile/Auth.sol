@@ -60,4 +60,153 @@ interface IAuthModule {
      * @param denom the denomination of the Coin that was allowed
      */
     function getSendAllowance(address owner, address spender, string calldata denom) external view returns (uint256);
+
+    ////////////////////////////////////////// AUTHZ //////////////////////////////////////////////
+
+    /**
+     * @dev Emitted when `granter` grants `grantee` an authorization for messages of type
+     * `msgTypeUrl`.
+     */
+    event GrantAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev Emitted when `granter` revokes the authorization of `grantee` for messages of type
+     * `msgTypeUrl`.
+     */
+    event RevokeAuthorization(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev Emitted when `grantee` executes a message of type `msgTypeUrl` on behalf of its
+     * signer.
+     */
+    event ExecAuthorization(address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev grantGenericAuthorization grants `grantee` an unrestricted authorization to execute
+     * messages of type `msgTypeUrl` on behalf of the caller.
+     * @param grantee the account being granted the authorization
+     * @param msgTypeUrl the type URL of the authorized message (e.g. "/cosmos.gov.v1.MsgVote")
+     * @param expiration the expiration time of the grant (0 means no expiration)
+     */
+    function grantGenericAuthorization(address grantee, string calldata msgTypeUrl, uint256 expiration)
+        external
+        returns (bool);
+
+    /**
+     * @dev grantSendAuthorization grants `grantee` an authorization to send up to `spendLimit` of
+     * the caller's coins.
+     * @param grantee the account being granted the authorization
+     * @param spendLimit the Coins the grantee may send
+     * @param allowList the only recipients the grantee may send to (empty means any recipient)
+     * @param expiration the expiration time of the grant (0 means no expiration)
+     */
+    function grantSendAuthorization(
+        address grantee,
+        Cosmos.Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        uint256 expiration
+    ) external returns (bool);
+
+    /**
+     * @dev grantStakeAuthorization grants `grantee` an authorization to stake the caller's coins.
+     * Exactly one of `allowList` and `denyList` must be non-empty.
+     * @param grantee the account being granted the authorization
+     * @param authorizationType the staking message authorized (1 = delegate, 2 = undelegate,
+     * 3 = redelegate, 4 = cancel unbonding delegation)
+     * @param allowList the only validators the grantee may stake with
+     * @param denyList the validators the grantee may not stake with
+     * @param maxTokens the maximum amount the grantee may stake (an amount of 0 means no limit)
+     * @param expiration the expiration time of the grant (0 means no expiration)
+     */
+    function grantStakeAuthorization(
+        address grantee,
+        int32 authorizationType,
+        address[] calldata allowList,
+        address[] calldata denyList,
+        Cosmos.Coin calldata maxTokens,
+        uint256 expiration
+    ) external returns (bool);
+
+    /**
+     * @dev revoke revokes the authorization of `grantee` for messages of type `msgTypeUrl`,
+     * granted by the caller.
+     * @param grantee the account whose authorization is revoked
+     * @param msgTypeUrl the type URL of the authorized message
+     */
+    function revoke(address grantee, string calldata msgTypeUrl) external returns (bool);
+
+    /**
+     * @dev exec executes the protobuf encoded messages `msgs`, of types `typeUrls`, on behalf of
+     * their signers, which must have granted the caller an authorization for them. Only messages
+     * whose type URL is on the dispatch allowlist of the x/evm module can be executed. Returns
+     * the protobuf encoded message responses.
+     * @param typeUrls the type URLs of the messages
+     * @param msgs the protobuf encodings of the messages
+     */
+    function exec(string[] calldata typeUrls, bytes[] calldata msgs) external returns (bytes[] memory);
+
+    /**
+     * @dev getGrants returns the grants from `granter` to `grantee`, optionally filtered by
+     * `msgTypeUrl` (empty means all message types).
+     */
+    function getGrants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    ) external view returns (Grant[] memory, PageResponse memory);
+
+    /**
+     * @dev getGranterGrants returns the grants given by `granter`.
+     */
+    function getGranterGrants(address granter, PageRequest calldata pagination)
+        external
+        view
+        returns (Grant[] memory, PageResponse memory);
+
+    /**
+     * @dev getGranteeGrants returns the grants given to `grantee`.
+     */
+    function getGranteeGrants(address grantee, PageRequest calldata pagination)
+        external
+        view
+        returns (Grant[] memory, PageResponse memory);
+
+    /**
+     * @dev Represents an authz grant.
+     * Note: this struct is generated in generated/i_auth_module.abigen.go
+     */
+    struct Grant {
+        address granter;
+        address grantee;
+        // the type URL of the authorized message
+        string msgTypeUrl;
+        // the type URL of the authorization (e.g. "/cosmos.bank.v1beta1.SendAuthorization")
+        string authorizationTypeUrl;
+        // the protobuf encoding of the authorization
+        bytes authorization;
+        // the expiration time of the grant (0 means no expiration)
+        uint256 expiration;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination request.
+     * Note: this struct is generated in generated/i_auth_module.abigen.go
+     */
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination response.
+     * Note: this struct is generated in generated/i_auth_module.abigen.go
+     */
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
 }
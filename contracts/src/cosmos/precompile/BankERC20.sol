@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+pragma solidity ^0.8.4;
+
+import {IERC20} from "../../../lib/IERC20.sol";
+
+/**
+ * @dev Interface of the bank ERC20 precompiled contracts, which expose an SDK coin denomination
+ * as an ERC20 token directly over x/bank balances. There is one precompile per denomination, and
+ * allowances are x/authz send authorizations of the denomination.
+ */
+interface IBankERC20 is IERC20 {
+    /**
+     * @dev Returns the SDK coin denomination of the token.
+     */
+    function denom() external view returns (string memory);
+
+    /**
+     * @dev Returns the name of the token, taken from the bank denom metadata of the denomination.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the symbol of the token, taken from the bank denom metadata of the denomination.
+     */
+    function symbol() external view returns (string memory);
+
+    /**
+     * @dev Returns the exponent of the display denom unit of the denomination.
+     */
+    function decimals() external view returns (uint8);
+
+    /**
+     * @dev Returns the bank supply of the denomination.
+     */
+    function totalSupply() external view returns (uint256);
+
+    /**
+     * @dev Returns the spendable bank balance of the denomination of `account`.
+     */
+    function balanceOf(address account) external view returns (uint256);
+
+    /**
+     * @dev Returns the amount of the denomination that `spender` may send on behalf of `owner`,
+     * i.e. the spend limit of the send authorization granted by `owner` to `spender`.
+     */
+    function allowance(address owner, address spender) external view returns (uint256);
+}
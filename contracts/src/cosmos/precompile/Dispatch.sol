@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the dispatch precompiled contract, which executes arbitrary Cosmos SDK
+ * messages on behalf of the caller (msg.sender). Only messages whose type URL is on the
+ * governance-controlled allowlist of the x/evm module can be dispatched. Messages which execute
+ * other messages (e.g. authz and group `MsgExec`) can never be allowlisted.
+ *
+ * The Cosmos events emitted by a dispatched message are added to the events of the Cosmos
+ * transaction, but they are not converted to Eth logs.
+ */
+interface IDispatchModule {
+    /**
+     * @dev Executes the protobuf encoded message `value` of type `typeUrl`. The caller must be the
+     * only signer of the message. Returns the protobuf encoded message response.
+     * @param typeUrl The type URL of the message (e.g. "/cosmos.bank.v1beta1.MsgSend").
+     * @param value The protobuf encoding of the message.
+     */
+    function dispatch(string calldata typeUrl, bytes calldata value) external returns (bytes memory);
+
+    /**
+     * @dev Executes the amino JSON encoded message `json`. The caller must be the only signer of
+     * the message. Returns the protobuf encoded message response.
+     * @param json The amino JSON encoding of the message.
+     */
+    function dispatchAmino(string calldata json) external returns (bytes memory);
+
+    /**
+     * @dev Returns whether messages of type `typeUrl` can be dispatched.
+     * @param typeUrl The type URL of the message.
+     */
+    function isAllowed(string calldata typeUrl) external view returns (bool);
+}
@@ -58,6 +58,63 @@ interface IDistributionModule {
         external
         returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev The caller (msg.sender) withdraws the rewards accumulated from all of its delegations.
+     * Returns the total rewards claimed.
+     */
+    function withdrawAllDelegatorRewards() external returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev The caller (msg.sender) withdraws the commission accumulated by the validator it
+     * operates. Returns the commission claimed.
+     */
+    function withdrawValidatorCommission() external returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev The caller (msg.sender) funds the community pool with `amount`.
+     * @param amount The amount of coins to send to the community pool.
+     */
+    function fundCommunityPool(Cosmos.Coin[] calldata amount) external returns (bool);
+
+    /**
+     * @dev Returns the rewards accumulated by `delegator` from its delegation with `validator`.
+     * Note: all returned rewards in this interface are truncated to whole amounts.
+     * @param delegator The delegator to query the rewards of.
+     * @param validator The validator the delegation is with.
+     */
+    function getDelegationRewards(address delegator, address validator)
+        external
+        view
+        returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the rewards accumulated by `delegator` from each of its delegations, along
+     * with the total rewards over all of its delegations.
+     * @param delegator The delegator to query the rewards of.
+     */
+    function getDelegationTotalRewards(address delegator)
+        external
+        view
+        returns (ValidatorReward[] memory, Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the rewards of `validator` which have not been withdrawn yet, including its
+     * commission.
+     * @param validator The validator to query the outstanding rewards of.
+     */
+    function getValidatorOutstandingRewards(address validator) external view returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the commission accumulated by `validator`.
+     * @param validator The validator to query the commission of.
+     */
+    function getValidatorCommission(address validator) external view returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the coins in the community pool.
+     */
+    function getCommunityPool() external view returns (Cosmos.Coin[] memory);
+
     /**
      * @dev Emitted by the distribution module when `amount` is withdrawn from a delegation with
      * `validator` as rewards.
@@ -72,4 +129,19 @@ interface IDistributionModule {
      * @param withdrawAddress The address to set as the withdraw address.
      */
     event SetWithdrawAddress(address indexed withdrawAddress);
+
+    /**
+     * @dev Emitted by the distribution module when `amount` is withdrawn from a validator as
+     * commission.
+     * @param amount The amount of commission withdrawn.
+     */
+    event WithdrawCommission(Cosmos.Coin[] amount);
+
+    /**
+     * @dev Represents the rewards accumulated by a delegation with `validator`.
+     */
+    struct ValidatorReward {
+        address validator;
+        Cosmos.Coin[] rewards;
+    }
 }
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Furychain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+
+pragma solidity ^0.8.4;
+
+import {Cosmos} from "../CosmosTypes.sol";
+
+/**
+ * @dev Interface of the feegrant module precompiled contract, which lets the caller (msg.sender)
+ * sponsor the Cosmos SDK transaction fees, including the gas of Ethereum transactions, of other
+ * accounts.
+ */
+interface IFeegrantModule {
+    ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
+
+    /**
+     * @dev Emitted by the feegrant module when `granter` grants a fee allowance to `grantee`.
+     */
+    event SetFeegrant(address indexed granter, address indexed grantee);
+
+    /**
+     * @dev Emitted by the feegrant module when `granter` revokes the fee allowance of `grantee`.
+     */
+    event RevokeFeegrant(address indexed granter, address indexed grantee);
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev grantBasicAllowance grants `grantee` an allowance to pay fees with the caller's coins.
+     * @param grantee the account being granted the allowance
+     * @param spendLimit the maximum amount of coins that can be spent (empty means no limit)
+     * @param expiration the expiration time of the allowance (0 means no expiration)
+     */
+    function grantBasicAllowance(address grantee, Cosmos.Coin[] calldata spendLimit, uint256 expiration)
+        external
+        returns (bool);
+
+    /**
+     * @dev grantPeriodicAllowance grants `grantee` an allowance to pay fees with the caller's
+     * coins, which is reset every `period`.
+     * @param grantee the account being granted the allowance
+     * @param spendLimit the maximum amount of coins that can be spent in total (empty means no
+     * limit)
+     * @param expiration the expiration time of the allowance (0 means no expiration)
+     * @param period the duration, in seconds, of a period
+     * @param periodSpendLimit the maximum amount of coins that can be spent in a period
+     */
+    function grantPeriodicAllowance(
+        address grantee,
+        Cosmos.Coin[] calldata spendLimit,
+        uint256 expiration,
+        uint64 period,
+        Cosmos.Coin[] calldata periodSpendLimit
+    ) external returns (bool);
+
+    /**
+     * @dev grantAllowedMsgAllowance grants `grantee` a basic allowance to pay fees with the
+     * caller's coins, only for transactions made of the `allowedMessages` message types.
+     * @param grantee the account being granted the allowance
+     * @param spendLimit the maximum amount of coins that can be spent (empty means no limit)
+     * @param expiration the expiration time of the allowance (0 means no expiration)
+     * @param allowedMessages the type URLs of the allowed messages (e.g.
+     * "/gridiron.evm.v1alpha1.EthTransactionRequest")
+     */
+    function grantAllowedMsgAllowance(
+        address grantee,
+        Cosmos.Coin[] calldata spendLimit,
+        uint256 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool);
+
+    /**
+     * @dev revokeAllowance revokes the fee allowance the caller granted to `grantee`.
+     */
+    function revokeAllowance(address grantee) external returns (bool);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev getAllowance returns the fee allowance granted by `granter` to `grantee`. The returned
+     * `allowanceTypeUrl` is empty if there is no such allowance.
+     */
+    function getAllowance(address granter, address grantee) external view returns (Allowance memory);
+
+    /**
+     * @dev getAllowances returns the fee allowances granted to `grantee`.
+     */
+    function getAllowances(address grantee, PageRequest calldata pagination)
+        external
+        view
+        returns (Allowance[] memory, PageResponse memory);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents a feegrant fee allowance.
+     * Note: this struct is generated in generated/i_feegrant_module.abigen.go
+     */
+    struct Allowance {
+        address granter;
+        address grantee;
+        // the type URL of the allowance (e.g. "/cosmos.feegrant.v1beta1.BasicAllowance")
+        string allowanceTypeUrl;
+        // the protobuf encoding of the allowance
+        bytes allowance;
+        // the remaining total spend limit of the allowance (empty means no limit)
+        Cosmos.Coin[] spendLimit;
+        // the expiration time of the allowance (0 means no expiration)
+        uint256 expiration;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination request.
+     * Note: this struct is generated in generated/i_feegrant_module.abigen.go
+     */
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination response.
+     * Note: this struct is generated in generated/i_feegrant_module.abigen.go
+     */
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
+}
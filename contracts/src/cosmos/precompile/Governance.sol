@@ -36,6 +36,32 @@ interface IGovernanceModule {
      */
     function submitProposal(bytes calldata proposal, bytes calldata message) external returns (uint64);
 
+    /**
+     * @dev Submit a proposal, proposed by the caller (msg.sender), to the governance module.
+     * Returns the proposal id.
+     *
+     * The proposal executes `sends`, then `communityPoolSpends`, then `messages`. Sends are made
+     * from, and community pool spends are authorized by, the governance module account. The
+     * signer of each of `messages` must be the governance module account.
+     * @param details The title, summary, metadata and initial deposit of the proposal.
+     * @param sends The bank sends to execute with the proposal.
+     * @param communityPoolSpends The community pool spends to execute with the proposal.
+     * @param messages Any other messages to execute with the proposal.
+     */
+    function submitProposal(
+        ProposalDetails calldata details,
+        BankSend[] calldata sends,
+        CommunityPoolSpend[] calldata communityPoolSpends,
+        Message[] calldata messages
+    ) external returns (uint64);
+
+    /**
+     * @dev Deposit `amount` from the caller (msg.sender) to a proposal.
+     * @param proposalId The id of the proposal to deposit to.
+     * @param amount The amount to deposit.
+     */
+    function deposit(uint64 proposalId, Cosmos.Coin[] calldata amount) external returns (bool);
+
     /**
      * @dev Cancel a proposal. Returns the cancled time and height.
      *   burned.
@@ -74,6 +100,44 @@ interface IGovernanceModule {
      */
     function getProposals(int32 proposalStatus) external view returns (Proposal[] memory);
 
+    /**
+     * @dev Get the deposits made to a proposal.
+     * @param proposalId The id of the proposal.
+     * @param pagination The pagination of the deposits.
+     */
+    function getDeposits(uint64 proposalId, PageRequest calldata pagination)
+        external
+        view
+        returns (Deposit[] memory, PageResponse memory);
+
+    /**
+     * @dev Get the current tally of the votes on a proposal.
+     * @param proposalId The id of the proposal.
+     */
+    function getTallyResult(uint64 proposalId) external view returns (TallyResult memory);
+
+    /**
+     * @dev Get the vote of `voter` on a proposal.
+     * @param proposalId The id of the proposal.
+     * @param voter The voter of the vote.
+     */
+    function getVote(uint64 proposalId, address voter) external view returns (Vote memory);
+
+    /**
+     * @dev Get the votes on a proposal.
+     * @param proposalId The id of the proposal.
+     * @param pagination The pagination of the votes.
+     */
+    function getVotes(uint64 proposalId, PageRequest calldata pagination)
+        external
+        view
+        returns (Vote[] memory, PageResponse memory);
+
+    /**
+     * @dev Get the parameters of the governance module.
+     */
+    function getParams() external view returns (Params memory);
+
     ////////////////////////////////////////// Structs ///////////////////////////////////////////////////
     /**
      * @dev Represents a governance module `WeightedVoteOption`.
@@ -115,6 +179,96 @@ interface IGovernanceModule {
         string noWithVetoCount;
     }
 
+    /**
+     * @dev Represents the details of a proposal to submit.
+     */
+    struct ProposalDetails {
+        string title;
+        string summary;
+        string metadata;
+        Cosmos.Coin[] initialDeposit;
+        bool expedited;
+    }
+
+    /**
+     * @dev Represents a bank `MsgSend` from the governance module account.
+     */
+    struct BankSend {
+        address toAddress;
+        Cosmos.Coin[] amount;
+    }
+
+    /**
+     * @dev Represents a distribution `MsgCommunityPoolSpend`.
+     */
+    struct CommunityPoolSpend {
+        address recipient;
+        Cosmos.Coin[] amount;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK message, by its type URL and protobuf encoded value.
+     */
+    struct Message {
+        string typeUrl;
+        bytes value;
+    }
+
+    /**
+     * @dev Represents a governance module `Deposit`.
+     */
+    struct Deposit {
+        uint64 proposalId;
+        address depositor;
+        Cosmos.Coin[] amount;
+    }
+
+    /**
+     * @dev Represents a governance module `Vote`.
+     */
+    struct Vote {
+        uint64 proposalId;
+        address voter;
+        WeightedVoteOption[] options;
+        string metadata;
+    }
+
+    /**
+     * @dev Represents the governance module `Params`. Periods are in seconds and ratios are
+     * decimal strings.
+     */
+    struct Params {
+        Cosmos.Coin[] minDeposit;
+        uint64 maxDepositPeriod;
+        uint64 votingPeriod;
+        string quorum;
+        string threshold;
+        string vetoThreshold;
+        string minInitialDepositRatio;
+        bool burnVoteQuorum;
+        bool burnProposalDepositPrevote;
+        bool burnVoteVeto;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination request.
+     */
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination response.
+     */
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
+
     /**
      * @dev Emitted by the governance module when `submitProposal` is called.
      * TODO: fix Cosmos event SubmitProposal.
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Furychain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the slashing module precompiled contract, which exposes the signing info and
+ * jailing of validators.
+ */
+interface ISlashingModule {
+    ////////////////////////////////////////// EVENTS /////////////////////////////////////////////
+
+    /**
+     * @dev Emitted by the slashing module when a validator is slashed for double signing or for
+     * missing too many blocks. The slashed validator is given by its bech32 consensus address in
+     * the Cosmos event.
+     * @param power the voting power of the validator at the time of the infraction
+     * @param reason the reason of the slash, either "double_sign" or "missing_signature"
+     * @param burnedCoins the amount of bond denom tokens burned
+     */
+    event Slash(int64 power, string reason, uint256 burnedCoins);
+
+    /**
+     * @dev Emitted by the slashing module when a validator misses a block.
+     * @param missedBlocks the number of blocks missed in the current signed blocks window
+     * @param height the height of the missed block
+     */
+    event Liveness(int64 missedBlocks, int64 height);
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev unjail unjails the validator operated by the caller (msg.sender).
+     */
+    function unjail() external returns (bool);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev getSigningInfo returns the signing info of the validator with the consensus address
+     * `consAddr`.
+     */
+    function getSigningInfo(address consAddr) external view returns (SigningInfo memory);
+
+    /**
+     * @dev getSigningInfos returns the signing infos of all validators.
+     */
+    function getSigningInfos(PageRequest calldata pagination)
+        external
+        view
+        returns (SigningInfo[] memory, PageResponse memory);
+
+    /**
+     * @dev getParams returns the parameters of the slashing module.
+     */
+    function getParams() external view returns (Params memory);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents a validator's signing info.
+     */
+    struct SigningInfo {
+        address consAddr;
+        // startHeight is the height at which the validator started signing blocks
+        int64 startHeight;
+        // indexOffset is the index of the current block in the signed blocks window
+        int64 indexOffset;
+        // jailedUntil is the unix time, in seconds, until which the validator is jailed
+        int64 jailedUntil;
+        // tombstoned is true if the validator was slashed for double signing and can never be
+        // unjailed
+        bool tombstoned;
+        int64 missedBlocksCounter;
+    }
+
+    /**
+     * @dev Represents the slashing module parameters.
+     */
+    struct Params {
+        int64 signedBlocksWindow;
+        // minSignedPerWindow has 18 digits of precision
+        uint256 minSignedPerWindow;
+        // downtimeJailDuration is the duration a validator is jailed for downtime, in seconds
+        int64 downtimeJailDuration;
+        // slashFractionDoubleSign has 18 digits of precision
+        uint256 slashFractionDoubleSign;
+        // slashFractionDowntime has 18 digits of precision
+        uint256 slashFractionDowntime;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination request.
+     */
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination response.
+     */
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
+}
@@ -50,6 +50,12 @@ interface IStakingModule {
      */
     event CreateValidator(address indexed validator, Cosmos.Coin[] amount);
 
+    /**
+     * @dev Emitted by the staking module when a validator is edited, with its resulting
+     * `commissionRate` and `minSelfDelegation`
+     */
+    event EditValidator(string commissionRate, string minSelfDelegation);
+
     /**
      * @dev Emitted by the staking module when `amount` tokens are unbonded from `validator`
      */
@@ -70,6 +76,46 @@ interface IStakingModule {
      */
     function getActiveValidators() external view returns (address[] memory);
 
+    /**
+     * @dev Returns the validator operated by `validatorAddress`
+     */
+    function getValidator(address validatorAddress) external view returns (Validator memory);
+
+    /**
+     * @dev Returns the validators with the given `status` (one of "BOND_STATUS_BONDED",
+     * "BOND_STATUS_UNBONDING" or "BOND_STATUS_UNBONDED"), or all validators if `status` is empty
+     */
+    function getValidators(string calldata status, PageRequest calldata pagination)
+        external
+        view
+        returns (Validator[] memory, PageResponse memory);
+
+    /**
+     * @dev Returns all delegations made by `delegatorAddress`
+     */
+    function getDelegatorDelegations(address delegatorAddress, PageRequest calldata pagination)
+        external
+        view
+        returns (Delegation[] memory, PageResponse memory);
+
+    /**
+     * @dev Returns all delegations made to `validatorAddress`
+     */
+    function getValidatorDelegations(address validatorAddress, PageRequest calldata pagination)
+        external
+        view
+        returns (Delegation[] memory, PageResponse memory);
+
+    /**
+     * @dev Returns the parameters of the staking module
+     */
+    function getParams() external view returns (Params memory);
+
+    /**
+     * @dev Returns the amount of tokens that are not bonded and bonded in the staking pool
+     */
+    function getPool() external view returns (uint256 notBondedTokens, uint256 bondedTokens);
+
     /**
      * @dev Returns the `amount` of tokens currently delegated by `delegatorAddress` to
      * `validatorAddress`
@@ -185,6 +231,29 @@ interface IStakingModule {
         payable
         returns (bool);
 
+    /**
+     * @dev msg.sender creates a validator, operated by msg.sender, with the ed25519 consensus
+     * `pubkey` and self-delegates the `amount` of tokens to it
+     */
+    function createValidator(
+        Description calldata description,
+        CommissionRates calldata commissionRates,
+        uint256 minSelfDelegation,
+        bytes calldata pubkey,
+        uint256 amount
+    ) external payable returns (bool);
+
+    /**
+     * @dev msg.sender edits the validator it operates
+     *
+     * Description fields set to "[do-not-modify]" are left unchanged, as are `commissionRate` and
+     * `minSelfDelegation` if they are negative
+     */
+    function editValidator(Description calldata description, int256 commissionRate, int256 minSelfDelegation)
+        external
+        payable
+        returns (bool);
+
     //////////////////////////////////////////// UTILS ////////////////////////////////////////////
     /**
      * @dev Represents one entry of an unbonding delegation
@@ -223,4 +292,106 @@ interface IStakingModule {
         // unbondingId is the incrementing id that uniquely identifies this entry
         uint64 unbondingId;
     }
+
+    /**
+     * @dev Represents the description of a validator
+     */
+    struct Description {
+        string moniker;
+        string identity;
+        string website;
+        string securityContact;
+        string details;
+    }
+
+    /**
+     * @dev Represents the commission rates of a validator, as decimals with 18 digits of precision
+     */
+    struct CommissionRates {
+        // rate is the commission rate charged to delegators
+        uint256 rate;
+        // maxRate is the maximum commission rate which the validator can ever charge
+        uint256 maxRate;
+        // maxChangeRate is the maximum daily increase of the validator commission
+        uint256 maxChangeRate;
+    }
+
+    /**
+     * @dev Represents the commission of a validator
+     */
+    struct Commission {
+        CommissionRates commissionRates;
+        // updateTime is the unix time at which the commission rate was last changed
+        int64 updateTime;
+    }
+
+    /**
+     * @dev Represents a validator
+     *
+     * Note: share and decimal values have 18 digits of precision
+     */
+    struct Validator {
+        address operatorAddress;
+        // consensusPubkey is the protobuf encoded consensus public key of the validator
+        bytes consensusPubkey;
+        bool jailed;
+        // status is one of "BOND_STATUS_BONDED", "BOND_STATUS_UNBONDING" or
+        // "BOND_STATUS_UNBONDED"
+        string status;
+        uint256 tokens;
+        uint256 delegatorShares;
+        Description description;
+        int64 unbondingHeight;
+        // unbondingTime is the unix time at which the validator completes unbonding
+        int64 unbondingTime;
+        Commission commission;
+        uint256 minSelfDelegation;
+    }
+
+    /**
+     * @dev Represents a delegation, with the `balance` of tokens backing its `shares`
+     *
+     * Note: `shares` has 18 digits of precision
+     */
+    struct Delegation {
+        address delegator;
+        address validator;
+        uint256 shares;
+        uint256 balance;
+    }
+
+    /**
+     * @dev Represents the parameters of the staking module
+     */
+    struct Params {
+        // unbondingTime is the duration of unbonding, in seconds
+        int64 unbondingTime;
+        uint32 maxValidators;
+        uint32 maxEntries;
+        uint32 historicalEntries;
+        string bondDenom;
+        // minCommissionRate has 18 digits of precision
+        uint256 minCommissionRate;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination request.
+     * Note: this struct is generated in generated/i_staking_module.abigen.go
+     */
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    /**
+     * @dev Represents a Cosmos SDK pagination response.
+     * Note: this struct is generated in generated/i_staking_module.abigen.go
+     */
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
 }
@@ -41,7 +41,7 @@ contract BaseSetup is GridironERC20, Test {
     address internal alice;
     address internal bob;
 
-    constructor() GridironERC20("denom") {}
+    constructor() GridironERC20("denom", "denom", "denom", 18) {}
 
     function setUp() public virtual {
         utils = new Utils();
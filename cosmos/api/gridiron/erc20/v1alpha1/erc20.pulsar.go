@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package erc20v1alpha1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TokenPair         protoreflect.MessageDescriptor
+	fd_TokenPair_denom   protoreflect.FieldDescriptor
+	fd_TokenPair_address protoreflect.FieldDescriptor
+	fd_TokenPair_origin  protoreflect.FieldDescriptor
+	fd_TokenPair_paused  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_erc20_proto_init()
+	md_TokenPair = File_gridiron_erc20_v1alpha1_erc20_proto.Messages().ByName("TokenPair")
+	fd_TokenPair_denom = md_TokenPair.Fields().ByName("denom")
+	fd_TokenPair_address = md_TokenPair.Fields().ByName("address")
+	fd_TokenPair_origin = md_TokenPair.Fields().ByName("origin")
+	fd_TokenPair_paused = md_TokenPair.Fields().ByName("paused")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenPair)(nil)
+
+type fastReflection_TokenPair TokenPair
+
+func (x *TokenPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenPair)(x)
+}
+
+func (x *TokenPair) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_erc20_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenPair_messageType fastReflection_TokenPair_messageType
+var _ protoreflect.MessageType = fastReflection_TokenPair_messageType{}
+
+type fastReflection_TokenPair_messageType struct{}
+
+func (x fastReflection_TokenPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenPair)(nil)
+}
+func (x fastReflection_TokenPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenPair)
+}
+func (x fastReflection_TokenPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPair
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPair
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenPair) Type() protoreflect.MessageType {
+	return _fastReflection_TokenPair_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenPair) New() protoreflect.Message {
+	return new(fastReflection_TokenPair)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenPair) Interface() protoreflect.ProtoMessage {
+	return (*TokenPair)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_TokenPair_denom, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_TokenPair_address, value) {
+			return
+		}
+	}
+	if x.Origin != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Origin))
+		if !f(fd_TokenPair_origin, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_TokenPair_paused, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenPair) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		return x.Denom != ""
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		return x.Address != ""
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		return x.Origin != 0
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		return x.Paused != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenPair) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		x.Denom = ""
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		x.Address = ""
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		x.Origin = 0
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		x.Paused = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		value := x.Origin
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		x.Denom = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		x.Address = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		x.Origin = (TokenOrigin)(value.Enum())
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		x.Paused = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		panic(fmt.Errorf("field denom of message gridiron.erc20.v1alpha1.TokenPair is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		panic(fmt.Errorf("field address of message gridiron.erc20.v1alpha1.TokenPair is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		panic(fmt.Errorf("field origin of message gridiron.erc20.v1alpha1.TokenPair is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		panic(fmt.Errorf("field paused of message gridiron.erc20.v1alpha1.TokenPair is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenPair.denom":
+		return protoreflect.ValueOfString("")
+	case "gridiron.erc20.v1alpha1.TokenPair.address":
+		return protoreflect.ValueOfString("")
+	case "gridiron.erc20.v1alpha1.TokenPair.origin":
+		return protoreflect.ValueOfEnum(0)
+	case "gridiron.erc20.v1alpha1.TokenPair.paused":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenPair"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.TokenPair", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenPair) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenPair) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenPair) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenPair) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenPair)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Origin != 0 {
+			n += 1 + runtime.Sov(uint64(x.Origin))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenPair)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Origin != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Origin))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenPair)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				}
+				x.Origin = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Origin |= TokenOrigin(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: gridiron/erc20/v1alpha1/erc20.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// `TokenOrigin` defines the side on which the asset of a token pair was created.
+type TokenOrigin int32
+
+const (
+	// `TOKEN_ORIGIN_UNSPECIFIED` is an invalid origin.
+	TokenOrigin_TOKEN_ORIGIN_UNSPECIFIED TokenOrigin = 0
+	// `TOKEN_ORIGIN_COIN` marks a pair of an SDK coin (e.g. IBC-originated) and its auto-deployed
+	// `GridironERC20` token.
+	TokenOrigin_TOKEN_ORIGIN_COIN TokenOrigin = 1
+	// `TOKEN_ORIGIN_ERC20` marks a pair of an ERC20 token and its `gridiron/0x...` coin.
+	TokenOrigin_TOKEN_ORIGIN_ERC20 TokenOrigin = 2
+)
+
+// Enum value maps for TokenOrigin.
+var (
+	TokenOrigin_name = map[int32]string{
+		0: "TOKEN_ORIGIN_UNSPECIFIED",
+		1: "TOKEN_ORIGIN_COIN",
+		2: "TOKEN_ORIGIN_ERC20",
+	}
+	TokenOrigin_value = map[string]int32{
+		"TOKEN_ORIGIN_UNSPECIFIED": 0,
+		"TOKEN_ORIGIN_COIN":        1,
+		"TOKEN_ORIGIN_ERC20":       2,
+	}
+)
+
+func (x TokenOrigin) Enum() *TokenOrigin {
+	p := new(TokenOrigin)
+	*p = x
+	return p
+}
+
+func (x TokenOrigin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenOrigin) Descriptor() protoreflect.EnumDescriptor {
+	return file_gridiron_erc20_v1alpha1_erc20_proto_enumTypes[0].Descriptor()
+}
+
+func (TokenOrigin) Type() protoreflect.EnumType {
+	return &file_gridiron_erc20_v1alpha1_erc20_proto_enumTypes[0]
+}
+
+func (x TokenOrigin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenOrigin.Descriptor instead.
+func (TokenOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_erc20_proto_rawDescGZIP(), []int{0}
+}
+
+// `TokenPair` defines a pairing of an SDK coin denomination and an ERC20 token.
+type TokenPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `denom` is the SDK coin denomination of the pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// `address` is the hex address of the ERC20 token contract of the pair.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// `origin` is the side on which the asset of the pair was created.
+	Origin TokenOrigin `protobuf:"varint,3,opt,name=origin,proto3,enum=gridiron.erc20.v1alpha1.TokenOrigin" json:"origin,omitempty"`
+	// `paused` is true if conversions between the coin and the token of the pair are disabled.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *TokenPair) Reset() {
+	*x = TokenPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_erc20_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPair) ProtoMessage() {}
+
+// Deprecated: Use TokenPair.ProtoReflect.Descriptor instead.
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_erc20_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TokenPair) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *TokenPair) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TokenPair) GetOrigin() TokenOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return TokenOrigin_TOKEN_ORIGIN_UNSPECIFIED
+}
+
+func (x *TokenPair) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+var File_gridiron_erc20_v1alpha1_erc20_proto protoreflect.FileDescriptor
+
+var file_gridiron_erc20_v1alpha1_erc20_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x2a, 0xa7, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47,
+	0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x49, 0x4e, 0x10, 0x01, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x45,
+	0x52, 0x43, 0x32, 0x30, 0x10, 0x02, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xdf, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x42, 0x0a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x45, 0x58, 0xaa, 0x02,
+	0x17, 0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x2e,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x17, 0x47, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xe2, 0x02, 0x23, 0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x5c, 0x45, 0x72,
+	0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x47, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x3a, 0x3a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gridiron_erc20_v1alpha1_erc20_proto_rawDescOnce sync.Once
+	file_gridiron_erc20_v1alpha1_erc20_proto_rawDescData = file_gridiron_erc20_v1alpha1_erc20_proto_rawDesc
+)
+
+func file_gridiron_erc20_v1alpha1_erc20_proto_rawDescGZIP() []byte {
+	file_gridiron_erc20_v1alpha1_erc20_proto_rawDescOnce.Do(func() {
+		file_gridiron_erc20_v1alpha1_erc20_proto_rawDescData = protoimpl.X.CompressGZIP(file_gridiron_erc20_v1alpha1_erc20_proto_rawDescData)
+	})
+	return file_gridiron_erc20_v1alpha1_erc20_proto_rawDescData
+}
+
+var file_gridiron_erc20_v1alpha1_erc20_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gridiron_erc20_v1alpha1_erc20_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gridiron_erc20_v1alpha1_erc20_proto_goTypes = []interface{}{
+	(TokenOrigin)(0),  // 0: gridiron.erc20.v1alpha1.TokenOrigin
+	(*TokenPair)(nil), // 1: gridiron.erc20.v1alpha1.TokenPair
+}
+var file_gridiron_erc20_v1alpha1_erc20_proto_depIdxs = []int32{
+	0, // 0: gridiron.erc20.v1alpha1.TokenPair.origin:type_name -> gridiron.erc20.v1alpha1.TokenOrigin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gridiron_erc20_v1alpha1_erc20_proto_init() }
+func file_gridiron_erc20_v1alpha1_erc20_proto_init() {
+	if File_gridiron_erc20_v1alpha1_erc20_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gridiron_erc20_v1alpha1_erc20_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gridiron_erc20_v1alpha1_erc20_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gridiron_erc20_v1alpha1_erc20_proto_goTypes,
+		DependencyIndexes: file_gridiron_erc20_v1alpha1_erc20_proto_depIdxs,
+		EnumInfos:         file_gridiron_erc20_v1alpha1_erc20_proto_enumTypes,
+		MessageInfos:      file_gridiron_erc20_v1alpha1_erc20_proto_msgTypes,
+	}.Build()
+	File_gridiron_erc20_v1alpha1_erc20_proto = out.File
+	file_gridiron_erc20_v1alpha1_erc20_proto_rawDesc = nil
+	file_gridiron_erc20_v1alpha1_erc20_proto_goTypes = nil
+	file_gridiron_erc20_v1alpha1_erc20_proto_depIdxs = nil
+}
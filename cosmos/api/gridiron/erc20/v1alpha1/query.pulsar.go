@@ -2,9 +2,11 @@
 package erc20v1alpha1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -15,25 +17,23 @@ import (
 )
 
 var (
-	md_ERC20AddressForCoinDenomRequest       protoreflect.MessageDescriptor
-	fd_ERC20AddressForCoinDenomRequest_denom protoreflect.FieldDescriptor
+	md_ParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_gridiron_erc20_v1alpha1_query_proto_init()
-	md_ERC20AddressForCoinDenomRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ERC20AddressForCoinDenomRequest")
-	fd_ERC20AddressForCoinDenomRequest_denom = md_ERC20AddressForCoinDenomRequest.Fields().ByName("denom")
+	md_ParamsRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_ERC20AddressForCoinDenomRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ParamsRequest)(nil)
 
-type fastReflection_ERC20AddressForCoinDenomRequest ERC20AddressForCoinDenomRequest
+type fastReflection_ParamsRequest ParamsRequest
 
-func (x *ERC20AddressForCoinDenomRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ERC20AddressForCoinDenomRequest)(x)
+func (x *ParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsRequest)(x)
 }
 
-func (x *ERC20AddressForCoinDenomRequest) slowProtoReflect() protoreflect.Message {
+func (x *ParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -45,43 +45,43 @@ func (x *ERC20AddressForCoinDenomRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ERC20AddressForCoinDenomRequest_messageType fastReflection_ERC20AddressForCoinDenomRequest_messageType
-var _ protoreflect.MessageType = fastReflection_ERC20AddressForCoinDenomRequest_messageType{}
+var _fastReflection_ParamsRequest_messageType fastReflection_ParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsRequest_messageType{}
 
-type fastReflection_ERC20AddressForCoinDenomRequest_messageType struct{}
+type fastReflection_ParamsRequest_messageType struct{}
 
-func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ERC20AddressForCoinDenomRequest)(nil)
+func (x fastReflection_ParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsRequest)(nil)
 }
-func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_ERC20AddressForCoinDenomRequest)
+func (x fastReflection_ParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsRequest)
 }
-func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ERC20AddressForCoinDenomRequest
+func (x fastReflection_ParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_ERC20AddressForCoinDenomRequest
+func (x *fastReflection_ParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Type() protoreflect.MessageType {
-	return _fastReflection_ERC20AddressForCoinDenomRequest_messageType
+func (x *fastReflection_ParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) New() protoreflect.Message {
-	return new(fastReflection_ERC20AddressForCoinDenomRequest)
+func (x *fastReflection_ParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_ParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Interface() protoreflect.ProtoMessage {
-	return (*ERC20AddressForCoinDenomRequest)(x)
+func (x *fastReflection_ParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*ParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -89,13 +89,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ERC20AddressForCoinDenomRequest_denom, value) {
-			return
-		}
-	}
+func (x *fastReflection_ParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -109,15 +103,13 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -127,15 +119,13 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -145,16 +135,13 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -168,15 +155,13 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -190,40 +175,36 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		panic(fmt.Errorf("field denom of message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -231,7 +212,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -242,7 +223,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -254,7 +235,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) IsValid() bool {
+func (x *fastReflection_ParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -264,9 +245,9 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -278,10 +259,6 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -292,7 +269,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -311,13 +288,6 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -329,7 +299,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -361,44 +331,12 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -435,25 +373,25 @@ func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoif
 }
 
 var (
-	md_ERC20AddressForCoinDenomResponse       protoreflect.MessageDescriptor
-	fd_ERC20AddressForCoinDenomResponse_token protoreflect.FieldDescriptor
+	md_ParamsResponse        protoreflect.MessageDescriptor
+	fd_ParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_gridiron_erc20_v1alpha1_query_proto_init()
-	md_ERC20AddressForCoinDenomResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ERC20AddressForCoinDenomResponse")
-	fd_ERC20AddressForCoinDenomResponse_token = md_ERC20AddressForCoinDenomResponse.Fields().ByName("token")
+	md_ParamsResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ParamsResponse")
+	fd_ParamsResponse_params = md_ParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_ERC20AddressForCoinDenomResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ParamsResponse)(nil)
 
-type fastReflection_ERC20AddressForCoinDenomResponse ERC20AddressForCoinDenomResponse
+type fastReflection_ParamsResponse ParamsResponse
 
-func (x *ERC20AddressForCoinDenomResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ERC20AddressForCoinDenomResponse)(x)
+func (x *ParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsResponse)(x)
 }
 
-func (x *ERC20AddressForCoinDenomResponse) slowProtoReflect() protoreflect.Message {
+func (x *ParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,43 +403,43 @@ func (x *ERC20AddressForCoinDenomResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ERC20AddressForCoinDenomResponse_messageType fastReflection_ERC20AddressForCoinDenomResponse_messageType
-var _ protoreflect.MessageType = fastReflection_ERC20AddressForCoinDenomResponse_messageType{}
+var _fastReflection_ParamsResponse_messageType fastReflection_ParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsResponse_messageType{}
 
-type fastReflection_ERC20AddressForCoinDenomResponse_messageType struct{}
+type fastReflection_ParamsResponse_messageType struct{}
 
-func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ERC20AddressForCoinDenomResponse)(nil)
+func (x fastReflection_ParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsResponse)(nil)
 }
-func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_ERC20AddressForCoinDenomResponse)
+func (x fastReflection_ParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsResponse)
 }
-func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ERC20AddressForCoinDenomResponse
+func (x fastReflection_ParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_ERC20AddressForCoinDenomResponse
+func (x *fastReflection_ParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Type() protoreflect.MessageType {
-	return _fastReflection_ERC20AddressForCoinDenomResponse_messageType
+func (x *fastReflection_ParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) New() protoreflect.Message {
-	return new(fastReflection_ERC20AddressForCoinDenomResponse)
+func (x *fastReflection_ParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_ParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Interface() protoreflect.ProtoMessage {
-	return (*ERC20AddressForCoinDenomResponse)(x)
+func (x *fastReflection_ParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*ParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -509,10 +447,10 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Token != "" {
-		value := protoreflect.ValueOfString(x.Token)
-		if !f(fd_ERC20AddressForCoinDenomResponse_token, value) {
+func (x *fastReflection_ParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ParamsResponse_params, value) {
 			return
 		}
 	}
@@ -529,15 +467,15 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		return x.Token != ""
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -547,15 +485,15 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		x.Token = ""
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -565,16 +503,16 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		value := x.Token
-		return protoreflect.ValueOfString(value)
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -588,15 +526,15 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		x.Token = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -610,40 +548,44 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		panic(fmt.Errorf("field token of message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse is not mutable"))
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
-		return protoreflect.ValueOfString("")
+	case "gridiron.erc20.v1alpha1.ParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -651,7 +593,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -662,7 +604,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -674,7 +616,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) IsValid() bool {
+func (x *fastReflection_ParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -684,9 +626,9 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -698,8 +640,8 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.Token)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -712,7 +654,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -731,10 +673,17 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Token) > 0 {
-			i -= len(x.Token)
-			copy(dAtA[i:], x.Token)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -749,7 +698,7 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -781,17 +730,17 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -801,23 +750,27 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Token = string(dAtA[iNdEx:postIndex])
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -855,25 +808,25 @@ func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_CoinDenomForERC20AddressRequest       protoreflect.MessageDescriptor
-	fd_CoinDenomForERC20AddressRequest_token protoreflect.FieldDescriptor
+	md_ERC20AddressForCoinDenomRequest       protoreflect.MessageDescriptor
+	fd_ERC20AddressForCoinDenomRequest_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_gridiron_erc20_v1alpha1_query_proto_init()
-	md_CoinDenomForERC20AddressRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("CoinDenomForERC20AddressRequest")
-	fd_CoinDenomForERC20AddressRequest_token = md_CoinDenomForERC20AddressRequest.Fields().ByName("token")
+	md_ERC20AddressForCoinDenomRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ERC20AddressForCoinDenomRequest")
+	fd_ERC20AddressForCoinDenomRequest_denom = md_ERC20AddressForCoinDenomRequest.Fields().ByName("denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_CoinDenomForERC20AddressRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ERC20AddressForCoinDenomRequest)(nil)
 
-type fastReflection_CoinDenomForERC20AddressRequest CoinDenomForERC20AddressRequest
+type fastReflection_ERC20AddressForCoinDenomRequest ERC20AddressForCoinDenomRequest
 
-func (x *CoinDenomForERC20AddressRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CoinDenomForERC20AddressRequest)(x)
+func (x *ERC20AddressForCoinDenomRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ERC20AddressForCoinDenomRequest)(x)
 }
 
-func (x *CoinDenomForERC20AddressRequest) slowProtoReflect() protoreflect.Message {
+func (x *ERC20AddressForCoinDenomRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -885,43 +838,43 @@ func (x *CoinDenomForERC20AddressRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CoinDenomForERC20AddressRequest_messageType fastReflection_CoinDenomForERC20AddressRequest_messageType
-var _ protoreflect.MessageType = fastReflection_CoinDenomForERC20AddressRequest_messageType{}
+var _fastReflection_ERC20AddressForCoinDenomRequest_messageType fastReflection_ERC20AddressForCoinDenomRequest_messageType
+var _ protoreflect.MessageType = fastReflection_ERC20AddressForCoinDenomRequest_messageType{}
 
-type fastReflection_CoinDenomForERC20AddressRequest_messageType struct{}
+type fastReflection_ERC20AddressForCoinDenomRequest_messageType struct{}
 
-func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CoinDenomForERC20AddressRequest)(nil)
+func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ERC20AddressForCoinDenomRequest)(nil)
 }
-func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_CoinDenomForERC20AddressRequest)
+func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_ERC20AddressForCoinDenomRequest)
 }
-func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CoinDenomForERC20AddressRequest
+func (x fastReflection_ERC20AddressForCoinDenomRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ERC20AddressForCoinDenomRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_CoinDenomForERC20AddressRequest
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_ERC20AddressForCoinDenomRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Type() protoreflect.MessageType {
-	return _fastReflection_CoinDenomForERC20AddressRequest_messageType
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Type() protoreflect.MessageType {
+	return _fastReflection_ERC20AddressForCoinDenomRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) New() protoreflect.Message {
-	return new(fastReflection_CoinDenomForERC20AddressRequest)
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) New() protoreflect.Message {
+	return new(fastReflection_ERC20AddressForCoinDenomRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Interface() protoreflect.ProtoMessage {
-	return (*CoinDenomForERC20AddressRequest)(x)
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Interface() protoreflect.ProtoMessage {
+	return (*ERC20AddressForCoinDenomRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -929,10 +882,10 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Token != "" {
-		value := protoreflect.ValueOfString(x.Token)
-		if !f(fd_CoinDenomForERC20AddressRequest_token, value) {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ERC20AddressForCoinDenomRequest_denom, value) {
 			return
 		}
 	}
@@ -949,15 +902,15 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
-		return x.Token != ""
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -967,15 +920,15 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
-		x.Token = ""
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -985,16 +938,16 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
-		value := x.Token
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1008,15 +961,15 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
-		x.Token = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1030,40 +983,40 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
-		panic(fmt.Errorf("field token of message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest is not mutable"))
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
+		panic(fmt.Errorf("field denom of message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest.denom":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1071,7 +1024,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1082,7 +1035,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1094,7 +1047,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) IsValid() bool {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1104,9 +1057,9 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ERC20AddressForCoinDenomRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1118,7 +1071,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.Token)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1132,7 +1085,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1151,10 +1104,10 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Token) > 0 {
-			i -= len(x.Token)
-			copy(dAtA[i:], x.Token)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1169,7 +1122,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1201,15 +1154,15 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1237,7 +1190,7 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Token = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1275,25 +1228,25 @@ func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoif
 }
 
 var (
-	md_CoinDenomForERC20AddressResponse       protoreflect.MessageDescriptor
-	fd_CoinDenomForERC20AddressResponse_denom protoreflect.FieldDescriptor
+	md_ERC20AddressForCoinDenomResponse       protoreflect.MessageDescriptor
+	fd_ERC20AddressForCoinDenomResponse_token protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_gridiron_erc20_v1alpha1_query_proto_init()
-	md_CoinDenomForERC20AddressResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("CoinDenomForERC20AddressResponse")
-	fd_CoinDenomForERC20AddressResponse_denom = md_CoinDenomForERC20AddressResponse.Fields().ByName("denom")
+	md_ERC20AddressForCoinDenomResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("ERC20AddressForCoinDenomResponse")
+	fd_ERC20AddressForCoinDenomResponse_token = md_ERC20AddressForCoinDenomResponse.Fields().ByName("token")
 }
 
-var _ protoreflect.Message = (*fastReflection_CoinDenomForERC20AddressResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ERC20AddressForCoinDenomResponse)(nil)
 
-type fastReflection_CoinDenomForERC20AddressResponse CoinDenomForERC20AddressResponse
+type fastReflection_ERC20AddressForCoinDenomResponse ERC20AddressForCoinDenomResponse
 
-func (x *CoinDenomForERC20AddressResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CoinDenomForERC20AddressResponse)(x)
+func (x *ERC20AddressForCoinDenomResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ERC20AddressForCoinDenomResponse)(x)
 }
 
-func (x *CoinDenomForERC20AddressResponse) slowProtoReflect() protoreflect.Message {
+func (x *ERC20AddressForCoinDenomResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,43 +1258,43 @@ func (x *CoinDenomForERC20AddressResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CoinDenomForERC20AddressResponse_messageType fastReflection_CoinDenomForERC20AddressResponse_messageType
-var _ protoreflect.MessageType = fastReflection_CoinDenomForERC20AddressResponse_messageType{}
+var _fastReflection_ERC20AddressForCoinDenomResponse_messageType fastReflection_ERC20AddressForCoinDenomResponse_messageType
+var _ protoreflect.MessageType = fastReflection_ERC20AddressForCoinDenomResponse_messageType{}
 
-type fastReflection_CoinDenomForERC20AddressResponse_messageType struct{}
+type fastReflection_ERC20AddressForCoinDenomResponse_messageType struct{}
 
-func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CoinDenomForERC20AddressResponse)(nil)
+func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ERC20AddressForCoinDenomResponse)(nil)
 }
-func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_CoinDenomForERC20AddressResponse)
+func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_ERC20AddressForCoinDenomResponse)
 }
-func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CoinDenomForERC20AddressResponse
+func (x fastReflection_ERC20AddressForCoinDenomResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ERC20AddressForCoinDenomResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_CoinDenomForERC20AddressResponse
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_ERC20AddressForCoinDenomResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Type() protoreflect.MessageType {
-	return _fastReflection_CoinDenomForERC20AddressResponse_messageType
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Type() protoreflect.MessageType {
+	return _fastReflection_ERC20AddressForCoinDenomResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) New() protoreflect.Message {
-	return new(fastReflection_CoinDenomForERC20AddressResponse)
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) New() protoreflect.Message {
+	return new(fastReflection_ERC20AddressForCoinDenomResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Interface() protoreflect.ProtoMessage {
-	return (*CoinDenomForERC20AddressResponse)(x)
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Interface() protoreflect.ProtoMessage {
+	return (*ERC20AddressForCoinDenomResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1349,10 +1302,10 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_CoinDenomForERC20AddressResponse_denom, value) {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_ERC20AddressForCoinDenomResponse_token, value) {
 			return
 		}
 	}
@@ -1369,15 +1322,15 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
-		return x.Denom != ""
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
+		return x.Token != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1387,15 +1340,15 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
-		x.Denom = ""
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
+		x.Token = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1405,16 +1358,16 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
-		value := x.Denom
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
+		value := x.Token
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1428,15 +1381,15 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
-		x.Denom = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
+		x.Token = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1450,40 +1403,40 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
-		panic(fmt.Errorf("field denom of message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse is not mutable"))
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
+		panic(fmt.Errorf("field token of message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+	case "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse.token":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse"))
 		}
-		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1491,7 +1444,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1502,7 +1455,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1514,7 +1467,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) IsValid() bool {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1524,9 +1477,9 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ERC20AddressForCoinDenomResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1538,7 +1491,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
+		l = len(x.Token)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1552,7 +1505,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1571,10 +1524,10 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1589,7 +1542,7 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		x := input.Message.Interface().(*ERC20AddressForCoinDenomResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1621,10 +1574,850 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CoinDenomForERC20AddressRequest       protoreflect.MessageDescriptor
+	fd_CoinDenomForERC20AddressRequest_token protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_CoinDenomForERC20AddressRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("CoinDenomForERC20AddressRequest")
+	fd_CoinDenomForERC20AddressRequest_token = md_CoinDenomForERC20AddressRequest.Fields().ByName("token")
+}
+
+var _ protoreflect.Message = (*fastReflection_CoinDenomForERC20AddressRequest)(nil)
+
+type fastReflection_CoinDenomForERC20AddressRequest CoinDenomForERC20AddressRequest
+
+func (x *CoinDenomForERC20AddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CoinDenomForERC20AddressRequest)(x)
+}
+
+func (x *CoinDenomForERC20AddressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CoinDenomForERC20AddressRequest_messageType fastReflection_CoinDenomForERC20AddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_CoinDenomForERC20AddressRequest_messageType{}
+
+type fastReflection_CoinDenomForERC20AddressRequest_messageType struct{}
+
+func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CoinDenomForERC20AddressRequest)(nil)
+}
+func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_CoinDenomForERC20AddressRequest)
+}
+func (x fastReflection_CoinDenomForERC20AddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoinDenomForERC20AddressRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoinDenomForERC20AddressRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_CoinDenomForERC20AddressRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) New() protoreflect.Message {
+	return new(fastReflection_CoinDenomForERC20AddressRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*CoinDenomForERC20AddressRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_CoinDenomForERC20AddressRequest_token, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		return x.Token != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		x.Token = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		value := x.Token
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		x.Token = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		panic(fmt.Errorf("field token of message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest.token":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CoinDenomForERC20AddressRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Token)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CoinDenomForERC20AddressResponse       protoreflect.MessageDescriptor
+	fd_CoinDenomForERC20AddressResponse_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_CoinDenomForERC20AddressResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("CoinDenomForERC20AddressResponse")
+	fd_CoinDenomForERC20AddressResponse_denom = md_CoinDenomForERC20AddressResponse.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_CoinDenomForERC20AddressResponse)(nil)
+
+type fastReflection_CoinDenomForERC20AddressResponse CoinDenomForERC20AddressResponse
+
+func (x *CoinDenomForERC20AddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CoinDenomForERC20AddressResponse)(x)
+}
+
+func (x *CoinDenomForERC20AddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CoinDenomForERC20AddressResponse_messageType fastReflection_CoinDenomForERC20AddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_CoinDenomForERC20AddressResponse_messageType{}
+
+type fastReflection_CoinDenomForERC20AddressResponse_messageType struct{}
+
+func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CoinDenomForERC20AddressResponse)(nil)
+}
+func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_CoinDenomForERC20AddressResponse)
+}
+func (x fastReflection_CoinDenomForERC20AddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoinDenomForERC20AddressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoinDenomForERC20AddressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_CoinDenomForERC20AddressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) New() protoreflect.Message {
+	return new(fastReflection_CoinDenomForERC20AddressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*CoinDenomForERC20AddressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_CoinDenomForERC20AddressResponse_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		panic(fmt.Errorf("field denom of message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CoinDenomForERC20AddressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1657,7 +2450,2886 @@ func (x *fastReflection_CoinDenomForERC20AddressResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PairsRequest            protoreflect.MessageDescriptor
+	fd_PairsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_PairsRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("PairsRequest")
+	fd_PairsRequest_pagination = md_PairsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairsRequest)(nil)
+
+type fastReflection_PairsRequest PairsRequest
+
+func (x *PairsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairsRequest)(x)
+}
+
+func (x *PairsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairsRequest_messageType fastReflection_PairsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_PairsRequest_messageType{}
+
+type fastReflection_PairsRequest_messageType struct{}
+
+func (x fastReflection_PairsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairsRequest)(nil)
+}
+func (x fastReflection_PairsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairsRequest)
+}
+func (x fastReflection_PairsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_PairsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairsRequest) New() protoreflect.Message {
+	return new(fastReflection_PairsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairsRequest) Interface() protoreflect.ProtoMessage {
+	return (*PairsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_PairsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.PairsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PairsResponse_1_list)(nil)
+
+type _PairsResponse_1_list struct {
+	list *[]*TokenPair
+}
+
+func (x *_PairsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PairsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PairsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PairsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PairsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(TokenPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PairsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PairsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(TokenPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PairsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PairsResponse            protoreflect.MessageDescriptor
+	fd_PairsResponse_pairs      protoreflect.FieldDescriptor
+	fd_PairsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_PairsResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("PairsResponse")
+	fd_PairsResponse_pairs = md_PairsResponse.Fields().ByName("pairs")
+	fd_PairsResponse_pagination = md_PairsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairsResponse)(nil)
+
+type fastReflection_PairsResponse PairsResponse
+
+func (x *PairsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairsResponse)(x)
+}
+
+func (x *PairsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairsResponse_messageType fastReflection_PairsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_PairsResponse_messageType{}
+
+type fastReflection_PairsResponse_messageType struct{}
+
+func (x fastReflection_PairsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairsResponse)(nil)
+}
+func (x fastReflection_PairsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairsResponse)
+}
+func (x fastReflection_PairsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_PairsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairsResponse) New() protoreflect.Message {
+	return new(fastReflection_PairsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairsResponse) Interface() protoreflect.ProtoMessage {
+	return (*PairsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pairs) != 0 {
+		value := protoreflect.ValueOfList(&_PairsResponse_1_list{list: &x.Pairs})
+		if !f(fd_PairsResponse_pairs, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_PairsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		return len(x.Pairs) != 0
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		x.Pairs = nil
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		if len(x.Pairs) == 0 {
+			return protoreflect.ValueOfList(&_PairsResponse_1_list{})
+		}
+		listValue := &_PairsResponse_1_list{list: &x.Pairs}
+		return protoreflect.ValueOfList(listValue)
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		lv := value.List()
+		clv := lv.(*_PairsResponse_1_list)
+		x.Pairs = *clv.list
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		if x.Pairs == nil {
+			x.Pairs = []*TokenPair{}
+		}
+		value := &_PairsResponse_1_list{list: &x.Pairs}
+		return protoreflect.ValueOfList(value)
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairsResponse.pairs":
+		list := []*TokenPair{}
+		return protoreflect.ValueOfList(&_PairsResponse_1_list{list: &list})
+	case "gridiron.erc20.v1alpha1.PairsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairsResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.PairsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Pairs) > 0 {
+			for _, e := range x.Pairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pairs) > 0 {
+			for iNdEx := len(x.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pairs = append(x.Pairs, &TokenPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pairs[len(x.Pairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PairRequest                protoreflect.MessageDescriptor
+	fd_PairRequest_token_or_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_PairRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("PairRequest")
+	fd_PairRequest_token_or_denom = md_PairRequest.Fields().ByName("token_or_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairRequest)(nil)
+
+type fastReflection_PairRequest PairRequest
+
+func (x *PairRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairRequest)(x)
+}
+
+func (x *PairRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairRequest_messageType fastReflection_PairRequest_messageType
+var _ protoreflect.MessageType = fastReflection_PairRequest_messageType{}
+
+type fastReflection_PairRequest_messageType struct{}
+
+func (x fastReflection_PairRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairRequest)(nil)
+}
+func (x fastReflection_PairRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairRequest)
+}
+func (x fastReflection_PairRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairRequest) Type() protoreflect.MessageType {
+	return _fastReflection_PairRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairRequest) New() protoreflect.Message {
+	return new(fastReflection_PairRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairRequest) Interface() protoreflect.ProtoMessage {
+	return (*PairRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TokenOrDenom != "" {
+		value := protoreflect.ValueOfString(x.TokenOrDenom)
+		if !f(fd_PairRequest_token_or_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		return x.TokenOrDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		x.TokenOrDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		value := x.TokenOrDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		x.TokenOrDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		panic(fmt.Errorf("field token_or_denom of message gridiron.erc20.v1alpha1.PairRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairRequest.token_or_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.PairRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TokenOrDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenOrDenom) > 0 {
+			i -= len(x.TokenOrDenom)
+			copy(dAtA[i:], x.TokenOrDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenOrDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenOrDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenOrDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PairResponse      protoreflect.MessageDescriptor
+	fd_PairResponse_pair protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_PairResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("PairResponse")
+	fd_PairResponse_pair = md_PairResponse.Fields().ByName("pair")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairResponse)(nil)
+
+type fastReflection_PairResponse PairResponse
+
+func (x *PairResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairResponse)(x)
+}
+
+func (x *PairResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairResponse_messageType fastReflection_PairResponse_messageType
+var _ protoreflect.MessageType = fastReflection_PairResponse_messageType{}
+
+type fastReflection_PairResponse_messageType struct{}
+
+func (x fastReflection_PairResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairResponse)(nil)
+}
+func (x fastReflection_PairResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairResponse)
+}
+func (x fastReflection_PairResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairResponse) Type() protoreflect.MessageType {
+	return _fastReflection_PairResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairResponse) New() protoreflect.Message {
+	return new(fastReflection_PairResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairResponse) Interface() protoreflect.ProtoMessage {
+	return (*PairResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != nil {
+		value := protoreflect.ValueOfMessage(x.Pair.ProtoReflect())
+		if !f(fd_PairResponse_pair, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		return x.Pair != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		x.Pair = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		value := x.Pair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		x.Pair = value.Message().Interface().(*TokenPair)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		if x.Pair == nil {
+			x.Pair = new(TokenPair)
+		}
+		return protoreflect.ValueOfMessage(x.Pair.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.PairResponse.pair":
+		m := new(TokenPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.PairResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.PairResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.PairResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pair != nil {
+			l = options.Size(x.Pair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pair != nil {
+			encoded, err := options.Marshal(x.Pair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pair == nil {
+					x.Pair = &TokenPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TokenInfoRequest       protoreflect.MessageDescriptor
+	fd_TokenInfoRequest_token protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_TokenInfoRequest = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("TokenInfoRequest")
+	fd_TokenInfoRequest_token = md_TokenInfoRequest.Fields().ByName("token")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenInfoRequest)(nil)
+
+type fastReflection_TokenInfoRequest TokenInfoRequest
+
+func (x *TokenInfoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenInfoRequest)(x)
+}
+
+func (x *TokenInfoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenInfoRequest_messageType fastReflection_TokenInfoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TokenInfoRequest_messageType{}
+
+type fastReflection_TokenInfoRequest_messageType struct{}
+
+func (x fastReflection_TokenInfoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenInfoRequest)(nil)
+}
+func (x fastReflection_TokenInfoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenInfoRequest)
+}
+func (x fastReflection_TokenInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenInfoRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenInfoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenInfoRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenInfoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TokenInfoRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenInfoRequest) New() protoreflect.Message {
+	return new(fastReflection_TokenInfoRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenInfoRequest) Interface() protoreflect.ProtoMessage {
+	return (*TokenInfoRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_TokenInfoRequest_token, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		return x.Token != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		x.Token = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		value := x.Token
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		x.Token = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		panic(fmt.Errorf("field token of message gridiron.erc20.v1alpha1.TokenInfoRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoRequest.token":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoRequest"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.TokenInfoRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenInfoRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenInfoRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenInfoRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenInfoRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Token)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenInfoRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenInfoRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenInfoRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TokenInfoResponse              protoreflect.MessageDescriptor
+	fd_TokenInfoResponse_name         protoreflect.FieldDescriptor
+	fd_TokenInfoResponse_symbol       protoreflect.FieldDescriptor
+	fd_TokenInfoResponse_decimals     protoreflect.FieldDescriptor
+	fd_TokenInfoResponse_total_supply protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_erc20_v1alpha1_query_proto_init()
+	md_TokenInfoResponse = File_gridiron_erc20_v1alpha1_query_proto.Messages().ByName("TokenInfoResponse")
+	fd_TokenInfoResponse_name = md_TokenInfoResponse.Fields().ByName("name")
+	fd_TokenInfoResponse_symbol = md_TokenInfoResponse.Fields().ByName("symbol")
+	fd_TokenInfoResponse_decimals = md_TokenInfoResponse.Fields().ByName("decimals")
+	fd_TokenInfoResponse_total_supply = md_TokenInfoResponse.Fields().ByName("total_supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenInfoResponse)(nil)
+
+type fastReflection_TokenInfoResponse TokenInfoResponse
+
+func (x *TokenInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenInfoResponse)(x)
+}
+
+func (x *TokenInfoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenInfoResponse_messageType fastReflection_TokenInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TokenInfoResponse_messageType{}
+
+type fastReflection_TokenInfoResponse_messageType struct{}
+
+func (x fastReflection_TokenInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenInfoResponse)(nil)
+}
+func (x fastReflection_TokenInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenInfoResponse)
+}
+func (x fastReflection_TokenInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenInfoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenInfoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TokenInfoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_TokenInfoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*TokenInfoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_TokenInfoResponse_name, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_TokenInfoResponse_symbol, value) {
+			return
+		}
+	}
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_TokenInfoResponse_decimals, value) {
+			return
+		}
+	}
+	if x.TotalSupply != "" {
+		value := protoreflect.ValueOfString(x.TotalSupply)
+		if !f(fd_TokenInfoResponse_total_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		return x.Name != ""
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		return x.Symbol != ""
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		return x.Decimals != uint32(0)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		return x.TotalSupply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		x.Name = ""
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		x.Symbol = ""
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		x.Decimals = uint32(0)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		x.TotalSupply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		x.Name = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		x.Symbol = value.Interface().(string)
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		x.Decimals = uint32(value.Uint())
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		x.TotalSupply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		panic(fmt.Errorf("field name of message gridiron.erc20.v1alpha1.TokenInfoResponse is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		panic(fmt.Errorf("field symbol of message gridiron.erc20.v1alpha1.TokenInfoResponse is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		panic(fmt.Errorf("field decimals of message gridiron.erc20.v1alpha1.TokenInfoResponse is not mutable"))
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		panic(fmt.Errorf("field total_supply of message gridiron.erc20.v1alpha1.TokenInfoResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.name":
+		return protoreflect.ValueOfString("")
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.symbol":
+		return protoreflect.ValueOfString("")
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "gridiron.erc20.v1alpha1.TokenInfoResponse.total_supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.erc20.v1alpha1.TokenInfoResponse"))
+		}
+		panic(fmt.Errorf("message gridiron.erc20.v1alpha1.TokenInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.erc20.v1alpha1.TokenInfoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenInfoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenInfoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenInfoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenInfoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.TotalSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenInfoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalSupply) > 0 {
+			i -= len(x.TotalSupply)
+			copy(dAtA[i:], x.TotalSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSupply)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenInfoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenInfoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSupply = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1727,7 +5399,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// ERC20AddressForCoinDenomRequest is the request type for the Query/ERC20AddressForCoinDenom RPC method.
+// ERC20AddressForCoinDenomRequest is the request type for the Query/ERC20AddressForCoinDenom RPC method.
+type ParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ParamsRequest) Reset() {
+	*x = ParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use ParamsRequest.ProtoReflect.Descriptor instead.
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{0}
+}
+
+type ParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params are the parameters of the x/erc20 module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ParamsResponse) Reset() {
+	*x = ParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use ParamsResponse.ProtoReflect.Descriptor instead.
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 type ERC20AddressForCoinDenomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1740,7 +5474,7 @@ type ERC20AddressForCoinDenomRequest struct {
 func (x *ERC20AddressForCoinDenomRequest) Reset() {
 	*x = ERC20AddressForCoinDenomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[0]
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1754,7 +5488,7 @@ func (*ERC20AddressForCoinDenomRequest) ProtoMessage() {}
 
 // Deprecated: Use ERC20AddressForCoinDenomRequest.ProtoReflect.Descriptor instead.
 func (*ERC20AddressForCoinDenomRequest) Descriptor() ([]byte, []int) {
-	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{0}
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ERC20AddressForCoinDenomRequest) GetDenom() string {
@@ -1777,7 +5511,7 @@ type ERC20AddressForCoinDenomResponse struct {
 func (x *ERC20AddressForCoinDenomResponse) Reset() {
 	*x = ERC20AddressForCoinDenomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[1]
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1791,7 +5525,7 @@ func (*ERC20AddressForCoinDenomResponse) ProtoMessage() {}
 
 // Deprecated: Use ERC20AddressForCoinDenomResponse.ProtoReflect.Descriptor instead.
 func (*ERC20AddressForCoinDenomResponse) Descriptor() ([]byte, []int) {
-	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{1}
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ERC20AddressForCoinDenomResponse) GetToken() string {
@@ -1814,7 +5548,7 @@ type CoinDenomForERC20AddressRequest struct {
 func (x *CoinDenomForERC20AddressRequest) Reset() {
 	*x = CoinDenomForERC20AddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[2]
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1828,7 +5562,7 @@ func (*CoinDenomForERC20AddressRequest) ProtoMessage() {}
 
 // Deprecated: Use CoinDenomForERC20AddressRequest.ProtoReflect.Descriptor instead.
 func (*CoinDenomForERC20AddressRequest) Descriptor() ([]byte, []int) {
-	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{2}
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CoinDenomForERC20AddressRequest) GetToken() string {
@@ -1851,7 +5585,7 @@ type CoinDenomForERC20AddressResponse struct {
 func (x *CoinDenomForERC20AddressResponse) Reset() {
 	*x = CoinDenomForERC20AddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[3]
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1865,7 +5599,7 @@ func (*CoinDenomForERC20AddressResponse) ProtoMessage() {}
 
 // Deprecated: Use CoinDenomForERC20AddressResponse.ProtoReflect.Descriptor instead.
 func (*CoinDenomForERC20AddressResponse) Descriptor() ([]byte, []int) {
-	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{3}
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CoinDenomForERC20AddressResponse) GetDenom() string {
@@ -1875,74 +5609,419 @@ func (x *CoinDenomForERC20AddressResponse) GetDenom() string {
 	return ""
 }
 
+// PairsRequest is the request type for the Query/Pairs RPC method.
+type PairsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *PairsRequest) Reset() {
+	*x = PairsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairsRequest) ProtoMessage() {}
+
+// Deprecated: Use PairsRequest.ProtoReflect.Descriptor instead.
+func (*PairsRequest) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PairsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// PairsResponse is the response type for the Query/Pairs RPC method.
+type PairsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pairs is the page of SDK coin <> ERC20 token pairs, ordered by ERC20 token address.
+	Pairs []*TokenPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *PairsResponse) Reset() {
+	*x = PairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairsResponse) ProtoMessage() {}
+
+// Deprecated: Use PairsResponse.ProtoReflect.Descriptor instead.
+func (*PairsResponse) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PairsResponse) GetPairs() []*TokenPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *PairsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// PairRequest is the request type for the Query/Pair RPC method.
+type PairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// token_or_denom is either the hex or bech32 address of the ERC20 token, or the SDK coin
+	// denomination of the pair.
+	TokenOrDenom string `protobuf:"bytes,1,opt,name=token_or_denom,json=tokenOrDenom,proto3" json:"token_or_denom,omitempty"`
+}
+
+func (x *PairRequest) Reset() {
+	*x = PairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairRequest) ProtoMessage() {}
+
+// Deprecated: Use PairRequest.ProtoReflect.Descriptor instead.
+func (*PairRequest) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PairRequest) GetTokenOrDenom() string {
+	if x != nil {
+		return x.TokenOrDenom
+	}
+	return ""
+}
+
+// PairResponse is the response type for the Query/Pair RPC method.
+type PairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pair is the SDK coin <> ERC20 token pair.
+	Pair *TokenPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *PairResponse) Reset() {
+	*x = PairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairResponse) ProtoMessage() {}
+
+// Deprecated: Use PairResponse.ProtoReflect.Descriptor instead.
+func (*PairResponse) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PairResponse) GetPair() *TokenPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+// TokenInfoRequest is the request type for the Query/TokenInfo RPC method.
+type TokenInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// token is the hex or bech32 address of the ERC20 token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *TokenInfoRequest) Reset() {
+	*x = TokenInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfoRequest) ProtoMessage() {}
+
+// Deprecated: Use TokenInfoRequest.ProtoReflect.Descriptor instead.
+func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TokenInfoRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// TokenInfoResponse is the response type for the Query/TokenInfo RPC method.
+type TokenInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the ERC20 token.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the symbol of the ERC20 token.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the number of decimals of the ERC20 token.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// total_supply is the total supply of the ERC20 token.
+	TotalSupply string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+}
+
+func (x *TokenInfoResponse) Reset() {
+	*x = TokenInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_erc20_v1alpha1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfoResponse) ProtoMessage() {}
+
+// Deprecated: Use TokenInfoResponse.ProtoReflect.Descriptor instead.
+func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
+	return file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TokenInfoResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenInfoResponse) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenInfoResponse) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
 var File_gridiron_erc20_v1alpha1_query_proto protoreflect.FileDescriptor
 
 var file_gridiron_erc20_v1alpha1_query_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x72,
-	0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x1f, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x4f,
-	0x0a, 0x20, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f,
-	0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x4e, 0x0a, 0x1f, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45,
-	0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x38, 0x0a, 0x20, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45,
-	0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0xa0, 0x03, 0x0a, 0x0c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x18, 0x45,
-	0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f,
-	0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x2e, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69,
-	0x73, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72,
-	0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x38, 0x2e, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41,
+	0x0a, 0x23, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x2a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x67, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
+	0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72,
+	0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x37, 0x0a, 0x1f, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x31, 0x12, 0x2f, 0x2f, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x65, 0x72, 0x63,
-	0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32,
-	0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0xc6, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x46, 0x6f, 0x72, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x37, 0x2e, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x70, 0x6f, 0x6c, 0x61,
-	0x72, 0x69, 0x73, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45,
-	0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x70, 0x6f,
-	0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x65,
-	0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0xd9, 0x01, 0x0a,
-	0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x72, 0x63,
-	0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x6c, 0x61,
-	0x72, 0x69, 0x73, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x3b, 0x65, 0x72, 0x63, 0x32, 0x30, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x50, 0x45, 0x58, 0xaa, 0x02, 0x16, 0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73,
-	0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca,
-	0x02, 0x16, 0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c,
-	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x22, 0x50, 0x6f, 0x6c, 0x61, 0x72,
-	0x69, 0x73, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18,
-	0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x3a,
-	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22,
+	0x4f, 0x0a, 0x20, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46,
+	0x6f, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x4e, 0x0a, 0x1f, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x38, 0x0a, 0x20, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x56, 0x0a, 0x0c, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a,
+	0x0b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x72, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x22, 0x4c, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x22, 0x28, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x32, 0xb9, 0x07, 0x0a, 0x0c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67,
+	0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
+	0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xc9,
+	0x01, 0x0a, 0x18, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46,
+	0x6f, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x38, 0x2e, 0x67, 0x72,
+	0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e,
+	0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x43,
+	0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xc9, 0x01, 0x0a, 0x18, 0x43,
+	0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45, 0x52, 0x43, 0x32, 0x30,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72,
+	0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x39, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7e, 0x0a, 0x05, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x25, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f,
+	0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f,
+	0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x7a, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72, 0x12, 0x24,
+	0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61,
+	0x69, 0x72, 0x12, 0x8f, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x29, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x72,
+	0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x23, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x42, 0xdf, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x72, 0x69,
+	0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x45, 0x58,
+	0xaa, 0x02, 0x17, 0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x63, 0x32,
+	0x30, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x17, 0x47, 0x72, 0x69,
+	0x64, 0x69, 0x72, 0x6f, 0x6e, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x5c,
+	0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x47, 0x72, 0x69,
+	0x64, 0x69, 0x72, 0x6f, 0x6e, 0x3a, 0x3a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x3a, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1957,23 +6036,48 @@ func file_gridiron_erc20_v1alpha1_query_proto_rawDescGZIP() []byte {
 	return file_gridiron_erc20_v1alpha1_query_proto_rawDescData
 }
 
-var file_gridiron_erc20_v1alpha1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gridiron_erc20_v1alpha1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_gridiron_erc20_v1alpha1_query_proto_goTypes = []interface{}{
-	(*ERC20AddressForCoinDenomRequest)(nil),  // 0: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest
-	(*ERC20AddressForCoinDenomResponse)(nil), // 1: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse
-	(*CoinDenomForERC20AddressRequest)(nil),  // 2: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest
-	(*CoinDenomForERC20AddressResponse)(nil), // 3: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse
+	(*ParamsRequest)(nil),                    // 0: gridiron.erc20.v1alpha1.ParamsRequest
+	(*ParamsResponse)(nil),                   // 1: gridiron.erc20.v1alpha1.ParamsResponse
+	(*ERC20AddressForCoinDenomRequest)(nil),  // 2: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest
+	(*ERC20AddressForCoinDenomResponse)(nil), // 3: gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse
+	(*CoinDenomForERC20AddressRequest)(nil),  // 4: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest
+	(*CoinDenomForERC20AddressResponse)(nil), // 5: gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse
+	(*PairsRequest)(nil),                     // 6: gridiron.erc20.v1alpha1.PairsRequest
+	(*PairsResponse)(nil),                    // 7: gridiron.erc20.v1alpha1.PairsResponse
+	(*PairRequest)(nil),                      // 8: gridiron.erc20.v1alpha1.PairRequest
+	(*PairResponse)(nil),                     // 9: gridiron.erc20.v1alpha1.PairResponse
+	(*TokenInfoRequest)(nil),                 // 10: gridiron.erc20.v1alpha1.TokenInfoRequest
+	(*TokenInfoResponse)(nil),                // 11: gridiron.erc20.v1alpha1.TokenInfoResponse
+	(*Params)(nil),                           // 12: gridiron.erc20.v1alpha1.Params
+	(*v1beta1.PageRequest)(nil),              // 13: cosmos.base.query.v1beta1.PageRequest
+	(*TokenPair)(nil),                        // 14: gridiron.erc20.v1alpha1.TokenPair
+	(*v1beta1.PageResponse)(nil),             // 15: cosmos.base.query.v1beta1.PageResponse
 }
 var file_gridiron_erc20_v1alpha1_query_proto_depIdxs = []int32{
-	0, // 0: gridiron.erc20.v1alpha1.QueryService.ERC20AddressForCoinDenom:input_type -> gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest
-	2, // 1: gridiron.erc20.v1alpha1.QueryService.CoinDenomForERC20Address:input_type -> gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest
-	1, // 2: gridiron.erc20.v1alpha1.QueryService.ERC20AddressForCoinDenom:output_type -> gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse
-	3, // 3: gridiron.erc20.v1alpha1.QueryService.CoinDenomForERC20Address:output_type -> gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	12, // 0: gridiron.erc20.v1alpha1.ParamsResponse.params:type_name -> gridiron.erc20.v1alpha1.Params
+	13, // 1: gridiron.erc20.v1alpha1.PairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	14, // 2: gridiron.erc20.v1alpha1.PairsResponse.pairs:type_name -> gridiron.erc20.v1alpha1.TokenPair
+	15, // 3: gridiron.erc20.v1alpha1.PairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	14, // 4: gridiron.erc20.v1alpha1.PairResponse.pair:type_name -> gridiron.erc20.v1alpha1.TokenPair
+	0,  // 5: gridiron.erc20.v1alpha1.QueryService.Params:input_type -> gridiron.erc20.v1alpha1.ParamsRequest
+	2,  // 6: gridiron.erc20.v1alpha1.QueryService.ERC20AddressForCoinDenom:input_type -> gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest
+	4,  // 7: gridiron.erc20.v1alpha1.QueryService.CoinDenomForERC20Address:input_type -> gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest
+	6,  // 8: gridiron.erc20.v1alpha1.QueryService.Pairs:input_type -> gridiron.erc20.v1alpha1.PairsRequest
+	8,  // 9: gridiron.erc20.v1alpha1.QueryService.Pair:input_type -> gridiron.erc20.v1alpha1.PairRequest
+	10, // 10: gridiron.erc20.v1alpha1.QueryService.TokenInfo:input_type -> gridiron.erc20.v1alpha1.TokenInfoRequest
+	1,  // 11: gridiron.erc20.v1alpha1.QueryService.Params:output_type -> gridiron.erc20.v1alpha1.ParamsResponse
+	3,  // 12: gridiron.erc20.v1alpha1.QueryService.ERC20AddressForCoinDenom:output_type -> gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse
+	5,  // 13: gridiron.erc20.v1alpha1.QueryService.CoinDenomForERC20Address:output_type -> gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse
+	7,  // 14: gridiron.erc20.v1alpha1.QueryService.Pairs:output_type -> gridiron.erc20.v1alpha1.PairsResponse
+	9,  // 15: gridiron.erc20.v1alpha1.QueryService.Pair:output_type -> gridiron.erc20.v1alpha1.PairResponse
+	11, // 16: gridiron.erc20.v1alpha1.QueryService.TokenInfo:output_type -> gridiron.erc20.v1alpha1.TokenInfoResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_gridiron_erc20_v1alpha1_query_proto_init() }
@@ -1981,9 +6085,11 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 	if File_gridiron_erc20_v1alpha1_query_proto != nil {
 		return
 	}
+	file_gridiron_erc20_v1alpha1_erc20_proto_init()
+	file_gridiron_erc20_v1alpha1_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ERC20AddressForCoinDenomRequest); i {
+			switch v := v.(*ParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,7 +6101,7 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 			}
 		}
 		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ERC20AddressForCoinDenomResponse); i {
+			switch v := v.(*ParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,7 +6113,7 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 			}
 		}
 		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CoinDenomForERC20AddressRequest); i {
+			switch v := v.(*ERC20AddressForCoinDenomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2019,6 +6125,30 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 			}
 		}
 		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERC20AddressForCoinDenomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinDenomForERC20AddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CoinDenomForERC20AddressResponse); i {
 			case 0:
 				return &v.state
@@ -2030,6 +6160,78 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 				return nil
 			}
 		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_erc20_v1alpha1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2037,7 +6239,7 @@ func file_gridiron_erc20_v1alpha1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gridiron_erc20_v1alpha1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,18 +39,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	QueryService_Params_FullMethodName                   = "/gridiron.erc20.v1alpha1.QueryService/Params"
 	QueryService_ERC20AddressForCoinDenom_FullMethodName = "/gridiron.erc20.v1alpha1.QueryService/ERC20AddressForCoinDenom"
 	QueryService_CoinDenomForERC20Address_FullMethodName = "/gridiron.erc20.v1alpha1.QueryService/CoinDenomForERC20Address"
+	QueryService_Pairs_FullMethodName                    = "/gridiron.erc20.v1alpha1.QueryService/Pairs"
+	QueryService_Pair_FullMethodName                     = "/gridiron.erc20.v1alpha1.QueryService/Pair"
+	QueryService_TokenInfo_FullMethodName                = "/gridiron.erc20.v1alpha1.QueryService/TokenInfo"
 )
 
 // QueryServiceClient is the client API for QueryService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryServiceClient interface {
+	// Params queries the parameters of the x/erc20 module.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 	// ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 	ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(ctx context.Context, in *CoinDenomForERC20AddressRequest, opts ...grpc.CallOption) (*CoinDenomForERC20AddressResponse, error)
+	// Pairs queries all registered SDK coin <> ERC20 token pairs.
+	Pairs(ctx context.Context, in *PairsRequest, opts ...grpc.CallOption) (*PairsResponse, error)
+	// Pair queries the SDK coin <> ERC20 token pair of a given ERC20 token address or SDK coin
+	// denomination.
+	Pair(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*PairResponse, error)
+	// TokenInfo queries the ERC20 metadata of a given ERC20 token, read from the EVM.
+	TokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error)
 }
 
 type queryServiceClient struct {
@@ -61,6 +74,15 @@ func NewQueryServiceClient(cc grpc.ClientConnInterface) QueryServiceClient {
 	return &queryServiceClient{cc}
 }
 
+func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, QueryService_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error) {
 	out := new(ERC20AddressForCoinDenomResponse)
 	err := c.cc.Invoke(ctx, QueryService_ERC20AddressForCoinDenom_FullMethodName, in, out, opts...)
@@ -79,14 +101,50 @@ func (c *queryServiceClient) CoinDenomForERC20Address(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *queryServiceClient) Pairs(ctx context.Context, in *PairsRequest, opts ...grpc.CallOption) (*PairsResponse, error) {
+	out := new(PairsResponse)
+	err := c.cc.Invoke(ctx, QueryService_Pairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Pair(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*PairResponse, error) {
+	out := new(PairResponse)
+	err := c.cc.Invoke(ctx, QueryService_Pair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error) {
+	out := new(TokenInfoResponse)
+	err := c.cc.Invoke(ctx, QueryService_TokenInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility
 type QueryServiceServer interface {
+	// Params queries the parameters of the x/erc20 module.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	// ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 	ERC20AddressForCoinDenom(context.Context, *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error)
+	// Pairs queries all registered SDK coin <> ERC20 token pairs.
+	Pairs(context.Context, *PairsRequest) (*PairsResponse, error)
+	// Pair queries the SDK coin <> ERC20 token pair of a given ERC20 token address or SDK coin
+	// denomination.
+	Pair(context.Context, *PairRequest) (*PairResponse, error)
+	// TokenInfo queries the ERC20 metadata of a given ERC20 token, read from the EVM.
+	TokenInfo(context.Context, *TokenInfoRequest) (*TokenInfoResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -94,12 +152,24 @@ type QueryServiceServer interface {
 type UnimplementedQueryServiceServer struct {
 }
 
+func (UnimplementedQueryServiceServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServiceServer) ERC20AddressForCoinDenom(context.Context, *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ERC20AddressForCoinDenom not implemented")
 }
 func (UnimplementedQueryServiceServer) CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinDenomForERC20Address not implemented")
 }
+func (UnimplementedQueryServiceServer) Pairs(context.Context, *PairsRequest) (*PairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pairs not implemented")
+}
+func (UnimplementedQueryServiceServer) Pair(context.Context, *PairRequest) (*PairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pair not implemented")
+}
+func (UnimplementedQueryServiceServer) TokenInfo(context.Context, *TokenInfoRequest) (*TokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 
 // UnsafeQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -113,6 +183,24 @@ func RegisterQueryServiceServer(s grpc.ServiceRegistrar, srv QueryServiceServer)
 	s.RegisterService(&QueryService_ServiceDesc, srv)
 }
 
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_ERC20AddressForCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ERC20AddressForCoinDenomRequest)
 	if err := dec(in); err != nil {
@@ -149,6 +237,60 @@ func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Pairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Pairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pairs(ctx, req.(*PairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Pair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_Pair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pair(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_TokenInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TokenInfo(ctx, req.(*TokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +298,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.erc20.v1alpha1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
 		{
 			MethodName: "ERC20AddressForCoinDenom",
 			Handler:    _QueryService_ERC20AddressForCoinDenom_Handler,
@@ -164,6 +310,18 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CoinDenomForERC20Address",
 			Handler:    _QueryService_CoinDenomForERC20Address_Handler,
 		},
+		{
+			MethodName: "Pairs",
+			Handler:    _QueryService_Pairs_Handler,
+		},
+		{
+			MethodName: "Pair",
+			Handler:    _QueryService_Pair_Handler,
+		},
+		{
+			MethodName: "TokenInfo",
+			Handler:    _QueryService_TokenInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/erc20/v1alpha1/query.proto",
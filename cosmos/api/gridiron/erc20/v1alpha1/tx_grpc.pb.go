@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: gridiron/erc20/v1alpha1/tx.proto
+
+package erc20v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgService_UpdateParams_FullMethodName   = "/gridiron.erc20.v1alpha1.MsgService/UpdateParams"
+	MsgService_RegisterPair_FullMethodName   = "/gridiron.erc20.v1alpha1.MsgService/RegisterPair"
+	MsgService_TogglePair_FullMethodName     = "/gridiron.erc20.v1alpha1.MsgService/TogglePair"
+	MsgService_DeregisterPair_FullMethodName = "/gridiron.erc20.v1alpha1.MsgService/DeregisterPair"
+)
+
+// MsgServiceClient is the client API for MsgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgServiceClient interface {
+	// `UpdateParams` defines a governance operation for updating the x/erc20 module parameters.
+	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	// `RegisterPair` defines a governance operation for registering the token pair of an ERC20
+	// originated token and its Gridiron coin.
+	RegisterPair(ctx context.Context, in *RegisterPairRequest, opts ...grpc.CallOption) (*RegisterPairResponse, error)
+	// `TogglePair` defines a governance operation for pausing or unpausing the conversions of a
+	// token pair.
+	TogglePair(ctx context.Context, in *TogglePairRequest, opts ...grpc.CallOption) (*TogglePairResponse, error)
+	// `DeregisterPair` defines a governance operation for removing the token pair of an ERC20
+	// originated token.
+	DeregisterPair(ctx context.Context, in *DeregisterPairRequest, opts ...grpc.CallOption) (*DeregisterPairResponse, error)
+}
+
+type msgServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgServiceClient(cc grpc.ClientConnInterface) MsgServiceClient {
+	return &msgServiceClient{cc}
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
+	out := new(UpdateParamsResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RegisterPair(ctx context.Context, in *RegisterPairRequest, opts ...grpc.CallOption) (*RegisterPairResponse, error) {
+	out := new(RegisterPairResponse)
+	err := c.cc.Invoke(ctx, MsgService_RegisterPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) TogglePair(ctx context.Context, in *TogglePairRequest, opts ...grpc.CallOption) (*TogglePairResponse, error) {
+	out := new(TogglePairResponse)
+	err := c.cc.Invoke(ctx, MsgService_TogglePair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DeregisterPair(ctx context.Context, in *DeregisterPairRequest, opts ...grpc.CallOption) (*DeregisterPairResponse, error) {
+	out := new(DeregisterPairResponse)
+	err := c.cc.Invoke(ctx, MsgService_DeregisterPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServiceServer is the server API for MsgService service.
+// All implementations must embed UnimplementedMsgServiceServer
+// for forward compatibility
+type MsgServiceServer interface {
+	// `UpdateParams` defines a governance operation for updating the x/erc20 module parameters.
+	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	// `RegisterPair` defines a governance operation for registering the token pair of an ERC20
+	// originated token and its Gridiron coin.
+	RegisterPair(context.Context, *RegisterPairRequest) (*RegisterPairResponse, error)
+	// `TogglePair` defines a governance operation for pausing or unpausing the conversions of a
+	// token pair.
+	TogglePair(context.Context, *TogglePairRequest) (*TogglePairResponse, error)
+	// `DeregisterPair` defines a governance operation for removing the token pair of an ERC20
+	// originated token.
+	DeregisterPair(context.Context, *DeregisterPairRequest) (*DeregisterPairResponse, error)
+	mustEmbedUnimplementedMsgServiceServer()
+}
+
+// UnimplementedMsgServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServiceServer struct {
+}
+
+func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServiceServer) RegisterPair(context.Context, *RegisterPairRequest) (*RegisterPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPair not implemented")
+}
+func (UnimplementedMsgServiceServer) TogglePair(context.Context, *TogglePairRequest) (*TogglePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TogglePair not implemented")
+}
+func (UnimplementedMsgServiceServer) DeregisterPair(context.Context, *DeregisterPairRequest) (*DeregisterPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterPair not implemented")
+}
+func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
+
+// UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServiceServer will
+// result in compilation errors.
+type UnsafeMsgServiceServer interface {
+	mustEmbedUnimplementedMsgServiceServer()
+}
+
+func RegisterMsgServiceServer(s grpc.ServiceRegistrar, srv MsgServiceServer) {
+	s.RegisterService(&MsgService_ServiceDesc, srv)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*UpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RegisterPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RegisterPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RegisterPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RegisterPair(ctx, req.(*RegisterPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_TogglePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TogglePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).TogglePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_TogglePair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).TogglePair(ctx, req.(*TogglePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeregisterPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeregisterPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_DeregisterPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeregisterPair(ctx, req.(*DeregisterPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gridiron.erc20.v1alpha1.MsgService",
+	HandlerType: (*MsgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterPair",
+			Handler:    _MsgService_RegisterPair_Handler,
+		},
+		{
+			MethodName: "TogglePair",
+			Handler:    _MsgService_TogglePair_Handler,
+		},
+		{
+			MethodName: "DeregisterPair",
+			Handler:    _MsgService_DeregisterPair_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gridiron/erc20/v1alpha1/tx.proto",
+}
@@ -3,6 +3,7 @@ package evmv1alpha1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -59,11 +60,161 @@ func (x *_Params_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]string
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field DispatchAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*PrecompileConfig
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrecompileConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrecompileConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(PrecompileConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(PrecompileConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field BankErc20Denoms as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params              protoreflect.MessageDescriptor
-	fd_Params_evm_denom    protoreflect.FieldDescriptor
-	fd_Params_extra_eips   protoreflect.FieldDescriptor
-	fd_Params_chain_config protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_evm_denom                   protoreflect.FieldDescriptor
+	fd_Params_extra_eips                  protoreflect.FieldDescriptor
+	fd_Params_chain_config                protoreflect.FieldDescriptor
+	fd_Params_fee_market                  protoreflect.FieldDescriptor
+	fd_Params_fee_distribution            protoreflect.FieldDescriptor
+	fd_Params_dispatch_allowlist          protoreflect.FieldDescriptor
+	fd_Params_precompile_kv_gas           protoreflect.FieldDescriptor
+	fd_Params_precompile_transient_kv_gas protoreflect.FieldDescriptor
+	fd_Params_precompiles                 protoreflect.FieldDescriptor
+	fd_Params_bank_erc20_denoms           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -72,6 +223,13 @@ func init() {
 	fd_Params_evm_denom = md_Params.Fields().ByName("evm_denom")
 	fd_Params_extra_eips = md_Params.Fields().ByName("extra_eips")
 	fd_Params_chain_config = md_Params.Fields().ByName("chain_config")
+	fd_Params_fee_market = md_Params.Fields().ByName("fee_market")
+	fd_Params_fee_distribution = md_Params.Fields().ByName("fee_distribution")
+	fd_Params_dispatch_allowlist = md_Params.Fields().ByName("dispatch_allowlist")
+	fd_Params_precompile_kv_gas = md_Params.Fields().ByName("precompile_kv_gas")
+	fd_Params_precompile_transient_kv_gas = md_Params.Fields().ByName("precompile_transient_kv_gas")
+	fd_Params_precompiles = md_Params.Fields().ByName("precompiles")
+	fd_Params_bank_erc20_denoms = md_Params.Fields().ByName("bank_erc20_denoms")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -157,6 +315,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.FeeMarket != nil {
+		value := protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
+		if !f(fd_Params_fee_market, value) {
+			return
+		}
+	}
+	if x.FeeDistribution != nil {
+		value := protoreflect.ValueOfMessage(x.FeeDistribution.ProtoReflect())
+		if !f(fd_Params_fee_distribution, value) {
+			return
+		}
+	}
+	if len(x.DispatchAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.DispatchAllowlist})
+		if !f(fd_Params_dispatch_allowlist, value) {
+			return
+		}
+	}
+	if x.PrecompileKvGas != nil {
+		value := protoreflect.ValueOfMessage(x.PrecompileKvGas.ProtoReflect())
+		if !f(fd_Params_precompile_kv_gas, value) {
+			return
+		}
+	}
+	if x.PrecompileTransientKvGas != nil {
+		value := protoreflect.ValueOfMessage(x.PrecompileTransientKvGas.ProtoReflect())
+		if !f(fd_Params_precompile_transient_kv_gas, value) {
+			return
+		}
+	}
+	if len(x.Precompiles) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.Precompiles})
+		if !f(fd_Params_precompiles, value) {
+			return
+		}
+	}
+	if len(x.BankErc20Denoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.BankErc20Denoms})
+		if !f(fd_Params_bank_erc20_denoms, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -178,6 +378,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.ExtraEips) != 0
 	case "gridiron.evm.v1alpha1.Params.chain_config":
 		return x.ChainConfig != ""
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		return x.FeeMarket != nil
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		return x.FeeDistribution != nil
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		return len(x.DispatchAllowlist) != 0
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		return x.PrecompileKvGas != nil
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		return x.PrecompileTransientKvGas != nil
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		return len(x.Precompiles) != 0
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		return len(x.BankErc20Denoms) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.Params"))
@@ -200,6 +414,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ExtraEips = nil
 	case "gridiron.evm.v1alpha1.Params.chain_config":
 		x.ChainConfig = ""
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		x.FeeMarket = nil
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		x.FeeDistribution = nil
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		x.DispatchAllowlist = nil
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		x.PrecompileKvGas = nil
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		x.PrecompileTransientKvGas = nil
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		x.Precompiles = nil
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		x.BankErc20Denoms = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.Params"))
@@ -228,6 +456,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "gridiron.evm.v1alpha1.Params.chain_config":
 		value := x.ChainConfig
 		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		value := x.FeeMarket
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		value := x.FeeDistribution
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		if len(x.DispatchAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.DispatchAllowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		value := x.PrecompileKvGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		value := x.PrecompileTransientKvGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		if len(x.Precompiles) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.Precompiles}
+		return protoreflect.ValueOfList(listValue)
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		if len(x.BankErc20Denoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.BankErc20Denoms}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.Params"))
@@ -256,6 +514,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ExtraEips = *clv.list
 	case "gridiron.evm.v1alpha1.Params.chain_config":
 		x.ChainConfig = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		x.FeeMarket = value.Message().Interface().(*FeeMarketParams)
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		x.FeeDistribution = value.Message().Interface().(*FeeDistributionParams)
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.DispatchAllowlist = *clv.list
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		x.PrecompileKvGas = value.Message().Interface().(*KVGasParams)
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		x.PrecompileTransientKvGas = value.Message().Interface().(*KVGasParams)
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.Precompiles = *clv.list
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.BankErc20Denoms = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.Params"))
@@ -282,6 +560,44 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_2_list{list: &x.ExtraEips}
 		return protoreflect.ValueOfList(value)
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		if x.FeeMarket == nil {
+			x.FeeMarket = new(FeeMarketParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		if x.FeeDistribution == nil {
+			x.FeeDistribution = new(FeeDistributionParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeDistribution.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		if x.DispatchAllowlist == nil {
+			x.DispatchAllowlist = []string{}
+		}
+		value := &_Params_6_list{list: &x.DispatchAllowlist}
+		return protoreflect.ValueOfList(value)
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		if x.PrecompileKvGas == nil {
+			x.PrecompileKvGas = new(KVGasParams)
+		}
+		return protoreflect.ValueOfMessage(x.PrecompileKvGas.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		if x.PrecompileTransientKvGas == nil {
+			x.PrecompileTransientKvGas = new(KVGasParams)
+		}
+		return protoreflect.ValueOfMessage(x.PrecompileTransientKvGas.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		if x.Precompiles == nil {
+			x.Precompiles = []*PrecompileConfig{}
+		}
+		value := &_Params_9_list{list: &x.Precompiles}
+		return protoreflect.ValueOfList(value)
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		if x.BankErc20Denoms == nil {
+			x.BankErc20Denoms = []string{}
+		}
+		value := &_Params_10_list{list: &x.BankErc20Denoms}
+		return protoreflect.ValueOfList(value)
 	case "gridiron.evm.v1alpha1.Params.evm_denom":
 		panic(fmt.Errorf("field evm_denom of message gridiron.evm.v1alpha1.Params is not mutable"))
 	case "gridiron.evm.v1alpha1.Params.chain_config":
@@ -306,6 +622,27 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
 	case "gridiron.evm.v1alpha1.Params.chain_config":
 		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.Params.fee_market":
+		m := new(FeeMarketParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.fee_distribution":
+		m := new(FeeDistributionParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.dispatch_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "gridiron.evm.v1alpha1.Params.precompile_kv_gas":
+		m := new(KVGasParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas":
+		m := new(KVGasParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "gridiron.evm.v1alpha1.Params.precompiles":
+		list := []*PrecompileConfig{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "gridiron.evm.v1alpha1.Params.bank_erc20_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.Params"))
@@ -390,6 +727,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.FeeMarket != nil {
+			l = options.Size(x.FeeMarket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDistribution != nil {
+			l = options.Size(x.FeeDistribution)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DispatchAllowlist) > 0 {
+			for _, s := range x.DispatchAllowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrecompileKvGas != nil {
+			l = options.Size(x.PrecompileKvGas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrecompileTransientKvGas != nil {
+			l = options.Size(x.PrecompileTransientKvGas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Precompiles) > 0 {
+			for _, e := range x.Precompiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BankErc20Denoms) > 0 {
+			for _, s := range x.BankErc20Denoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -419,6 +790,96 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BankErc20Denoms) > 0 {
+			for iNdEx := len(x.BankErc20Denoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BankErc20Denoms[iNdEx])
+				copy(dAtA[i:], x.BankErc20Denoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankErc20Denoms[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Precompiles) > 0 {
+			for iNdEx := len(x.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Precompiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.PrecompileTransientKvGas != nil {
+			encoded, err := options.Marshal(x.PrecompileTransientKvGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.PrecompileKvGas != nil {
+			encoded, err := options.Marshal(x.PrecompileKvGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DispatchAllowlist) > 0 {
+			for iNdEx := len(x.DispatchAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DispatchAllowlist[iNdEx])
+				copy(dAtA[i:], x.DispatchAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DispatchAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.FeeDistribution != nil {
+			encoded, err := options.Marshal(x.FeeDistribution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.FeeMarket != nil {
+			encoded, err := options.Marshal(x.FeeMarket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.ChainConfig) > 0 {
 			i -= len(x.ChainConfig)
 			copy(dAtA[i:], x.ChainConfig)
@@ -643,65 +1104,2783 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ChainConfig = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeMarket == nil {
+					x.FeeMarket = &FeeMarketParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeMarket); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.FeeDistribution == nil {
+					x.FeeDistribution = &FeeDistributionParams{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// SPDX-License-Identifier: BUSL-1.1
-//
-// Copyright (C) 2023, Furychain Foundation. All rights reserved.
-// Use of this software is govered by the Business Source License included
-// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
-//
-// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
-// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
-// VERSIONS OF THE LICENSED WORK.
-//
-// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
-// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
-// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
-//
-// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
-// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
-// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
-// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
-// TITLE.
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDistribution); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DispatchAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DispatchAllowlist = append(x.DispatchAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecompileKvGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrecompileKvGas == nil {
+					x.PrecompileKvGas = &KVGasParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrecompileKvGas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecompileTransientKvGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrecompileTransientKvGas == nil {
+					x.PrecompileTransientKvGas = &KVGasParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrecompileTransientKvGas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Precompiles = append(x.Precompiles, &PrecompileConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Precompiles[len(x.Precompiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankErc20Denoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankErc20Denoms = append(x.BankErc20Denoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PrecompileConfig                     protoreflect.MessageDescriptor
+	fd_PrecompileConfig_address             protoreflect.FieldDescriptor
+	fd_PrecompileConfig_deployed_address    protoreflect.FieldDescriptor
+	fd_PrecompileConfig_activation_height   protoreflect.FieldDescriptor
+	fd_PrecompileConfig_deactivation_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_evm_v1alpha1_params_proto_init()
+	md_PrecompileConfig = File_gridiron_evm_v1alpha1_params_proto.Messages().ByName("PrecompileConfig")
+	fd_PrecompileConfig_address = md_PrecompileConfig.Fields().ByName("address")
+	fd_PrecompileConfig_deployed_address = md_PrecompileConfig.Fields().ByName("deployed_address")
+	fd_PrecompileConfig_activation_height = md_PrecompileConfig.Fields().ByName("activation_height")
+	fd_PrecompileConfig_deactivation_height = md_PrecompileConfig.Fields().ByName("deactivation_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_PrecompileConfig)(nil)
+
+type fastReflection_PrecompileConfig PrecompileConfig
+
+func (x *PrecompileConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrecompileConfig)(x)
+}
+
+func (x *PrecompileConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrecompileConfig_messageType fastReflection_PrecompileConfig_messageType
+var _ protoreflect.MessageType = fastReflection_PrecompileConfig_messageType{}
+
+type fastReflection_PrecompileConfig_messageType struct{}
+
+func (x fastReflection_PrecompileConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrecompileConfig)(nil)
+}
+func (x fastReflection_PrecompileConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrecompileConfig)
+}
+func (x fastReflection_PrecompileConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrecompileConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrecompileConfig) Type() protoreflect.MessageType {
+	return _fastReflection_PrecompileConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrecompileConfig) New() protoreflect.Message {
+	return new(fastReflection_PrecompileConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrecompileConfig) Interface() protoreflect.ProtoMessage {
+	return (*PrecompileConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrecompileConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PrecompileConfig_address, value) {
+			return
+		}
+	}
+	if x.DeployedAddress != "" {
+		value := protoreflect.ValueOfString(x.DeployedAddress)
+		if !f(fd_PrecompileConfig_deployed_address, value) {
+			return
+		}
+	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_PrecompileConfig_activation_height, value) {
+			return
+		}
+	}
+	if x.DeactivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeactivationHeight)
+		if !f(fd_PrecompileConfig_deactivation_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrecompileConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		return x.Address != ""
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		return x.DeployedAddress != ""
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		return x.DeactivationHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		x.Address = ""
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		x.DeployedAddress = ""
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		x.ActivationHeight = int64(0)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		x.DeactivationHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrecompileConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		value := x.DeployedAddress
+		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		value := x.DeactivationHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		x.Address = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		x.DeployedAddress = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		x.ActivationHeight = value.Int()
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		x.DeactivationHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		panic(fmt.Errorf("field address of message gridiron.evm.v1alpha1.PrecompileConfig is not mutable"))
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		panic(fmt.Errorf("field deployed_address of message gridiron.evm.v1alpha1.PrecompileConfig is not mutable"))
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		panic(fmt.Errorf("field activation_height of message gridiron.evm.v1alpha1.PrecompileConfig is not mutable"))
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		panic(fmt.Errorf("field deactivation_height of message gridiron.evm.v1alpha1.PrecompileConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrecompileConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.PrecompileConfig.address":
+		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deployed_address":
+		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.PrecompileConfig.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "gridiron.evm.v1alpha1.PrecompileConfig.deactivation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.PrecompileConfig"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.PrecompileConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrecompileConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.evm.v1alpha1.PrecompileConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrecompileConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrecompileConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrecompileConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrecompileConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DeployedAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		if x.DeactivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeactivationHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DeactivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeactivationHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DeployedAddress) > 0 {
+			i -= len(x.DeployedAddress)
+			copy(dAtA[i:], x.DeployedAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeployedAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeployedAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeployedAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeactivationHeight", wireType)
+				}
+				x.DeactivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeactivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeMarketParams                             protoreflect.MessageDescriptor
+	fd_FeeMarketParams_enabled                     protoreflect.FieldDescriptor
+	fd_FeeMarketParams_base_fee_change_denominator protoreflect.FieldDescriptor
+	fd_FeeMarketParams_elasticity_multiplier       protoreflect.FieldDescriptor
+	fd_FeeMarketParams_min_base_fee                protoreflect.FieldDescriptor
+	fd_FeeMarketParams_initial_base_fee            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_evm_v1alpha1_params_proto_init()
+	md_FeeMarketParams = File_gridiron_evm_v1alpha1_params_proto.Messages().ByName("FeeMarketParams")
+	fd_FeeMarketParams_enabled = md_FeeMarketParams.Fields().ByName("enabled")
+	fd_FeeMarketParams_base_fee_change_denominator = md_FeeMarketParams.Fields().ByName("base_fee_change_denominator")
+	fd_FeeMarketParams_elasticity_multiplier = md_FeeMarketParams.Fields().ByName("elasticity_multiplier")
+	fd_FeeMarketParams_min_base_fee = md_FeeMarketParams.Fields().ByName("min_base_fee")
+	fd_FeeMarketParams_initial_base_fee = md_FeeMarketParams.Fields().ByName("initial_base_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeMarketParams)(nil)
+
+type fastReflection_FeeMarketParams FeeMarketParams
+
+func (x *FeeMarketParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(x)
+}
+
+func (x *FeeMarketParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeMarketParams_messageType fastReflection_FeeMarketParams_messageType
+var _ protoreflect.MessageType = fastReflection_FeeMarketParams_messageType{}
+
+type fastReflection_FeeMarketParams_messageType struct{}
+
+func (x fastReflection_FeeMarketParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(nil)
+}
+func (x fastReflection_FeeMarketParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
+}
+func (x fastReflection_FeeMarketParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeMarketParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeMarketParams) Type() protoreflect.MessageType {
+	return _fastReflection_FeeMarketParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeMarketParams) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeMarketParams) Interface() protoreflect.ProtoMessage {
+	return (*FeeMarketParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeMarketParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_FeeMarketParams_enabled, value) {
+			return
+		}
+	}
+	if x.BaseFeeChangeDenominator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeChangeDenominator)
+		if !f(fd_FeeMarketParams_base_fee_change_denominator, value) {
+			return
+		}
+	}
+	if x.ElasticityMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ElasticityMultiplier)
+		if !f(fd_FeeMarketParams_elasticity_multiplier, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinBaseFee)
+		if !f(fd_FeeMarketParams_min_base_fee, value) {
+			return
+		}
+	}
+	if x.InitialBaseFee != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InitialBaseFee)
+		if !f(fd_FeeMarketParams_initial_base_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeMarketParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		return x.Enabled != false
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		return x.BaseFeeChangeDenominator != uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		return x.ElasticityMultiplier != uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		return x.MinBaseFee != uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		return x.InitialBaseFee != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeMarketParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		x.Enabled = false
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		x.ElasticityMultiplier = uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = uint64(0)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		x.InitialBaseFee = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeMarketParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		value := x.BaseFeeChangeDenominator
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		value := x.ElasticityMultiplier
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		value := x.InitialBaseFee
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeMarketParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		x.Enabled = value.Bool()
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = value.Uint()
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		x.ElasticityMultiplier = value.Uint()
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = value.Uint()
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		x.InitialBaseFee = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeMarketParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		panic(fmt.Errorf("field enabled of message gridiron.evm.v1alpha1.FeeMarketParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		panic(fmt.Errorf("field base_fee_change_denominator of message gridiron.evm.v1alpha1.FeeMarketParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		panic(fmt.Errorf("field elasticity_multiplier of message gridiron.evm.v1alpha1.FeeMarketParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message gridiron.evm.v1alpha1.FeeMarketParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		panic(fmt.Errorf("field initial_base_fee of message gridiron.evm.v1alpha1.FeeMarketParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeMarketParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeMarketParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "gridiron.evm.v1alpha1.FeeMarketParams.base_fee_change_denominator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.elasticity_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.min_base_fee":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.FeeMarketParams.initial_base_fee":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeMarketParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeMarketParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeMarketParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.evm.v1alpha1.FeeMarketParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeMarketParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeMarketParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeMarketParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeMarketParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeMarketParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeChangeDenominator))
+		}
+		if x.ElasticityMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.ElasticityMultiplier))
+		}
+		if x.MinBaseFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinBaseFee))
+		}
+		if x.InitialBaseFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialBaseFee))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeMarketParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InitialBaseFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialBaseFee))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinBaseFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinBaseFee))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ElasticityMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ElasticityMultiplier))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeChangeDenominator))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeMarketParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+				}
+				x.BaseFeeChangeDenominator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+				}
+				x.ElasticityMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ElasticityMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				x.MinBaseFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinBaseFee |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialBaseFee", wireType)
+				}
+				x.InitialBaseFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialBaseFee |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDistributionParams                      protoreflect.MessageDescriptor
+	fd_FeeDistributionParams_community_pool_ratio protoreflect.FieldDescriptor
+	fd_FeeDistributionParams_recipient_ratio      protoreflect.FieldDescriptor
+	fd_FeeDistributionParams_recipient            protoreflect.FieldDescriptor
+	fd_FeeDistributionParams_tips_to_proposer     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_evm_v1alpha1_params_proto_init()
+	md_FeeDistributionParams = File_gridiron_evm_v1alpha1_params_proto.Messages().ByName("FeeDistributionParams")
+	fd_FeeDistributionParams_community_pool_ratio = md_FeeDistributionParams.Fields().ByName("community_pool_ratio")
+	fd_FeeDistributionParams_recipient_ratio = md_FeeDistributionParams.Fields().ByName("recipient_ratio")
+	fd_FeeDistributionParams_recipient = md_FeeDistributionParams.Fields().ByName("recipient")
+	fd_FeeDistributionParams_tips_to_proposer = md_FeeDistributionParams.Fields().ByName("tips_to_proposer")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDistributionParams)(nil)
+
+type fastReflection_FeeDistributionParams FeeDistributionParams
+
+func (x *FeeDistributionParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDistributionParams)(x)
+}
+
+func (x *FeeDistributionParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDistributionParams_messageType fastReflection_FeeDistributionParams_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDistributionParams_messageType{}
+
+type fastReflection_FeeDistributionParams_messageType struct{}
+
+func (x fastReflection_FeeDistributionParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDistributionParams)(nil)
+}
+func (x fastReflection_FeeDistributionParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDistributionParams)
+}
+func (x fastReflection_FeeDistributionParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDistributionParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDistributionParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDistributionParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDistributionParams) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDistributionParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDistributionParams) New() protoreflect.Message {
+	return new(fastReflection_FeeDistributionParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDistributionParams) Interface() protoreflect.ProtoMessage {
+	return (*FeeDistributionParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDistributionParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CommunityPoolRatio != "" {
+		value := protoreflect.ValueOfString(x.CommunityPoolRatio)
+		if !f(fd_FeeDistributionParams_community_pool_ratio, value) {
+			return
+		}
+	}
+	if x.RecipientRatio != "" {
+		value := protoreflect.ValueOfString(x.RecipientRatio)
+		if !f(fd_FeeDistributionParams_recipient_ratio, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_FeeDistributionParams_recipient, value) {
+			return
+		}
+	}
+	if x.TipsToProposer != false {
+		value := protoreflect.ValueOfBool(x.TipsToProposer)
+		if !f(fd_FeeDistributionParams_tips_to_proposer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDistributionParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		return x.CommunityPoolRatio != ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		return x.RecipientRatio != ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		return x.Recipient != ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		return x.TipsToProposer != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistributionParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		x.CommunityPoolRatio = ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		x.RecipientRatio = ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		x.Recipient = ""
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		x.TipsToProposer = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDistributionParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		value := x.CommunityPoolRatio
+		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		value := x.RecipientRatio
+		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		value := x.TipsToProposer
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistributionParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		x.CommunityPoolRatio = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		x.RecipientRatio = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		x.Recipient = value.Interface().(string)
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		x.TipsToProposer = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistributionParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		panic(fmt.Errorf("field community_pool_ratio of message gridiron.evm.v1alpha1.FeeDistributionParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		panic(fmt.Errorf("field recipient_ratio of message gridiron.evm.v1alpha1.FeeDistributionParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		panic(fmt.Errorf("field recipient of message gridiron.evm.v1alpha1.FeeDistributionParams is not mutable"))
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		panic(fmt.Errorf("field tips_to_proposer of message gridiron.evm.v1alpha1.FeeDistributionParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDistributionParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.community_pool_ratio":
+		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient_ratio":
+		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.recipient":
+		return protoreflect.ValueOfString("")
+	case "gridiron.evm.v1alpha1.FeeDistributionParams.tips_to_proposer":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.FeeDistributionParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.FeeDistributionParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDistributionParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.evm.v1alpha1.FeeDistributionParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDistributionParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDistributionParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDistributionParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDistributionParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDistributionParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CommunityPoolRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecipientRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TipsToProposer {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDistributionParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TipsToProposer {
+			i--
+			if x.TipsToProposer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.RecipientRatio) > 0 {
+			i -= len(x.RecipientRatio)
+			copy(dAtA[i:], x.RecipientRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecipientRatio)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CommunityPoolRatio) > 0 {
+			i -= len(x.CommunityPoolRatio)
+			copy(dAtA[i:], x.CommunityPoolRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPoolRatio)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDistributionParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDistributionParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDistributionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityPoolRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecipientRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipsToProposer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TipsToProposer = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_KVGasParams                     protoreflect.MessageDescriptor
+	fd_KVGasParams_has_cost            protoreflect.FieldDescriptor
+	fd_KVGasParams_delete_cost         protoreflect.FieldDescriptor
+	fd_KVGasParams_read_cost_flat      protoreflect.FieldDescriptor
+	fd_KVGasParams_read_cost_per_byte  protoreflect.FieldDescriptor
+	fd_KVGasParams_write_cost_flat     protoreflect.FieldDescriptor
+	fd_KVGasParams_write_cost_per_byte protoreflect.FieldDescriptor
+	fd_KVGasParams_iter_next_cost_flat protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_gridiron_evm_v1alpha1_params_proto_init()
+	md_KVGasParams = File_gridiron_evm_v1alpha1_params_proto.Messages().ByName("KVGasParams")
+	fd_KVGasParams_has_cost = md_KVGasParams.Fields().ByName("has_cost")
+	fd_KVGasParams_delete_cost = md_KVGasParams.Fields().ByName("delete_cost")
+	fd_KVGasParams_read_cost_flat = md_KVGasParams.Fields().ByName("read_cost_flat")
+	fd_KVGasParams_read_cost_per_byte = md_KVGasParams.Fields().ByName("read_cost_per_byte")
+	fd_KVGasParams_write_cost_flat = md_KVGasParams.Fields().ByName("write_cost_flat")
+	fd_KVGasParams_write_cost_per_byte = md_KVGasParams.Fields().ByName("write_cost_per_byte")
+	fd_KVGasParams_iter_next_cost_flat = md_KVGasParams.Fields().ByName("iter_next_cost_flat")
+}
+
+var _ protoreflect.Message = (*fastReflection_KVGasParams)(nil)
+
+type fastReflection_KVGasParams KVGasParams
+
+func (x *KVGasParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KVGasParams)(x)
+}
+
+func (x *KVGasParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KVGasParams_messageType fastReflection_KVGasParams_messageType
+var _ protoreflect.MessageType = fastReflection_KVGasParams_messageType{}
+
+type fastReflection_KVGasParams_messageType struct{}
+
+func (x fastReflection_KVGasParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KVGasParams)(nil)
+}
+func (x fastReflection_KVGasParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_KVGasParams)
+}
+func (x fastReflection_KVGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KVGasParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KVGasParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_KVGasParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KVGasParams) Type() protoreflect.MessageType {
+	return _fastReflection_KVGasParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KVGasParams) New() protoreflect.Message {
+	return new(fastReflection_KVGasParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KVGasParams) Interface() protoreflect.ProtoMessage {
+	return (*KVGasParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KVGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HasCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HasCost)
+		if !f(fd_KVGasParams_has_cost, value) {
+			return
+		}
+	}
+	if x.DeleteCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeleteCost)
+		if !f(fd_KVGasParams_delete_cost, value) {
+			return
+		}
+	}
+	if x.ReadCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReadCostFlat)
+		if !f(fd_KVGasParams_read_cost_flat, value) {
+			return
+		}
+	}
+	if x.ReadCostPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReadCostPerByte)
+		if !f(fd_KVGasParams_read_cost_per_byte, value) {
+			return
+		}
+	}
+	if x.WriteCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WriteCostFlat)
+		if !f(fd_KVGasParams_write_cost_flat, value) {
+			return
+		}
+	}
+	if x.WriteCostPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WriteCostPerByte)
+		if !f(fd_KVGasParams_write_cost_per_byte, value) {
+			return
+		}
+	}
+	if x.IterNextCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IterNextCostFlat)
+		if !f(fd_KVGasParams_iter_next_cost_flat, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KVGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		return x.HasCost != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		return x.DeleteCost != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		return x.ReadCostFlat != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		return x.ReadCostPerByte != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		return x.WriteCostFlat != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		return x.WriteCostPerByte != uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		return x.IterNextCostFlat != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KVGasParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		x.HasCost = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		x.DeleteCost = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		x.ReadCostFlat = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		x.ReadCostPerByte = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		x.WriteCostFlat = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		x.WriteCostPerByte = uint64(0)
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		x.IterNextCostFlat = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KVGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		value := x.HasCost
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		value := x.DeleteCost
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		value := x.ReadCostFlat
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		value := x.ReadCostPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		value := x.WriteCostFlat
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		value := x.WriteCostPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		value := x.IterNextCostFlat
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KVGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		x.HasCost = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		x.DeleteCost = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		x.ReadCostFlat = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		x.ReadCostPerByte = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		x.WriteCostFlat = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		x.WriteCostPerByte = value.Uint()
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		x.IterNextCostFlat = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KVGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		panic(fmt.Errorf("field has_cost of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		panic(fmt.Errorf("field delete_cost of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		panic(fmt.Errorf("field read_cost_flat of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		panic(fmt.Errorf("field read_cost_per_byte of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		panic(fmt.Errorf("field write_cost_flat of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		panic(fmt.Errorf("field write_cost_per_byte of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		panic(fmt.Errorf("field iter_next_cost_flat of message gridiron.evm.v1alpha1.KVGasParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KVGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "gridiron.evm.v1alpha1.KVGasParams.has_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.delete_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.read_cost_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.write_cost_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "gridiron.evm.v1alpha1.KVGasParams.iter_next_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: gridiron.evm.v1alpha1.KVGasParams"))
+		}
+		panic(fmt.Errorf("message gridiron.evm.v1alpha1.KVGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KVGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in gridiron.evm.v1alpha1.KVGasParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KVGasParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KVGasParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KVGasParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KVGasParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KVGasParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.HasCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.HasCost))
+		}
+		if x.DeleteCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeleteCost))
+		}
+		if x.ReadCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReadCostFlat))
+		}
+		if x.ReadCostPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReadCostPerByte))
+		}
+		if x.WriteCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.WriteCostFlat))
+		}
+		if x.WriteCostPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.WriteCostPerByte))
+		}
+		if x.IterNextCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.IterNextCostFlat))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KVGasParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IterNextCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IterNextCostFlat))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.WriteCostPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WriteCostPerByte))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.WriteCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WriteCostFlat))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ReadCostPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReadCostPerByte))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReadCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReadCostFlat))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DeleteCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeleteCost))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.HasCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HasCost))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KVGasParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KVGasParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KVGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+				}
+				x.HasCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HasCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+				}
+				x.DeleteCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeleteCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+				}
+				x.ReadCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReadCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+				}
+				x.ReadCostPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReadCostPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+				}
+				x.WriteCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WriteCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+				}
+				x.WriteCostPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WriteCostPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+				}
+				x.IterNextCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IterNextCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
 // source: gridiron/evm/v1alpha1/params.proto
 
 const (
@@ -725,6 +3904,30 @@ type Params struct {
 	// `chain_config` represents the ethereum chain config for the gridiron
 	// EVM
 	ChainConfig string `protobuf:"bytes,3,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+	// `fee_market` defines the EIP-1559 base fee parameters of the gridiron EVM.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,4,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// `fee_distribution` defines how the fees paid by EVM transactions are
+	// distributed.
+	FeeDistribution *FeeDistributionParams `protobuf:"bytes,5,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution,omitempty"`
+	// `dispatch_allowlist` is the list of Cosmos message type URLs (e.g.
+	// `/cosmos.bank.v1beta1.MsgSend`) that may be executed through the dispatch
+	// precompile.
+	DispatchAllowlist []string `protobuf:"bytes,6,rep,name=dispatch_allowlist,json=dispatchAllowlist,proto3" json:"dispatch_allowlist,omitempty"`
+	// `precompile_kv_gas` is the gas schedule charged for the KV store accesses
+	// made during precompile execution.
+	PrecompileKvGas *KVGasParams `protobuf:"bytes,7,opt,name=precompile_kv_gas,json=precompileKvGas,proto3" json:"precompile_kv_gas,omitempty"`
+	// `precompile_transient_kv_gas` is the gas schedule charged for the
+	// transient KV store accesses made during precompile execution.
+	PrecompileTransientKvGas *KVGasParams `protobuf:"bytes,8,opt,name=precompile_transient_kv_gas,json=precompileTransientKvGas,proto3" json:"precompile_transient_kv_gas,omitempty"`
+	// `precompiles` overrides the activation and address of the stateful
+	// precompiles built into the chain. Precompiles without an entry are active
+	// at their default address.
+	Precompiles []*PrecompileConfig `protobuf:"bytes,9,rep,name=precompiles,proto3" json:"precompiles,omitempty"`
+	// `bank_erc20_denoms` is the list of SDK coin denominations that a bank ERC20
+	// token precompile is active for. Like the stateful precompiles built into
+	// the chain, a token precompile can be configured in `precompiles` by its
+	// default address.
+	BankErc20Denoms []string `protobuf:"bytes,10,rep,name=bank_erc20_denoms,json=bankErc20Denoms,proto3" json:"bank_erc20_denoms,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -768,40 +3971,547 @@ func (x *Params) GetChainConfig() string {
 	return ""
 }
 
+func (x *Params) GetFeeMarket() *FeeMarketParams {
+	if x != nil {
+		return x.FeeMarket
+	}
+	return nil
+}
+
+func (x *Params) GetFeeDistribution() *FeeDistributionParams {
+	if x != nil {
+		return x.FeeDistribution
+	}
+	return nil
+}
+
+func (x *Params) GetDispatchAllowlist() []string {
+	if x != nil {
+		return x.DispatchAllowlist
+	}
+	return nil
+}
+
+func (x *Params) GetPrecompileKvGas() *KVGasParams {
+	if x != nil {
+		return x.PrecompileKvGas
+	}
+	return nil
+}
+
+func (x *Params) GetPrecompileTransientKvGas() *KVGasParams {
+	if x != nil {
+		return x.PrecompileTransientKvGas
+	}
+	return nil
+}
+
+func (x *Params) GetPrecompiles() []*PrecompileConfig {
+	if x != nil {
+		return x.Precompiles
+	}
+	return nil
+}
+
+func (x *Params) GetBankErc20Denoms() []string {
+	if x != nil {
+		return x.BankErc20Denoms
+	}
+	return nil
+}
+
+// `PrecompileConfig` defines the governable activation of a stateful
+// precompile built into the chain.
+type PrecompileConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `address` is the default hex address of the precompile, which identifies
+	// it.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// `deployed_address` is the hex address the precompile is called at. If
+	// empty, the precompile is called at its default `address`.
+	DeployedAddress string `protobuf:"bytes,2,opt,name=deployed_address,json=deployedAddress,proto3" json:"deployed_address,omitempty"`
+	// `activation_height` is the first block height at which the precompile is
+	// active. If zero, the precompile is active from genesis.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// `deactivation_height` is the first block height at which the precompile
+	// is no longer active. If zero, the precompile is never deactivated.
+	DeactivationHeight int64 `protobuf:"varint,4,opt,name=deactivation_height,json=deactivationHeight,proto3" json:"deactivation_height,omitempty"`
+}
+
+func (x *PrecompileConfig) Reset() {
+	*x = PrecompileConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileConfig) ProtoMessage() {}
+
+// Deprecated: Use PrecompileConfig.ProtoReflect.Descriptor instead.
+func (*PrecompileConfig) Descriptor() ([]byte, []int) {
+	return file_gridiron_evm_v1alpha1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrecompileConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PrecompileConfig) GetDeployedAddress() string {
+	if x != nil {
+		return x.DeployedAddress
+	}
+	return ""
+}
+
+func (x *PrecompileConfig) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *PrecompileConfig) GetDeactivationHeight() int64 {
+	if x != nil {
+		return x.DeactivationHeight
+	}
+	return 0
+}
+
+// `FeeMarketParams` defines the governable parameters of the EIP-1559 base fee
+// calculation.
+type FeeMarketParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `enabled` toggles the host chain base fee calculation. If disabled, the
+	// gridiron EVM falls back to the Ethereum defaults.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// `base_fee_change_denominator` bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// `elasticity_multiplier` bounds the maximum gas limit an EIP-1559 block may
+	// have relative to its gas target.
+	ElasticityMultiplier uint64 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// `min_base_fee` is the lower bound of the base fee, in the EVM denom.
+	MinBaseFee uint64 `protobuf:"varint,4,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// `initial_base_fee` is the base fee used when no parent block exists.
+	InitialBaseFee uint64 `protobuf:"varint,5,opt,name=initial_base_fee,json=initialBaseFee,proto3" json:"initial_base_fee,omitempty"`
+}
+
+func (x *FeeMarketParams) Reset() {
+	*x = FeeMarketParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeMarketParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeMarketParams) ProtoMessage() {}
+
+// Deprecated: Use FeeMarketParams.ProtoReflect.Descriptor instead.
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return file_gridiron_evm_v1alpha1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeMarketParams) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetElasticityMultiplier() uint64 {
+	if x != nil {
+		return x.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetMinBaseFee() uint64 {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetInitialBaseFee() uint64 {
+	if x != nil {
+		return x.InitialBaseFee
+	}
+	return 0
+}
+
+// `FeeDistributionParams` defines the governable parameters of the EVM fee
+// distribution. The base fee portion of EVM transaction fees is burned by
+// default, the shares set here are redirected away from the burn.
+type FeeDistributionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `community_pool_ratio` is the share of the base fee sent to the community
+	// pool.
+	CommunityPoolRatio string `protobuf:"bytes,1,opt,name=community_pool_ratio,json=communityPoolRatio,proto3" json:"community_pool_ratio,omitempty"`
+	// `recipient_ratio` is the share of the base fee sent to the `recipient`.
+	RecipientRatio string `protobuf:"bytes,2,opt,name=recipient_ratio,json=recipientRatio,proto3" json:"recipient_ratio,omitempty"`
+	// `recipient` is the bech32 address receiving the `recipient_ratio` share of
+	// the base fee.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// `tips_to_proposer` sends the priority tips directly to the account of the
+	// block proposer instead of the fee collector.
+	TipsToProposer bool `protobuf:"varint,4,opt,name=tips_to_proposer,json=tipsToProposer,proto3" json:"tips_to_proposer,omitempty"`
+}
+
+func (x *FeeDistributionParams) Reset() {
+	*x = FeeDistributionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDistributionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDistributionParams) ProtoMessage() {}
+
+// Deprecated: Use FeeDistributionParams.ProtoReflect.Descriptor instead.
+func (*FeeDistributionParams) Descriptor() ([]byte, []int) {
+	return file_gridiron_evm_v1alpha1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeDistributionParams) GetCommunityPoolRatio() string {
+	if x != nil {
+		return x.CommunityPoolRatio
+	}
+	return ""
+}
+
+func (x *FeeDistributionParams) GetRecipientRatio() string {
+	if x != nil {
+		return x.RecipientRatio
+	}
+	return ""
+}
+
+func (x *FeeDistributionParams) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *FeeDistributionParams) GetTipsToProposer() bool {
+	if x != nil {
+		return x.TipsToProposer
+	}
+	return false
+}
+
+// `KVGasParams` defines the gas costs of KV store operations. It mirrors the
+// Cosmos SDK store `GasConfig`.
+type KVGasParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `has_cost` is the flat cost of checking whether a key exists.
+	HasCost uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	// `delete_cost` is the flat cost of deleting a key.
+	DeleteCost uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	// `read_cost_flat` is the flat cost of reading a key.
+	ReadCostFlat uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	// `read_cost_per_byte` is the cost per byte of the value read.
+	ReadCostPerByte uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	// `write_cost_flat` is the flat cost of writing a key.
+	WriteCostFlat uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	// `write_cost_per_byte` is the cost per byte of the key and value written.
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	// `iter_next_cost_flat` is the flat cost of advancing an iterator.
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (x *KVGasParams) Reset() {
+	*x = KVGasParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gridiron_evm_v1alpha1_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVGasParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGasParams) ProtoMessage() {}
+
+// Deprecated: Use KVGasParams.ProtoReflect.Descriptor instead.
+func (*KVGasParams) Descriptor() ([]byte, []int) {
+	return file_gridiron_evm_v1alpha1_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KVGasParams) GetHasCost() uint64 {
+	if x != nil {
+		return x.HasCost
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetDeleteCost() uint64 {
+	if x != nil {
+		return x.DeleteCost
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetReadCostFlat() uint64 {
+	if x != nil {
+		return x.ReadCostFlat
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetReadCostPerByte() uint64 {
+	if x != nil {
+		return x.ReadCostPerByte
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetWriteCostFlat() uint64 {
+	if x != nil {
+		return x.WriteCostFlat
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetWriteCostPerByte() uint64 {
+	if x != nil {
+		return x.WriteCostPerByte
+	}
+	return 0
+}
+
+func (x *KVGasParams) GetIterNextCostFlat() uint64 {
+	if x != nil {
+		return x.IterNextCostFlat
+	}
+	return 0
+}
+
 var File_gridiron_evm_v1alpha1_params_proto protoreflect.FileDescriptor
 
 var file_gridiron_evm_v1alpha1_params_proto_rawDesc = []byte{
-	0x0a, 0x21, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xba, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76,
-	0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2,
-	0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a,
-	0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x03, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x49, 0x50, 0x73,
-	0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
-	0x65, 0x69, 0x70, 0x73, 0x22, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x45, 0x69, 0x70, 0x73,
-	0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0xcc, 0x01, 0x0a,
-	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x6c, 0x61, 0x72,
-	0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b,
-	0x65, 0x76, 0x6d, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x45,
-	0x58, 0xaa, 0x02, 0x14, 0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e,
-	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x14, 0x50, 0x6f, 0x6c, 0x61, 0x72,
-	0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2,
-	0x02, 0x20, 0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x16, 0x50, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x76,
-	0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x0a, 0x22, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x07, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22,
+	0x52, 0x08, 0x65, 0x76, 0x6d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x22,
+	0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f,
+	0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70,
+	0x73, 0x22, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x45, 0x69, 0x70, 0x73, 0x12, 0x3a, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x60, 0x0a, 0x0a, 0x66, 0x65, 0x65,
+	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x19, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x11, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22,
+	0x52, 0x09, 0x66, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x78, 0x0a, 0x10, 0x66,
+	0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x1f, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x5f, 0x6b, 0x76, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0f, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4b, 0x56, 0x47, 0x61, 0x73, 0xf2, 0xde, 0x1f, 0x18,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x5f, 0x6b, 0x76, 0x5f, 0x67, 0x61, 0x73, 0x22, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x4b, 0x76, 0x47, 0x61, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x1b, 0x70, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x6b, 0x76, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x18, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x56, 0x47, 0x61, 0x73, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x76, 0x5f, 0x67, 0x61, 0x73, 0x22, 0x52, 0x18, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x76, 0x47, 0x61, 0x73, 0x12, 0x65, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x72, 0x69,
+	0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x52,
+	0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x11,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2f, 0xe2, 0xde, 0x1f, 0x0f, 0x42, 0x61, 0x6e,
+	0x6b, 0x45, 0x52, 0x43, 0x32, 0x30, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0xf2, 0xde, 0x1f, 0x18,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x6b, 0x45, 0x72,
+	0x63, 0x32, 0x30, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0xa4, 0x02, 0x0a, 0x10, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x10, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x4f, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1e, 0xf2, 0xde,
+	0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x12, 0x64, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xff, 0x02, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x65, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x26, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x52,
+	0x18, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x15, 0x65, 0x6c, 0x61,
+	0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x52, 0x14, 0x65, 0x6c, 0x61, 0x73,
+	0x74, 0x69, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x52,
+	0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x22, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x22, 0xa5, 0x03, 0x0a, 0x15, 0x46, 0x65, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x82, 0x01, 0x0a,
+	0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x74, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x69, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xf2,
+	0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x69, 0x70, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x52, 0x0e, 0x74, 0x69, 0x70, 0x73,
+	0x54, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0xe5, 0x03, 0x0a, 0x0b, 0x4b,
+	0x56, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x61,
+	0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xf2, 0xde,
+	0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x22, 0x52, 0x07, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xf2, 0xde, 0x1f,
+	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x74, 0x22, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x73, 0x74,
+	0x46, 0x6c, 0x61, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x52,
+	0x0f, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65,
+	0x12, 0x42, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x66,
+	0x6c, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x66, 0x6c, 0x61, 0x74, 0x22, 0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74,
+	0x46, 0x6c, 0x61, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x22, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x65, 0x72, 0x42,
+	0x79, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x22,
+	0x52, 0x10, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x46, 0x6c,
+	0x61, 0x74, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x72, 0x69, 0x64, 0x69,
+	0x72, 0x6f, 0x6e, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x45, 0x58, 0xaa, 0x02, 0x15, 0x47, 0x72, 0x69, 0x64,
+	0x69, 0x72, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xca, 0x02, 0x15, 0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x5c, 0x45, 0x76, 0x6d,
+	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x21, 0x47, 0x72, 0x69, 0x64,
+	0x69, 0x72, 0x6f, 0x6e, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x47, 0x72, 0x69, 0x64, 0x69, 0x72, 0x6f, 0x6e, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -816,16 +4526,25 @@ func file_gridiron_evm_v1alpha1_params_proto_rawDescGZIP() []byte {
 	return file_gridiron_evm_v1alpha1_params_proto_rawDescData
 }
 
-var file_gridiron_evm_v1alpha1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gridiron_evm_v1alpha1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_gridiron_evm_v1alpha1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: gridiron.evm.v1alpha1.Params
+	(*Params)(nil),                // 0: gridiron.evm.v1alpha1.Params
+	(*PrecompileConfig)(nil),      // 1: gridiron.evm.v1alpha1.PrecompileConfig
+	(*FeeMarketParams)(nil),       // 2: gridiron.evm.v1alpha1.FeeMarketParams
+	(*FeeDistributionParams)(nil), // 3: gridiron.evm.v1alpha1.FeeDistributionParams
+	(*KVGasParams)(nil),           // 4: gridiron.evm.v1alpha1.KVGasParams
 }
 var file_gridiron_evm_v1alpha1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: gridiron.evm.v1alpha1.Params.fee_market:type_name -> gridiron.evm.v1alpha1.FeeMarketParams
+	3, // 1: gridiron.evm.v1alpha1.Params.fee_distribution:type_name -> gridiron.evm.v1alpha1.FeeDistributionParams
+	4, // 2: gridiron.evm.v1alpha1.Params.precompile_kv_gas:type_name -> gridiron.evm.v1alpha1.KVGasParams
+	4, // 3: gridiron.evm.v1alpha1.Params.precompile_transient_kv_gas:type_name -> gridiron.evm.v1alpha1.KVGasParams
+	1, // 4: gridiron.evm.v1alpha1.Params.precompiles:type_name -> gridiron.evm.v1alpha1.PrecompileConfig
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_gridiron_evm_v1alpha1_params_proto_init() }
@@ -846,6 +4565,54 @@ func file_gridiron_evm_v1alpha1_params_proto_init() {
 				return nil
 			}
 		}
+		file_gridiron_evm_v1alpha1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecompileConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_evm_v1alpha1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeMarketParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_evm_v1alpha1_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDistributionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gridiron_evm_v1alpha1_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVGasParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -853,7 +4620,7 @@ func file_gridiron_evm_v1alpha1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gridiron_evm_v1alpha1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -39,7 +39,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	QueryService_Params_FullMethodName = "/gridiron.evm.v1alpha1.QueryService/Params"
+	QueryService_Params_FullMethodName  = "/gridiron.evm.v1alpha1.QueryService/Params"
+	QueryService_BaseFee_FullMethodName = "/gridiron.evm.v1alpha1.QueryService/BaseFee"
 )
 
 // QueryServiceClient is the client API for QueryService service.
@@ -48,6 +49,8 @@ const (
 type QueryServiceClient interface {
 	// Params returns the total set of evm parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// BaseFee returns the base fee of the latest finalized gridiron block.
+	BaseFee(ctx context.Context, in *BaseFeeRequest, opts ...grpc.CallOption) (*BaseFeeResponse, error)
 }
 
 type queryServiceClient struct {
@@ -67,12 +70,23 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) BaseFee(ctx context.Context, in *BaseFeeRequest, opts ...grpc.CallOption) (*BaseFeeResponse, error) {
+	out := new(BaseFeeResponse)
+	err := c.cc.Invoke(ctx, QueryService_BaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility
 type QueryServiceServer interface {
 	// Params returns the total set of evm parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// BaseFee returns the base fee of the latest finalized gridiron block.
+	BaseFee(context.Context, *BaseFeeRequest) (*BaseFeeResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -83,6 +97,9 @@ type UnimplementedQueryServiceServer struct {
 func (UnimplementedQueryServiceServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServiceServer) BaseFee(context.Context, *BaseFeeRequest) (*BaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 
 // UnsafeQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -114,6 +131,24 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_BaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BaseFee(ctx, req.(*BaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +160,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _QueryService_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/evm/v1alpha1/query.proto",
@@ -41,6 +41,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	MsgService_EthTransaction_FullMethodName = "/gridiron.evm.v1alpha1.MsgService/EthTransaction"
 	MsgService_UpdateParams_FullMethodName   = "/gridiron.evm.v1alpha1.MsgService/UpdateParams"
+	MsgService_CallContract_FullMethodName   = "/gridiron.evm.v1alpha1.MsgService/CallContract"
+	MsgService_DeployContract_FullMethodName = "/gridiron.evm.v1alpha1.MsgService/DeployContract"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -54,6 +56,12 @@ type MsgServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	// `CallContract` defines a method for calling an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error)
+	// `DeployContract` defines a method for deploying an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	DeployContract(ctx context.Context, in *DeployContractRequest, opts ...grpc.CallOption) (*DeployContractResponse, error)
 }
 
 type msgServiceClient struct {
@@ -82,6 +90,24 @@ func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsReq
 	return out, nil
 }
 
+func (c *msgServiceClient) CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error) {
+	out := new(CallContractResponse)
+	err := c.cc.Invoke(ctx, MsgService_CallContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DeployContract(ctx context.Context, in *DeployContractRequest, opts ...grpc.CallOption) (*DeployContractResponse, error) {
+	out := new(DeployContractResponse)
+	err := c.cc.Invoke(ctx, MsgService_DeployContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility
@@ -93,6 +119,12 @@ type MsgServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	// `CallContract` defines a method for calling an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	CallContract(context.Context, *CallContractRequest) (*CallContractResponse, error)
+	// `DeployContract` defines a method for deploying an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	DeployContract(context.Context, *DeployContractRequest) (*DeployContractResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -106,6 +138,12 @@ func (UnimplementedMsgServiceServer) EthTransaction(context.Context, *EthTransac
 func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServiceServer) CallContract(context.Context, *CallContractRequest) (*CallContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallContract not implemented")
+}
+func (UnimplementedMsgServiceServer) DeployContract(context.Context, *DeployContractRequest) (*DeployContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployContract not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 
 // UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -155,6 +193,42 @@ func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CallContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CallContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CallContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CallContract(ctx, req.(*CallContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeployContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeployContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_DeployContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeployContract(ctx, req.(*DeployContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -170,6 +244,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _MsgService_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CallContract",
+			Handler:    _MsgService_CallContract_Handler,
+		},
+		{
+			MethodName: "DeployContract",
+			Handler:    _MsgService_DeployContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/evm/v1alpha1/tx.proto",
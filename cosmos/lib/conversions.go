@@ -25,6 +25,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -68,6 +69,31 @@ func ExtractCoinsFromInput(coins any) (sdk.Coins, error) {
 	return sdkCoins, nil
 }
 
+// ExtractPageRequestFromInput converts a page request from input (of type any) into a
+// *query.PageRequest.
+func ExtractPageRequestFromInput(pageRequest any) (*query.PageRequest, error) {
+	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
+	// the any type input into the generated PageRequest struct of a precompile.
+	req, ok := utils.GetAs[struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	}](pageRequest)
+	if !ok {
+		return nil, precompile.ErrInvalidPageRequest
+	}
+
+	return &query.PageRequest{
+		Key:        req.Key,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		CountTotal: req.CountTotal,
+		Reverse:    req.Reverse,
+	}, nil
+}
+
 // SdkCoinsToUnnamedCoins converts sdk.Coins into an unnamed struct.
 func SdkCoinsToUnnamedCoins(coins sdk.Coins) any {
 	unnamedCoins := []struct {
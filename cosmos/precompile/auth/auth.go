@@ -25,20 +25,44 @@ import (
 	"math/big"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/auth"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
 	"pkg.furychain.dev/gridiron/eth/common"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
-const requiredGas = 1000
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in auth_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// convertGas is charged by the stateless address conversions.
+	convertGas = 700
+	// queryGas is charged by the read-only query methods.
+	queryGas = 1900
+	// txGas is charged by the state-changing methods.
+	txGas = 7600
+)
+
+// Cosmos events emitted by the auth(z) precompile, converted to the Eth logs of `IAuthModule`.
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorization   = "exec_authorization"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
 
 // Contract is the precompile contract for the auth(z) module.
 type Contract struct {
@@ -46,11 +70,19 @@ type Contract struct {
 
 	msgServer   authz.MsgServer
 	queryServer authz.QueryServer
+	registry    codectypes.InterfaceRegistry
+	allowlist   AllowlistKeeper
 }
 
 // NewPrecompileContract returns a new instance of the auth(z) module precompile contract. Uses the
-// auth module's account address as the contract address.
-func NewPrecompileContract(m authz.MsgServer, q authz.QueryServer) *Contract {
+// auth module's account address as the contract address. Messages executed on behalf of a granter
+// are decoded with the interface `registry` and must be allowed by the `allowlist`.
+func NewPrecompileContract(
+	m authz.MsgServer,
+	q authz.QueryServer,
+	registry codectypes.InterfaceRegistry,
+	allowlist AllowlistKeeper,
+) *Contract {
 	return &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			generated.AuthModuleMetaData.ABI,
@@ -60,6 +92,8 @@ func NewPrecompileContract(m authz.MsgServer, q authz.QueryServer) *Contract {
 		),
 		msgServer:   m,
 		queryServer: q,
+		registry:    registry,
+		allowlist:   allowlist,
 	}
 }
 
@@ -69,24 +103,75 @@ func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 		{
 			AbiSig:      "convertHexToBech32(address)",
 			Execute:     c.ConvertHexToBech32,
-			RequiredGas: requiredGas,
+			RequiredGas: convertGas,
 		},
 		{
 			AbiSig:      "convertBech32ToHexAddress(string)",
 			Execute:     c.ConvertBech32ToHexAddress,
-			RequiredGas: requiredGas,
+			RequiredGas: convertGas,
+		},
+		{
+			AbiSig:      "setSendAllowance(address,address,(uint256,string)[],uint256)",
+			Execute:     c.SetSendAllowance,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getSendAllowance(address,address,string)",
+			Execute:     c.GetSendAllowance,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "grantGenericAuthorization(address,string,uint256)",
+			Execute:     c.GrantGenericAuthorization,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "grantSendAuthorization(address,(uint256,string)[],address[],uint256)",
+			Execute:     c.GrantSendAuthorization,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "grantStakeAuthorization(address,int32,address[],address[],(uint256,string),uint256)",
+			Execute:     c.GrantStakeAuthorization,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "revoke(address,string)",
+			Execute:     c.Revoke,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "exec(string[],bytes[])",
+			Execute:     c.Exec,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getGrants(address,address,string,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetGrants,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "setSendAllowance(address,address,(uint256,string)[],uint256)",
-			Execute: c.SetSendAllowance,
+			AbiSig:      "getGranterGrants(address,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetGranterGrants,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "getSendAllowance(address,address,string)",
-			Execute: c.GetSendAllowance,
+			AbiSig:      "getGranteeGrants(address,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetGranteeGrants,
+			RequiredGas: queryGas,
 		},
 	}
 }
 
+// CustomValueDecoders implements StatefulImpl.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		AttributeKeyGranter:    log.ConvertAccAddressFromBech32,
+		AttributeKeyGrantee:    log.ConvertAccAddressFromBech32,
+		AttributeKeyMsgTypeURL: log.ReturnStringAsIs,
+	}
+}
+
 // ConvertHexToBech32 converts a common.Address to a bech32 string.
 func (c *Contract) ConvertHexToBech32(
 	ctx context.Context,
@@ -212,6 +297,299 @@ func (c *Contract) GetSendAllowance(
 	)
 }
 
+// GrantGenericAuthorization grants the grantee an authorization, without any limits, to execute
+// messages of the given type on behalf of the caller.
+func (c *Contract) GrantGenericAuthorization(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	expiration, ok := utils.GetAs[*big.Int](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	return c.grantHelper(
+		ctx,
+		time.Unix(int64(evm.GetContext().Time), 0),
+		cosmlib.AddressToAccAddress(caller),
+		cosmlib.AddressToAccAddress(grantee),
+		authz.NewGenericAuthorization(msgTypeURL),
+		expiration,
+	)
+}
+
+// GrantSendAuthorization grants the grantee an authorization to send up to the spend limit of the
+// caller's coins, optionally only to the addresses in the allow list.
+func (c *Contract) GrantSendAuthorization(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	spendLimit, err := cosmlib.ExtractCoinsFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+	allowList, ok := utils.GetAs[[]common.Address](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	expiration, ok := utils.GetAs[*big.Int](args[3])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	allowed := make([]sdk.AccAddress, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = cosmlib.AddressToAccAddress(addr)
+	}
+
+	return c.grantHelper(
+		ctx,
+		time.Unix(int64(evm.GetContext().Time), 0),
+		cosmlib.AddressToAccAddress(caller),
+		cosmlib.AddressToAccAddress(grantee),
+		banktypes.NewSendAuthorization(spendLimit, allowed),
+		expiration,
+	)
+}
+
+// GrantStakeAuthorization grants the grantee an authorization to delegate, undelegate or
+// redelegate up to the max tokens of the caller's coins, to or from the allowed validators.
+func (c *Contract) GrantStakeAuthorization(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	authorizationType, ok := utils.GetAs[int32](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidInt32
+	}
+	allowList, ok := utils.GetAs[[]common.Address](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	denyList, ok := utils.GetAs[[]common.Address](args[3])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	maxTokens, ok := utils.GetAs[struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	}](args[4])
+	if !ok {
+		return nil, precompile.ErrInvalidCoin
+	}
+	expiration, ok := utils.GetAs[*big.Int](args[5])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	// A zero max tokens amount means the grantee can stake an unlimited amount of coins.
+	var maxCoin *sdk.Coin
+	if maxTokens.Amount != nil && maxTokens.Amount.Sign() != 0 {
+		coin := sdk.NewCoin(maxTokens.Denom, sdk.NewIntFromBigInt(maxTokens.Amount))
+		maxCoin = &coin
+	}
+
+	stakeAuth, err := stakingtypes.NewStakeAuthorization(
+		addressesToValAddresses(allowList),
+		addressesToValAddresses(denyList),
+		stakingtypes.AuthorizationType(authorizationType),
+		maxCoin,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grantHelper(
+		ctx,
+		time.Unix(int64(evm.GetContext().Time), 0),
+		cosmlib.AddressToAccAddress(caller),
+		cosmlib.AddressToAccAddress(grantee),
+		stakeAuth,
+		expiration,
+	)
+}
+
+// Revoke revokes the grantee's authorization to execute messages of the given type on behalf of
+// the caller.
+func (c *Contract) Revoke(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	return c.revokeHelper(
+		ctx,
+		cosmlib.AddressToAccAddress(caller),
+		cosmlib.AddressToAccAddress(grantee),
+		msgTypeURL,
+	)
+}
+
+// Exec executes the given protobuf encoded messages with the caller as the grantee. The
+// granter of each message is its signer, who must have granted the caller an authorization for it.
+func (c *Contract) Exec(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	typeURLs, ok := utils.GetAs[[]string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	msgs, ok := utils.GetAs[[][]byte](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBytes
+	}
+
+	if readonly {
+		return nil, ErrReadOnly
+	}
+	if value != nil && value.Sign() != 0 {
+		return nil, ErrNonZeroValue
+	}
+	if len(typeURLs) != len(msgs) {
+		return nil, ErrMismatchedMsgs
+	}
+
+	return c.execHelper(ctx, cosmlib.AddressToAccAddress(caller), typeURLs, msgs)
+}
+
+// GetGrants returns the grants from the granter to the grantee, filtered by the message type if
+// one is given.
+func (c *Contract) GetGrants(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	granter, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	grantee, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	pagination, err := cosmlib.ExtractPageRequestFromInput(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getGrantsHelper(
+		ctx,
+		cosmlib.AddressToAccAddress(granter),
+		cosmlib.AddressToAccAddress(grantee),
+		msgTypeURL,
+		pagination,
+	)
+}
+
+// GetGranterGrants returns the grants given by the granter.
+func (c *Contract) GetGranterGrants(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	granter, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	pagination, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryServer.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{
+		Granter:    cosmlib.AddressToAccAddress(granter).String(),
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grantAuthorizationsToOutput(res.Grants, res.Pagination)
+}
+
+// GetGranteeGrants returns the grants given to the grantee.
+func (c *Contract) GetGranteeGrants(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	pagination, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryServer.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{
+		Grantee:    cosmlib.AddressToAccAddress(grantee).String(),
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grantAuthorizationsToOutput(res.Grants, res.Pagination)
+}
+
 // getHighestAllowance returns the highest allowance for a given coin denom.
 func getHighestAllowance(sendAuths []*banktypes.SendAuthorization, coinDenom string) *big.Int {
 	// Init the max to 0.
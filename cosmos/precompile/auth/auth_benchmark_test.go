@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package auth_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth/mock"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+var (
+	benchGranter = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("granter")))
+	benchGrantee = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("grantee")))
+	benchLimit   = sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+)
+
+// setupBenchmark returns an auth(z) precompile and an evm with a block time of 100.
+func setupBenchmark() (sdk.Context, *mock.PrecompileEVMMock, *auth.Contract) {
+	ctx, ak, _, _ := testutil.SetupMinimalKeepers()
+	k := authzkeeper.NewKeeper(
+		testutil.EvmKey,
+		testutil.GetEncodingConfig().Codec,
+		MsgRouterMockWithSend(),
+		ak,
+	)
+	evm := mock.NewPrecompileEVMMock()
+	evm.GetContextFunc = func() *vm.BlockContext {
+		return &vm.BlockContext{Time: 100}
+	}
+	return ctx, evm, utils.MustGetAs[*auth.Contract](auth.NewPrecompileContract(
+		k, k, testutil.GetEncodingConfig().InterfaceRegistry, mockAllowlist{},
+	))
+}
+
+func BenchmarkConvertBech32ToHexAddress(b *testing.B) {
+	ctx, evm, contract := setupBenchmark()
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, common.Address{}, "convertBech32ToHexAddress",
+		cosmlib.AddressToAccAddress(benchGranter).String(),
+	)
+}
+
+func BenchmarkSetSendAllowance(b *testing.B) {
+	ctx, evm, contract := setupBenchmark()
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, common.Address{}, "setSendAllowance",
+		benchGranter, benchGrantee, sdkCoinsToEvmCoins(benchLimit), big.NewInt(0),
+	)
+}
+
+func BenchmarkGetSendAllowance(b *testing.B) {
+	ctx, evm, contract := setupBenchmark()
+	if _, err := contract.SetSendAllowance(
+		ctx, evm, common.Address{}, big.NewInt(0), false,
+		benchGranter, benchGrantee, sdkCoinsToEvmCoins(benchLimit), big.NewInt(0),
+	); err != nil {
+		b.Fatal(err)
+	}
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, common.Address{}, "getSendAllowance",
+		benchGranter, benchGrantee, "test",
+	)
+}
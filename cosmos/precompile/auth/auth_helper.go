@@ -22,14 +22,21 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/auth"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/eth/common"
 )
 
 // setSendAllowanceHelper is the helper method to call the grant method on the msgServer, with a
@@ -41,23 +48,11 @@ func (c *Contract) setSendAllowanceHelper(
 	limit sdk.Coins,
 	expiration *big.Int,
 ) ([]any, error) {
-	var (
-		grant authz.Grant
-		err   error
-	)
-
 	// Create the send authorization via bank module.
 	sendAuth := banktypes.NewSendAuthorization(limit, []sdk.AccAddress{grantee})
 
-	// If the expiration is 0, then the grant is valid forever, and can be nil.
-	if expiration.Cmp(big.NewInt(0)) == 0 {
-		grant, err = authz.NewGrant(blocktime, sendAuth, nil)
-	} else {
-		expirationTime := time.Unix(expiration.Int64(), 0)
-		grant, err = authz.NewGrant(blocktime, sendAuth, &expirationTime)
-	}
-
 	// Assert that the grant is valid.
+	grant, err := newGrant(blocktime, sendAuth, expiration)
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +98,235 @@ func (c *Contract) getSendAllownaceHelper(
 
 	return []any{allowance}, nil
 }
+
+// grantHelper is the helper method to call the grant method on the msgServer, with the given
+// authorization. A `GrantAuthorization` event is emitted on success.
+func (c *Contract) grantHelper(
+	ctx context.Context,
+	blocktime time.Time,
+	granter, grantee sdk.AccAddress,
+	authorization authz.Authorization,
+	expiration *big.Int,
+) ([]any, error) {
+	grant, err := newGrant(blocktime, authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.msgServer.Grant(ctx, &authz.MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	}); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeGrantAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+		),
+	)
+	return []any{true}, nil
+}
+
+// revokeHelper is the helper method to call the revoke method on the msgServer. A
+// `RevokeAuthorization` event is emitted on success.
+func (c *Contract) revokeHelper(
+	ctx context.Context,
+	granter, grantee sdk.AccAddress,
+	msgTypeURL string,
+) ([]any, error) {
+	if _, err := c.msgServer.Revoke(ctx, &authz.MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRevokeAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+	return []any{true}, nil
+}
+
+// execHelper decodes the given messages, checks that they are allowed to be executed from the
+// EVM, and calls the exec method on the msgServer. An `ExecAuthorization` event is emitted for
+// each executed message and the results of the messages are returned.
+func (c *Contract) execHelper(
+	ctx context.Context,
+	grantee sdk.AccAddress,
+	typeURLs []string,
+	msgBzs [][]byte,
+) ([]any, error) {
+	msgs := make([]*codectypes.Any, len(typeURLs))
+	for i, typeURL := range typeURLs {
+		var msg sdk.Msg
+		if err := c.registry.UnpackAny(
+			&codectypes.Any{TypeUrl: typeURL, Value: msgBzs[i]}, &msg,
+		); err != nil {
+			return nil, err
+		}
+		if !c.allowlist.IsDispatchAllowed(ctx, sdk.MsgTypeURL(msg)) {
+			return nil, errorsmod.Wrap(ErrMsgNotAllowed, sdk.MsgTypeURL(msg))
+		}
+
+		var err error
+		if msgs[i], err = codectypes.NewAnyWithValue(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// The authz module re-emits the events of the executed messages, which have no Eth log
+	// equivalent, so the messages are executed on a fresh event manager.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := c.msgServer.Exec(sdkCtx.WithEventManager(sdk.NewEventManager()), &authz.MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    msgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range msgs {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeExecAuthorization,
+				sdk.NewAttribute(AttributeKeyGrantee, grantee.String()),
+				sdk.NewAttribute(AttributeKeyMsgTypeURL, msg.TypeUrl),
+			),
+		)
+	}
+	return []any{res.Results}, nil
+}
+
+// getGrantsHelper returns the grants from the granter to the grantee. No grants are returned,
+// rather than an error, if there is no grant for the message type.
+func (c *Contract) getGrantsHelper(
+	ctx context.Context,
+	granter, grantee sdk.AccAddress,
+	msgTypeURL string,
+	pagination *query.PageRequest,
+) ([]any, error) {
+	res, err := c.queryServer.Grants(ctx, &authz.QueryGrantsRequest{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+		Pagination: pagination,
+	})
+	if errors.Is(err, authz.ErrNoAuthorizationFound) {
+		return []any{[]generated.IAuthModuleGrant{}, generated.IAuthModulePageResponse{}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	grants := make([]generated.IAuthModuleGrant, len(res.Grants))
+	for i, grant := range res.Grants {
+		if grants[i], err = c.grantToOutput(
+			cosmlib.AccAddressToEthAddress(granter),
+			cosmlib.AccAddressToEthAddress(grantee),
+			grant.Authorization,
+			grant.Expiration,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return []any{grants, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// grantAuthorizationsToOutput converts the grant authorizations returned by the granter and
+// grantee queries to the ABI output of the grant queries.
+func (c *Contract) grantAuthorizationsToOutput(
+	grantAuths []*authz.GrantAuthorization,
+	pageRes *query.PageResponse,
+) ([]any, error) {
+	grants := make([]generated.IAuthModuleGrant, len(grantAuths))
+	for i, grantAuth := range grantAuths {
+		granter, err := sdk.AccAddressFromBech32(grantAuth.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := sdk.AccAddressFromBech32(grantAuth.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		if grants[i], err = c.grantToOutput(
+			cosmlib.AccAddressToEthAddress(granter),
+			cosmlib.AccAddressToEthAddress(grantee),
+			grantAuth.Authorization,
+			grantAuth.Expiration,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return []any{grants, pageResponseToOutput(pageRes)}, nil
+}
+
+// grantToOutput converts a grant to the `IAuthModule.Grant` struct. The authorization is returned
+// protobuf encoded, along with its type URL. A grant without expiration has an expiration of 0.
+func (c *Contract) grantToOutput(
+	granter, grantee common.Address,
+	authorizationAny *codectypes.Any,
+	expiration *time.Time,
+) (generated.IAuthModuleGrant, error) {
+	var authorization authz.Authorization
+	if err := c.registry.UnpackAny(authorizationAny, &authorization); err != nil {
+		return generated.IAuthModuleGrant{}, err
+	}
+
+	expirationTime := big.NewInt(0)
+	if expiration != nil {
+		expirationTime = big.NewInt(expiration.Unix())
+	}
+
+	return generated.IAuthModuleGrant{
+		Granter:              granter,
+		Grantee:              grantee,
+		MsgTypeUrl:           authorization.MsgTypeURL(),
+		AuthorizationTypeUrl: authorizationAny.TypeUrl,
+		Authorization:        authorizationAny.Value,
+		Expiration:           expirationTime,
+	}, nil
+}
+
+// newGrant returns a grant of the authorization. If the expiration is 0, then the grant is valid
+// forever, and can be nil.
+func newGrant(
+	blocktime time.Time,
+	authorization authz.Authorization,
+	expiration *big.Int,
+) (authz.Grant, error) {
+	if expiration.Sign() == 0 {
+		return authz.NewGrant(blocktime, authorization, nil)
+	}
+	expirationTime := time.Unix(expiration.Int64(), 0)
+	return authz.NewGrant(blocktime, authorization, &expirationTime)
+}
+
+// pageResponseToOutput converts a query.PageResponse to the `IAuthModule.PageResponse` struct.
+func pageResponseToOutput(pageRes *query.PageResponse) generated.IAuthModulePageResponse {
+	if pageRes == nil {
+		return generated.IAuthModulePageResponse{}
+	}
+	return generated.IAuthModulePageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
+	}
+}
+
+// addressesToValAddresses converts a list of Eth addresses to validator addresses.
+func addressesToValAddresses(addrs []common.Address) []sdk.ValAddress {
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = cosmlib.AddressToValAddress(addr)
+	}
+	return valAddrs
+}
@@ -25,9 +25,12 @@ import (
 	"math/big"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/auth"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
@@ -49,6 +52,13 @@ func TestAddressPrecompile(t *testing.T) {
 	RunSpecs(t, "cosmos/precompile/auth")
 }
 
+// mockAllowlist is an `AllowlistKeeper` backed by a static set of type URLs.
+type mockAllowlist map[string]bool
+
+func (m mockAllowlist) IsDispatchAllowed(_ context.Context, typeURL string) bool {
+	return m[typeURL]
+}
+
 var _ = Describe("Address Precompile", func() {
 	var contract *auth.Contract
 	var ctx sdk.Context
@@ -62,7 +72,12 @@ var _ = Describe("Address Precompile", func() {
 			MsgRouterMockWithSend(),
 			ak,
 		)
-		contract = utils.MustGetAs[*auth.Contract](auth.NewPrecompileContract(k, k))
+		contract = utils.MustGetAs[*auth.Contract](auth.NewPrecompileContract(
+			k,
+			k,
+			testutil.GetEncodingConfig().InterfaceRegistry,
+			mockAllowlist{sdk.MsgTypeURL(&banktypes.MsgSend{}): true},
+		))
 
 	})
 
@@ -83,8 +98,10 @@ var _ = Describe("Address Precompile", func() {
 		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
 	})
 
-	It("custom value decoder should be no-op", func() {
-		Expect(contract.CustomValueDecoders()).To(BeNil())
+	It("should have custom value decoders for the authz events", func() {
+		Expect(contract.CustomValueDecoders()).To(HaveKey(auth.AttributeKeyGranter))
+		Expect(contract.CustomValueDecoders()).To(HaveKey(auth.AttributeKeyGrantee))
+		Expect(contract.CustomValueDecoders()).To(HaveKey(auth.AttributeKeyMsgTypeURL))
 	})
 
 	When("When Calling ConvertHexToBech32", func() {
@@ -366,6 +383,232 @@ var _ = Describe("Address Precompile", func() {
 		})
 	})
 
+	When("Authz", func() {
+		var (
+			evm              *mock.PrecompileEVMMock
+			granter, grantee common.Address
+			sendTypeURL      string
+			pageReq          any
+		)
+
+		BeforeEach(func() {
+			evm = mock.NewPrecompileEVMMock()
+			evm.GetContextFunc = func() *vm.BlockContext {
+				return &vm.BlockContext{Time: 100}
+			}
+			granter = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("granter")))
+			grantee = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("grantee")))
+			sendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+			pageReq = struct {
+				Key        []byte `json:"key"`
+				Offset     uint64 `json:"offset"`
+				Limit      uint64 `json:"limit"`
+				CountTotal bool   `json:"countTotal"`
+				Reverse    bool   `json:"reverse"`
+			}{Limit: 10, CountTotal: true}
+		})
+
+		It("should error if the page request is invalid", func() {
+			_, err := contract.GetGranterGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true, granter, "invalid page request",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidPageRequest))
+		})
+
+		It("should return no grants if none were granted", func() {
+			res, err := contract.GetGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true,
+				granter, grantee, sendTypeURL, pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res[0]).To(BeEmpty())
+		})
+
+		It("should grant, query and revoke a generic authorization", func() {
+			res, err := contract.GrantGenericAuthorization(
+				ctx, evm, granter, big.NewInt(0), false, grantee, sendTypeURL, big.NewInt(110),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{true}))
+			Expect(ctx.EventManager().Events()).To(ContainElement(sdk.NewEvent(
+				auth.EventTypeGrantAuthorization,
+				sdk.NewAttribute(auth.AttributeKeyGranter, cosmlib.AddressToAccAddress(granter).String()),
+				sdk.NewAttribute(auth.AttributeKeyGrantee, cosmlib.AddressToAccAddress(grantee).String()),
+				sdk.NewAttribute(auth.AttributeKeyMsgTypeURL, sendTypeURL),
+			)))
+
+			res, err = contract.GetGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true,
+				granter, grantee, sendTypeURL, pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			grants := utils.MustGetAs[[]generated.IAuthModuleGrant](res[0])
+			Expect(grants).To(HaveLen(1))
+			Expect(grants[0].Granter).To(Equal(granter))
+			Expect(grants[0].Grantee).To(Equal(grantee))
+			Expect(grants[0].MsgTypeUrl).To(Equal(sendTypeURL))
+			Expect(grants[0].AuthorizationTypeUrl).To(Equal(
+				"/cosmos.authz.v1beta1.GenericAuthorization",
+			))
+			Expect(grants[0].Expiration).To(Equal(big.NewInt(110)))
+
+			res, err = contract.Revoke(
+				ctx, evm, granter, big.NewInt(0), false, grantee, sendTypeURL,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{true}))
+
+			res, err = contract.GetGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true,
+				granter, grantee, sendTypeURL, pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res[0]).To(BeEmpty())
+		})
+
+		It("should error when revoking a missing authorization", func() {
+			_, err := contract.Revoke(
+				ctx, evm, granter, big.NewInt(0), false, grantee, sendTypeURL,
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should grant a send authorization with an allow list", func() {
+			_, err := contract.GrantSendAuthorization(
+				ctx, evm, granter, big.NewInt(0), false,
+				grantee,
+				sdkCoinsToEvmCoins(sdk.NewCoins(sdk.NewInt64Coin("test", 100))),
+				[]common.Address{grantee},
+				big.NewInt(0),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.GetGranteeGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true, grantee, pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			grants := utils.MustGetAs[[]generated.IAuthModuleGrant](res[0])
+			Expect(grants).To(HaveLen(1))
+			Expect(grants[0].Granter).To(Equal(granter))
+			Expect(grants[0].AuthorizationTypeUrl).To(Equal(
+				"/cosmos.bank.v1beta1.SendAuthorization",
+			))
+			Expect(grants[0].Expiration).To(Equal(big.NewInt(0)))
+			Expect(res[1]).To(Equal(generated.IAuthModulePageResponse{Total: 1}))
+		})
+
+		It("should grant a stake authorization", func() {
+			_, err := contract.GrantStakeAuthorization(
+				ctx, evm, granter, big.NewInt(0), false,
+				grantee,
+				int32(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE),
+				[]common.Address{common.BytesToAddress([]byte("validator"))},
+				[]common.Address{},
+				struct {
+					Amount *big.Int `json:"amount"`
+					Denom  string   `json:"denom"`
+				}{Amount: big.NewInt(0)},
+				big.NewInt(0),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.GetGranterGrants(
+				ctx, evm, common.Address{}, big.NewInt(0), true, granter, pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			grants := utils.MustGetAs[[]generated.IAuthModuleGrant](res[0])
+			Expect(grants).To(HaveLen(1))
+			Expect(grants[0].MsgTypeUrl).To(Equal(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})))
+		})
+
+		It("should error on an invalid stake authorization type", func() {
+			_, err := contract.GrantStakeAuthorization(
+				ctx, evm, granter, big.NewInt(0), false,
+				grantee,
+				int32(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED),
+				[]common.Address{common.BytesToAddress([]byte("validator"))},
+				[]common.Address{},
+				struct {
+					Amount *big.Int `json:"amount"`
+					Denom  string   `json:"denom"`
+				}{Amount: big.NewInt(0)},
+				big.NewInt(0),
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
+		When("executing messages", func() {
+			var typeURL string
+			var bz []byte
+
+			BeforeEach(func() {
+				msgAny, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(
+					cosmlib.AddressToAccAddress(granter),
+					cosmlib.AddressToAccAddress(grantee),
+					sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+				))
+				Expect(err).ToNot(HaveOccurred())
+				typeURL, bz = msgAny.TypeUrl, msgAny.Value
+			})
+
+			It("should error in a read-only call", func() {
+				_, err := contract.Exec(
+					ctx, evm, grantee, big.NewInt(0), true, []string{typeURL}, [][]byte{bz},
+				)
+				Expect(err).To(MatchError(auth.ErrReadOnly))
+			})
+
+			It("should error with value", func() {
+				_, err := contract.Exec(
+					ctx, evm, grantee, big.NewInt(1), false, []string{typeURL}, [][]byte{bz},
+				)
+				Expect(err).To(MatchError(auth.ErrNonZeroValue))
+			})
+
+			It("should error if the type urls and messages mismatch", func() {
+				_, err := contract.Exec(
+					ctx, evm, grantee, big.NewInt(0), false, []string{typeURL}, [][]byte{},
+				)
+				Expect(err).To(MatchError(auth.ErrMismatchedMsgs))
+			})
+
+			It("should error if the message is not allowed", func() {
+				msgAny, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = contract.Exec(
+					ctx, evm, grantee, big.NewInt(0), false,
+					[]string{msgAny.TypeUrl}, [][]byte{msgAny.Value},
+				)
+				Expect(err).To(MatchError(ContainSubstring(auth.ErrMsgNotAllowed.Error())))
+			})
+
+			It("should error without an authorization", func() {
+				_, err := contract.Exec(
+					ctx, evm, grantee, big.NewInt(0), false, []string{typeURL}, [][]byte{bz},
+				)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should execute the message on behalf of the granter", func() {
+				_, err := contract.GrantGenericAuthorization(
+					ctx, evm, granter, big.NewInt(0), false, grantee, sendTypeURL, big.NewInt(0),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := contract.Exec(
+					ctx, evm, grantee, big.NewInt(0), false, []string{typeURL}, [][]byte{bz},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(utils.MustGetAs[[][]byte](res[0])).To(HaveLen(1))
+				Expect(ctx.EventManager().Events()).To(ContainElement(sdk.NewEvent(
+					auth.EventTypeExecAuthorization,
+					sdk.NewAttribute(auth.AttributeKeyGrantee, cosmlib.AddressToAccAddress(grantee).String()),
+					sdk.NewAttribute(auth.AttributeKeyMsgTypeURL, sendTypeURL),
+				)))
+			})
+		})
+	})
+
 })
 
 // TODO: move to utils since also used by bank.
@@ -396,6 +639,9 @@ func MsgRouterMockWithSend() *mock.MessageRouterMock {
 			return &sdk.Result{}, nil
 		}
 	}
+	router.HandlerFunc = func(msg sdk.Msg) func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		return router.HandlerByTypeURLFunc(sdk.MsgTypeURL(msg))
+	}
 
 	return router
 }
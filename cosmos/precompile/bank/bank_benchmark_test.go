@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank_test
+
+import (
+	"math/big"
+	"testing"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/bank"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+const benchDenom = "abgt"
+
+// setupBenchmark returns a bank precompile and two accounts, the first of which is funded.
+func setupBenchmark(b *testing.B) (sdk.Context, *bank.Contract, common.Address, common.Address) {
+	ctx, _, bk, _ := testutil.SetupMinimalKeepers()
+	contract := utils.MustGetAs[*bank.Contract](
+		bank.NewPrecompileContract(bankkeeper.NewMsgServerImpl(bk), bk),
+	)
+
+	accs := simtestutil.CreateRandomAccounts(2)
+	coins := sdk.NewCoins(sdk.NewCoin(benchDenom, sdk.NewInt(1e18)))
+	if err := FundAccount(ctx, bk, accs[0], coins); err != nil {
+		b.Fatal(err)
+	}
+	bk.SetSendEnabled(ctx, benchDenom, true)
+
+	return ctx, contract, cosmlib.AccAddressToEthAddress(accs[0]),
+		cosmlib.AccAddressToEthAddress(accs[1])
+}
+
+func BenchmarkGetBalance(b *testing.B) {
+	ctx, contract, from, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "getBalance", from, benchDenom)
+}
+
+func BenchmarkGetAllBalances(b *testing.B) {
+	ctx, contract, from, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "getAllBalances", from)
+}
+
+func BenchmarkGetSpendableBalance(b *testing.B) {
+	ctx, contract, from, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "getSpendableBalance", from, benchDenom)
+}
+
+func BenchmarkGetSupply(b *testing.B) {
+	ctx, contract, from, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "getSupply", benchDenom)
+}
+
+func BenchmarkGetSendEnabled(b *testing.B) {
+	ctx, contract, from, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "getSendEnabled", benchDenom)
+}
+
+func BenchmarkSend(b *testing.B) {
+	ctx, contract, from, to := setupBenchmark(b)
+	coins := sdk.NewCoins(sdk.NewCoin(benchDenom, sdk.NewIntFromBigInt(big.NewInt(1))))
+	test.BenchmarkMethod(b, ctx, nil, contract, from, "send", from, to, sdkCoinsToEvmCoins(coins))
+}
@@ -0,0 +1,515 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package bankerc20 implements ERC20 token precompiles directly over x/bank balances. Each
+// precompile exposes a single SDK coin denomination as an ERC20 token, so that the coin has a
+// single representation shared by Cosmos and EVM users: balances are x/bank balances and
+// allowances are x/authz send authorizations.
+//
+// The token precompiles are optional; they are active for the denominations listed in the
+// `bank_erc20_denoms` of the x/evm params, which governance updates with `MsgUpdateParams`, and
+// can be moved or deactivated like any other precompile through the x/evm precompile params. To
+// pair a denomination with its token precompile in x/erc20, the pair (with origin
+// `TOKEN_ORIGIN_COIN` and address `AddressForDenom(denom)`) is added to the x/erc20 genesis.
+package bankerc20
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/bankerc20"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	erc20types "pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	"pkg.furychain.dev/gridiron/lib/errors"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+const (
+	// addressPrefix is the prefix of the names used to derive the addresses of the token
+	// precompiles.
+	addressPrefix = "bankerc20/"
+
+	transferEvent = "Transfer"
+	approvalEvent = "Approval"
+)
+
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in bankerc20_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 2600
+	// txGas is charged by the state-changing methods.
+	txGas = 9800
+)
+
+// sendMsgTypeURL is the message type URL of the send authorizations used as allowances.
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+// AddressForDenom returns the address of the token precompile of the given SDK coin denomination.
+func AddressForDenom(denom string) common.Address {
+	return cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(addressPrefix + denom))
+}
+
+// Contract is the precompile contract of an ERC20 token over the x/bank balances of a single SDK
+// coin denomination.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	denom     string
+	bk        BankKeeper
+	msgServer banktypes.MsgServer
+	ak        AuthzKeeper
+
+	erc20ABI abi.ABI
+}
+
+// NewPrecompileContract returns a new instance of the ERC20 token precompile contract of the given
+// SDK coin denomination, at `AddressForDenom(denom)`.
+func NewPrecompileContract(
+	denom string, bk BankKeeper, ms banktypes.MsgServer, ak AuthzKeeper,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.BankERC20MetaData.ABI,
+			AddressForDenom(denom),
+		),
+		denom:     denom,
+		bk:        bk,
+		msgServer: ms,
+		ak:        ak,
+		erc20ABI:  abi.MustUnmarshalJSON(generated.BankERC20MetaData.ABI),
+	}
+}
+
+// ABIEvents implements StatefulImpl. The ERC20 events are not registered as Cosmos events, as
+// every token precompile shares the same event types; the contract adds its logs directly.
+func (c *Contract) ABIEvents() map[string]abi.Event {
+	return nil
+}
+
+// PrecompileMethods implements StatefulImpl.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:      "denom()",
+			Execute:     c.Denom,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "name()",
+			Execute:     c.Name,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "symbol()",
+			Execute:     c.Symbol,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "decimals()",
+			Execute:     c.Decimals,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "totalSupply()",
+			Execute:     c.TotalSupply,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "balanceOf(address)",
+			Execute:     c.BalanceOf,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "allowance(address,address)",
+			Execute:     c.Allowance,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "approve(address,uint256)",
+			Execute:     c.Approve,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "transfer(address,uint256)",
+			Execute:     c.Transfer,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "transferFrom(address,address,uint256)",
+			Execute:     c.TransferFrom,
+			RequiredGas: txGas,
+		},
+	}
+}
+
+// Denom implements the `denom()` method.
+func (c *Contract) Denom(
+	_ context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	return []any{c.denom}, nil
+}
+
+// Name implements the `name()` method.
+func (c *Contract) Name(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	name, _, _ := c.metadata(sdk.UnwrapSDKContext(ctx))
+	return []any{name}, nil
+}
+
+// Symbol implements the `symbol()` method.
+func (c *Contract) Symbol(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	_, symbol, _ := c.metadata(sdk.UnwrapSDKContext(ctx))
+	return []any{symbol}, nil
+}
+
+// Decimals implements the `decimals()` method.
+func (c *Contract) Decimals(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	_, _, decimals := c.metadata(sdk.UnwrapSDKContext(ctx))
+	return []any{decimals}, nil
+}
+
+// TotalSupply implements the `totalSupply()` method.
+func (c *Contract) TotalSupply(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	return []any{c.bk.GetSupply(sdk.UnwrapSDKContext(ctx), c.denom).Amount.BigInt()}, nil
+}
+
+// BalanceOf implements the `balanceOf(address)` method. It returns the spendable balance.
+func (c *Contract) BalanceOf(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	account, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	balance := c.bk.SpendableCoin(
+		sdk.UnwrapSDKContext(ctx), cosmlib.AddressToAccAddress(account), c.denom,
+	)
+	return []any{balance.Amount.BigInt()}, nil
+}
+
+// Allowance implements the `allowance(address,address)` method.
+func (c *Contract) Allowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	owner, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	spender, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	sendAuth, _ := c.sendAuthorization(sdk.UnwrapSDKContext(ctx), owner, spender)
+	if sendAuth == nil {
+		return []any{big.NewInt(0)}, nil
+	}
+	return []any{sendAuth.SpendLimit.AmountOf(c.denom).BigInt()}, nil
+}
+
+// Approve implements the `approve(address,uint256)` method. It sets the spend limit of the
+// denomination in the send authorization granted by the caller to the spender, keeping the spend
+// limits of other denominations. Approving zero removes the denomination from the authorization.
+func (c *Contract) Approve(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	if err := validateWrite(value, readonly); err != nil {
+		return nil, err
+	}
+	spender, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	amount, ok := utils.GetAs[*big.Int](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	var (
+		sdkCtx     = sdk.UnwrapSDKContext(ctx)
+		limit      = sdk.NewCoins()
+		allowList  []string
+		expiration *time.Time
+	)
+	sendAuth, exp := c.sendAuthorization(sdkCtx, caller, spender)
+	if sendAuth != nil {
+		allowList, expiration = sendAuth.AllowList, exp
+		for _, coin := range sendAuth.SpendLimit {
+			if coin.Denom != c.denom {
+				limit = limit.Add(coin)
+			}
+		}
+	}
+	if amount.Sign() > 0 {
+		limit = limit.Add(sdk.NewCoin(c.denom, sdkmath.NewIntFromBigInt(amount)))
+	}
+
+	granter, grantee := cosmlib.AddressToAccAddress(caller), cosmlib.AddressToAccAddress(spender)
+	if limit.Empty() {
+		if sendAuth != nil {
+			if err := c.ak.DeleteGrant(sdkCtx, grantee, granter, sendMsgTypeURL); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := c.ak.SaveGrant(sdkCtx, grantee, granter, &banktypes.SendAuthorization{
+		SpendLimit: limit,
+		AllowList:  allowList,
+	}, expiration); err != nil {
+		return nil, err
+	}
+
+	if err := c.addLog(evm, approvalEvent, caller, spender, amount); err != nil {
+		return nil, err
+	}
+	return []any{true}, nil
+}
+
+// Transfer implements the `transfer(address,uint256)` method.
+func (c *Contract) Transfer(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	if err := validateWrite(value, readonly); err != nil {
+		return nil, err
+	}
+	to, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	amount, ok := utils.GetAs[*big.Int](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	if err := c.send(ctx, evm, caller, to, amount); err != nil {
+		return nil, err
+	}
+	return []any{true}, nil
+}
+
+// TransferFrom implements the `transferFrom(address,address,uint256)` method. Unless the caller
+// is the owner, the amount is spent from the send authorization granted by the owner to the
+// caller.
+func (c *Contract) TransferFrom(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	if err := validateWrite(value, readonly); err != nil {
+		return nil, err
+	}
+	from, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	to, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	amount, ok := utils.GetAs[*big.Int](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	if caller != from && amount.Sign() > 0 {
+		if err := c.spendAllowance(sdk.UnwrapSDKContext(ctx), from, caller, to, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.send(ctx, evm, from, to, amount); err != nil {
+		return nil, err
+	}
+	return []any{true}, nil
+}
+
+// send sends amount of the denomination from one account to another through the bank message
+// server, and adds the ERC20 `Transfer` log.
+func (c *Contract) send(
+	ctx context.Context, evm ethprecompile.EVM, from, to common.Address, amount *big.Int,
+) error {
+	if amount.Sign() > 0 {
+		if _, err := c.msgServer.Send(ctx, &banktypes.MsgSend{
+			FromAddress: cosmlib.AddressToAccAddress(from).String(),
+			ToAddress:   cosmlib.AddressToAccAddress(to).String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(c.denom, sdkmath.NewIntFromBigInt(amount))),
+		}); err != nil {
+			return err
+		}
+	}
+	return c.addLog(evm, transferEvent, from, to, amount)
+}
+
+// spendAllowance spends amount of the denomination from the send authorization granted by the
+// owner to the spender, as authz would when executing the send on behalf of the owner.
+func (c *Contract) spendAllowance(
+	ctx sdk.Context, owner, spender, to common.Address, amount *big.Int,
+) error {
+	sendAuth, expiration := c.sendAuthorization(ctx, owner, spender)
+	if sendAuth == nil {
+		return ErrInsufficientAllowance
+	}
+
+	resp, err := sendAuth.Accept(ctx, &banktypes.MsgSend{
+		FromAddress: cosmlib.AddressToAccAddress(owner).String(),
+		ToAddress:   cosmlib.AddressToAccAddress(to).String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(c.denom, sdkmath.NewIntFromBigInt(amount))),
+	})
+	if err != nil {
+		return errors.Wrap(ErrInsufficientAllowance, err.Error())
+	}
+	if !resp.Accept {
+		return ErrInsufficientAllowance
+	}
+
+	granter, grantee := cosmlib.AddressToAccAddress(owner), cosmlib.AddressToAccAddress(spender)
+	if resp.Delete {
+		return c.ak.DeleteGrant(ctx, grantee, granter, sendMsgTypeURL)
+	}
+	if resp.Updated != nil {
+		return c.ak.SaveGrant(ctx, grantee, granter, resp.Updated, expiration)
+	}
+	return nil
+}
+
+// sendAuthorization returns the send authorization granted by the owner to the spender and its
+// expiration, or nil if there is none.
+func (c *Contract) sendAuthorization(
+	ctx sdk.Context, owner, spender common.Address,
+) (*banktypes.SendAuthorization, *time.Time) {
+	authorization, expiration := c.ak.GetAuthorization(
+		ctx, cosmlib.AddressToAccAddress(spender), cosmlib.AddressToAccAddress(owner), sendMsgTypeURL,
+	)
+	sendAuth, ok := utils.GetAs[*banktypes.SendAuthorization](authorization)
+	if !ok {
+		return nil, nil
+	}
+	return sendAuth, expiration
+}
+
+// metadata returns the ERC20 name, symbol and decimals of the denomination, derived from its bank
+// denom metadata. Otherwise the denom is used as name and symbol.
+func (c *Contract) metadata(ctx sdk.Context) (string, string, uint8) {
+	if md, found := c.bk.GetDenomMetaData(ctx, c.denom); found {
+		return erc20types.ERC20MetadataFromDenomMetadata(md)
+	}
+	return c.denom, c.denom, 0
+}
+
+// addLog adds an ERC20 `Transfer` or `Approval` log of the token precompile.
+func (c *Contract) addLog(
+	evm ethprecompile.EVM, eventName string, from, to common.Address, amount *big.Int,
+) error {
+	event := c.erc20ABI.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(amount)
+	if err != nil {
+		return err
+	}
+
+	evm.GetStateDB().AddLog(&coretypes.Log{
+		Address: c.RegistryKey(),
+		Topics: []common.Hash{
+			event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()),
+		},
+		Data: data,
+	})
+	return nil
+}
+
+// validateWrite returns an error if a method that modifies balances or allowances is called in
+// a read-only call or with value.
+func validateWrite(value *big.Int, readonly bool) error {
+	if readonly {
+		return ErrReadOnly
+	}
+	if value != nil && value.Sign() != 0 {
+		return ErrNonZeroValue
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bankerc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth/mock"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/bankerc20"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+var (
+	benchOwner   = common.BytesToAddress([]byte("owner"))
+	benchSpender = common.BytesToAddress([]byte("spender"))
+)
+
+// setupBenchmark returns a token precompile of `denom` and its evm. The owner is funded and has
+// approved the spender.
+func setupBenchmark(b *testing.B) (sdk.Context, *bankerc20.Contract, *mockEVM) {
+	ctx, ak, bk, _ := testutil.SetupMinimalKeepers()
+	azk := authzkeeper.NewKeeper(
+		testutil.EvmKey, testutil.GetEncodingConfig().Codec, mock.NewMsgRouterMock(), ak,
+	)
+	contract := bankerc20.NewPrecompileContract(denom, bk, bankkeeper.NewMsgServerImpl(bk), azk)
+	evm := newMockEVM()
+
+	bk.SetSendEnabled(ctx, denom, true)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1e18))
+	if err := bk.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+		b.Fatal(err)
+	}
+	if err := bk.SendCoinsFromModuleToAccount(
+		ctx, evmtypes.ModuleName, cosmlib.AddressToAccAddress(benchOwner), coins,
+	); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := contract.Approve(
+		ctx, evm, benchOwner, big.NewInt(0), false, benchSpender, big.NewInt(1e18),
+	); err != nil {
+		b.Fatal(err)
+	}
+	return ctx, contract, evm
+}
+
+func BenchmarkName(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, evm, contract, benchOwner, "name")
+}
+
+func BenchmarkTotalSupply(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, evm, contract, benchOwner, "totalSupply")
+}
+
+func BenchmarkBalanceOf(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, evm, contract, benchOwner, "balanceOf", benchOwner)
+}
+
+func BenchmarkAllowance(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, evm, contract, benchOwner, "allowance", benchOwner, benchSpender)
+}
+
+func BenchmarkApprove(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, benchOwner, "approve", benchSpender, big.NewInt(1),
+	)
+}
+
+func BenchmarkTransfer(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, benchOwner, "transfer", benchSpender, big.NewInt(1),
+	)
+}
+
+func BenchmarkTransferFrom(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, benchSpender, "transferFrom", benchOwner, benchSpender, big.NewInt(1),
+	)
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bankerc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/bankerc20"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth/mock"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/bankerc20"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+	vmmock "pkg.furychain.dev/gridiron/eth/core/vm/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBankERC20Precompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/bankerc20")
+}
+
+const denom = "abera"
+
+var _ = Describe("Bank ERC20 Precompile", func() {
+	var (
+		ctx      sdk.Context
+		contract *bankerc20.Contract
+		evm      *mockEVM
+		bk       bankkeeper.BaseKeeper
+		owner    = common.BytesToAddress([]byte("owner"))
+		spender  = common.BytesToAddress([]byte("spender"))
+		to       = common.BytesToAddress([]byte("to"))
+	)
+
+	BeforeEach(func() {
+		sdkCtx, authKeeper, bankKeeper, _ := testutil.SetupMinimalKeepers()
+		ctx, bk = sdkCtx, bankKeeper
+		azk := authzkeeper.NewKeeper(
+			testutil.EvmKey,
+			testutil.GetEncodingConfig().Codec,
+			mock.NewMsgRouterMock(),
+			authKeeper,
+		)
+		contract = bankerc20.NewPrecompileContract(denom, bk, bankkeeper.NewMsgServerImpl(bk), azk)
+		evm = newMockEVM()
+
+		bk.SetSendEnabled(ctx, denom, true)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(
+			ctx, evmtypes.ModuleName, cosmlib.AddressToAccAddress(owner), coins,
+		)).To(Succeed())
+	})
+
+	It("should be at the address of its denom and match the ABI", func() {
+		Expect(contract.RegistryKey()).To(Equal(bankerc20.AddressForDenom(denom)))
+		Expect(contract.RegistryKey()).ToNot(Equal(bankerc20.AddressForDenom("stake")))
+
+		cAbi := abi.MustUnmarshalJSON(generated.BankERC20MetaData.ABI)
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(cAbi.Methods)))
+		Expect(contract.ABIEvents()).To(BeEmpty())
+	})
+
+	It("should read the metadata, supply and balances from x/bank", func() {
+		res, err := contract.Symbol(ctx, nil, owner, big.NewInt(0), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{denom}))
+
+		res, err = contract.TotalSupply(ctx, nil, owner, big.NewInt(0), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{big.NewInt(100)}))
+
+		res, err = contract.BalanceOf(ctx, nil, owner, big.NewInt(0), true, owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{big.NewInt(100)}))
+	})
+
+	It("should transfer x/bank balances and add a Transfer log", func() {
+		_, err := contract.Transfer(ctx, evm, owner, big.NewInt(0), false, to, big.NewInt(40))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(bk.GetBalance(ctx, cosmlib.AddressToAccAddress(owner), denom).Amount.Int64()).
+			To(Equal(int64(60)))
+		Expect(bk.GetBalance(ctx, cosmlib.AddressToAccAddress(to), denom).Amount.Int64()).
+			To(Equal(int64(40)))
+
+		Expect(evm.logs).To(HaveLen(1))
+		Expect(evm.logs[0].Address).To(Equal(contract.RegistryKey()))
+		Expect(evm.logs[0].Topics[1]).To(Equal(common.BytesToHash(owner.Bytes())))
+		Expect(evm.logs[0].Topics[2]).To(Equal(common.BytesToHash(to.Bytes())))
+	})
+
+	It("should reject writes in read-only calls", func() {
+		_, err := contract.Transfer(ctx, evm, owner, big.NewInt(0), true, to, big.NewInt(40))
+		Expect(err).To(MatchError(bankerc20.ErrReadOnly))
+	})
+
+	It("should spend approved allowances in transferFrom", func() {
+		_, err := contract.TransferFrom(
+			ctx, evm, spender, big.NewInt(0), false, owner, to, big.NewInt(10),
+		)
+		Expect(err).To(MatchError(bankerc20.ErrInsufficientAllowance))
+
+		_, err = contract.Approve(ctx, evm, owner, big.NewInt(0), false, spender, big.NewInt(30))
+		Expect(err).ToNot(HaveOccurred())
+		res, err := contract.Allowance(ctx, nil, owner, big.NewInt(0), true, owner, spender)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{big.NewInt(30)}))
+
+		_, err = contract.TransferFrom(
+			ctx, evm, spender, big.NewInt(0), false, owner, to, big.NewInt(20),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bk.GetBalance(ctx, cosmlib.AddressToAccAddress(to), denom).Amount.Int64()).
+			To(Equal(int64(20)))
+
+		res, err = contract.Allowance(ctx, nil, owner, big.NewInt(0), true, owner, spender)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{big.NewInt(10)}))
+
+		_, err = contract.TransferFrom(
+			ctx, evm, spender, big.NewInt(0), false, owner, to, big.NewInt(20),
+		)
+		Expect(err).To(MatchError(bankerc20.ErrInsufficientAllowance))
+
+		// approving zero removes the allowance
+		_, err = contract.Approve(ctx, evm, owner, big.NewInt(0), false, spender, big.NewInt(0))
+		Expect(err).ToNot(HaveOccurred())
+		res, err = contract.Allowance(ctx, nil, owner, big.NewInt(0), true, owner, spender)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{big.NewInt(0)}))
+	})
+})
+
+// mockEVM is a precompile EVM that records the logs added by the precompile.
+type mockEVM struct {
+	ethprecompile.EVM
+	sdb  *vmmock.GridironStateDBMock
+	logs []*coretypes.Log
+}
+
+func newMockEVM() *mockEVM {
+	me := &mockEVM{sdb: vmmock.NewEmptyStateDB()}
+	me.sdb.AddLogFunc = func(log *coretypes.Log) {
+		me.logs = append(me.logs, log)
+	}
+	return me
+}
+
+func (me *mockEVM) GetStateDB() vm.GethStateDB {
+	return me.sdb
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bankerc20
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// BankKeeper defines the expected bank keeper, which holds the token balances.
+	BankKeeper interface {
+		SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
+		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	}
+
+	// AuthzKeeper defines the expected authz keeper, which holds the token allowances as send
+	// authorizations.
+	AuthzKeeper interface {
+		GetAuthorization(
+			ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string,
+		) (authz.Authorization, *time.Time)
+		SaveGrant(
+			ctx sdk.Context, grantee, granter sdk.AccAddress,
+			authorization authz.Authorization, expiration *time.Time,
+		) error
+		DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error
+	}
+)
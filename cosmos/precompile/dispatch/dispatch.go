@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatch
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/dispatch"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/errors"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// ModuleName is the name used to derive the address of the dispatch precompile.
+const ModuleName = "dispatch"
+
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in dispatch_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+//
+// The state-changing methods are measured on a bank send; the gas of the store accesses of the
+// dispatched message is metered on top.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 1200
+	// txGas is charged by the state-changing methods.
+	txGas = 6900
+)
+
+// Contract is the precompile contract for dispatching arbitrary Cosmos SDK messages.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	router    MessageRouter
+	registry  codectypes.InterfaceRegistry
+	amino     *codec.LegacyAmino
+	allowlist AllowlistKeeper
+}
+
+// NewPrecompileContract returns a new instance of the dispatch precompile contract.
+func NewPrecompileContract(
+	router MessageRouter,
+	registry codectypes.InterfaceRegistry,
+	amino *codec.LegacyAmino,
+	allowlist AllowlistKeeper,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.DispatchModuleMetaData.ABI,
+			// Precompile Address: 0xDB8D1B6D64E4eC90CEB335fC4344e75799fdC20C
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+		router:    router,
+		registry:  registry,
+		amino:     amino,
+		allowlist: allowlist,
+	}
+}
+
+// PrecompileMethods implements the `ethprecompile.StatefulImpl` interface.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:      "dispatch(string,bytes)",
+			Execute:     c.Dispatch,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "dispatchAmino(string)",
+			Execute:     c.DispatchAmino,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "isAllowed(string)",
+			Execute:     c.IsAllowed,
+			RequiredGas: queryGas,
+		},
+	}
+}
+
+// Dispatch is the precompile contract method for the `dispatch(string,bytes)` method.
+func (c *Contract) Dispatch(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	typeURL, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	bz, ok := utils.GetAs[[]byte](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBytes
+	}
+
+	var msg sdk.Msg
+	if err := c.registry.UnpackAny(&codectypes.Any{TypeUrl: typeURL, Value: bz}, &msg); err != nil {
+		return nil, err
+	}
+
+	return c.dispatchHelper(ctx, caller, value, readonly, msg)
+}
+
+// DispatchAmino is the precompile contract method for the `dispatchAmino(string)` method.
+func (c *Contract) DispatchAmino(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	json, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	var msg sdk.Msg
+	if err := c.amino.UnmarshalJSON([]byte(json), &msg); err != nil {
+		return nil, err
+	}
+
+	return c.dispatchHelper(ctx, caller, value, readonly, msg)
+}
+
+// IsAllowed is the precompile contract method for the `isAllowed(string)` method.
+func (c *Contract) IsAllowed(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	typeURL, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	return []any{c.allowlist.IsDispatchAllowed(ctx, typeURL)}, nil
+}
+
+// dispatchHelper checks that the caller is allowed to execute the given message and routes it to
+// its message service handler. The protobuf encoded message response is returned.
+func (c *Contract) dispatchHelper(
+	ctx context.Context,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	msg sdk.Msg,
+) ([]any, error) {
+	if readonly {
+		return nil, ErrReadOnly
+	}
+	if value != nil && value.Sign() != 0 {
+		return nil, ErrNonZeroValue
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if !c.allowlist.IsDispatchAllowed(ctx, typeURL) {
+		return nil, errors.Wrap(ErrMsgNotAllowed, typeURL)
+	}
+
+	// The caller of the precompile must be the one and only signer of the message, i.e. a contract
+	// can never act on behalf of another account.
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil, ErrInvalidSigners
+	}
+	for _, signer := range signers {
+		if !signer.Equals(cosmlib.AddressToAccAddress(caller)) {
+			return nil, ErrInvalidSigners
+		}
+	}
+
+	if m, ok := msg.(interface{ ValidateBasic() error }); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	handler := c.router.Handler(msg)
+	if handler == nil {
+		return nil, errors.Wrap(ErrNoHandler, typeURL)
+	}
+
+	// The dispatched message reads and writes the Cosmos SDK stores directly, so its store
+	// accesses are charged to the precompile gas meter with the precompile KV gas configs.
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := handler(sCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The handler emits its events on a fresh event manager, so they are merged back into the
+	// parent context along with a message event, as the baseapp does. Most of them have no
+	// registered Eth log, so they are emitted as Cosmos events only.
+	events := createEvents(res.GetEvents(), msg)
+	if em, ok := sCtx.EventManager().(logslessEventEmitter); ok {
+		em.EmitEventsWithoutLogs(events)
+	} else {
+		sCtx.EventManager().EmitEvents(events)
+	}
+
+	if len(res.MsgResponses) > 0 {
+		return []any{res.MsgResponses[0].Value}, nil
+	}
+	return []any{res.Data}, nil
+}
+
+// createEvents prepends the message event of the given dispatched message to its events, in the
+// same way as the baseapp does for the messages of a transaction.
+func createEvents(events sdk.Events, msg sdk.Msg) sdk.Events {
+	typeURL := sdk.MsgTypeURL(msg)
+	msgEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyAction, typeURL),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+	)
+	if _, found := events.GetAttributes(sdk.AttributeKeyModule); !found {
+		if moduleName := sdk.GetModuleNameFromTypeURL(typeURL); moduleName != "" {
+			msgEvent = msgEvent.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyModule, moduleName))
+		}
+	}
+	return sdk.Events{msgEvent}.AppendEvents(events)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatch_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/dispatch"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+)
+
+// setupBenchmark returns a dispatch precompile, which allows bank sends, and a bank send from the
+// funded Alice to Bob.
+func setupBenchmark(b *testing.B) (sdk.Context, *dispatch.Contract, *banktypes.MsgSend) {
+	ctx, _, bk, _ := testutil.SetupMinimalKeepers()
+	if err := bk.SetParams(ctx, banktypes.DefaultParams()); err != nil {
+		b.Fatal(err)
+	}
+	encCfg := cosmostestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(bk))
+	contract := dispatch.NewPrecompileContract(
+		msr,
+		encCfg.InterfaceRegistry,
+		encCfg.Amino,
+		mockAllowlist{sdk.MsgTypeURL(&banktypes.MsgSend{}): true},
+	)
+
+	if err := cosmlib.MintCoinsToAddress(
+		ctx, bk, evmtypes.ModuleName, testutil.Alice, "afury", big.NewInt(1000),
+	); err != nil {
+		b.Fatal(err)
+	}
+	return ctx, contract, banktypes.NewMsgSend(
+		cosmlib.AddressToAccAddress(testutil.Alice),
+		cosmlib.AddressToAccAddress(testutil.Bob),
+		sdk.NewCoins(sdk.NewInt64Coin("afury", 100)),
+	)
+}
+
+func BenchmarkIsAllowed(b *testing.B) {
+	ctx, contract, _ := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "isAllowed", sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	)
+}
+
+func BenchmarkDispatch(b *testing.B) {
+	ctx, contract, msg := setupBenchmark(b)
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		b.Fatal(err)
+	}
+	test.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "dispatch", msgAny.TypeUrl, msgAny.Value,
+	)
+}
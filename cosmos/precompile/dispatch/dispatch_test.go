@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatch_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/dispatch"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDispatchPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/dispatch")
+}
+
+// mockAllowlist is an `AllowlistKeeper` backed by a static set of type URLs.
+type mockAllowlist map[string]bool
+
+func (m mockAllowlist) IsDispatchAllowed(_ context.Context, typeURL string) bool {
+	return m[typeURL]
+}
+
+var _ = Describe("Dispatch Precompile", func() {
+	var (
+		contract *dispatch.Contract
+		encCfg   cosmostestutil.TestEncodingConfig
+		bk       bankkeeper.BaseKeeper
+		ctx      sdk.Context
+		caller   = testutil.Alice
+		sendMsg  *banktypes.MsgSend
+	)
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = testutil.SetupMinimalKeepers()
+		Expect(bk.SetParams(ctx, banktypes.DefaultParams())).To(Succeed())
+		encCfg = cosmostestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+
+		msr := baseapp.NewMsgServiceRouter()
+		msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(bk))
+
+		contract = dispatch.NewPrecompileContract(
+			msr,
+			encCfg.InterfaceRegistry,
+			encCfg.Amino,
+			mockAllowlist{sdk.MsgTypeURL(&banktypes.MsgSend{}): true},
+		)
+
+		Expect(cosmlib.MintCoinsToAddress(
+			ctx, bk, evmtypes.ModuleName, caller, "afury", big.NewInt(1000),
+		)).To(Succeed())
+		sendMsg = banktypes.NewMsgSend(
+			cosmlib.AddressToAccAddress(caller),
+			cosmlib.AddressToAccAddress(testutil.Bob),
+			sdk.NewCoins(sdk.NewInt64Coin("afury", 100)),
+		)
+	})
+
+	It("should report the allowed message types", func() {
+		res, err := contract.IsAllowed(
+			ctx, nil, caller, big.NewInt(0), true, sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{true}))
+
+		res, err = contract.IsAllowed(
+			ctx, nil, caller, big.NewInt(0), true, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{false}))
+	})
+
+	When("dispatching a protobuf encoded message", func() {
+		var typeURL string
+		var bz []byte
+
+		BeforeEach(func() {
+			msgAny, err := codectypes.NewAnyWithValue(sendMsg)
+			Expect(err).ToNot(HaveOccurred())
+			typeURL, bz = msgAny.TypeUrl, msgAny.Value
+		})
+
+		It("should execute the message as the caller", func() {
+			_, err := contract.Dispatch(ctx, nil, caller, big.NewInt(0), false, typeURL, bz)
+			Expect(err).ToNot(HaveOccurred())
+
+			balance := bk.GetBalance(ctx, cosmlib.AddressToAccAddress(testutil.Bob), "afury")
+			Expect(balance.Amount.Int64()).To(Equal(int64(100)))
+		})
+
+		It("should merge the events of the message into the context", func() {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err := contract.Dispatch(ctx, nil, caller, big.NewInt(0), false, typeURL, bz)
+			Expect(err).ToNot(HaveOccurred())
+
+			events := ctx.EventManager().Events()
+			Expect(events).ToNot(BeEmpty())
+			Expect(events[0]).To(Equal(sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyAction, typeURL),
+				sdk.NewAttribute(sdk.AttributeKeySender, sendMsg.FromAddress),
+				sdk.NewAttribute(sdk.AttributeKeyModule, banktypes.ModuleName),
+			)))
+			transfers, found := events.GetAttributes(banktypes.AttributeKeyRecipient)
+			Expect(found).To(BeTrue())
+			Expect(transfers).To(ContainElement(
+				sdk.NewAttribute(banktypes.AttributeKeyRecipient, sendMsg.ToAddress),
+			))
+		})
+
+		It("should fail if the caller is not the signer", func() {
+			_, err := contract.Dispatch(ctx, nil, testutil.Bob, big.NewInt(0), false, typeURL, bz)
+			Expect(err).To(MatchError(dispatch.ErrInvalidSigners))
+		})
+
+		It("should fail in a read-only call", func() {
+			_, err := contract.Dispatch(ctx, nil, caller, big.NewInt(0), true, typeURL, bz)
+			Expect(err).To(MatchError(dispatch.ErrReadOnly))
+		})
+
+		It("should fail if value is sent", func() {
+			_, err := contract.Dispatch(ctx, nil, caller, big.NewInt(1), false, typeURL, bz)
+			Expect(err).To(MatchError(dispatch.ErrNonZeroValue))
+		})
+
+		It("should fail if the message type is not allowed", func() {
+			msgAny, err := codectypes.NewAnyWithValue(banktypes.NewMsgMultiSend(
+				banktypes.NewInput(cosmlib.AddressToAccAddress(caller), sendMsg.Amount),
+				[]banktypes.Output{
+					banktypes.NewOutput(cosmlib.AddressToAccAddress(testutil.Bob), sendMsg.Amount),
+				},
+			))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = contract.Dispatch(
+				ctx, nil, caller, big.NewInt(0), false, msgAny.TypeUrl, msgAny.Value,
+			)
+			Expect(err).To(MatchError(ContainSubstring(dispatch.ErrMsgNotAllowed.Error())))
+		})
+	})
+
+	It("should execute an amino JSON encoded message", func() {
+		bz, err := encCfg.Amino.MarshalJSON(sendMsg)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = contract.DispatchAmino(ctx, nil, caller, big.NewInt(0), false, string(bz))
+		Expect(err).ToNot(HaveOccurred())
+
+		balance := bk.GetBalance(ctx, cosmlib.AddressToAccAddress(testutil.Bob), "afury")
+		Expect(balance.Amount.Int64()).To(Equal(int64(100)))
+	})
+})
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatch
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// MessageRouter routes Cosmos SDK messages to their message service handlers.
+	MessageRouter interface {
+		// Handler returns the handler of the given message, or nil if none is registered.
+		Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+	}
+
+	// AllowlistKeeper decides which messages may be dispatched from the EVM.
+	AllowlistKeeper interface {
+		// IsDispatchAllowed returns true if messages with the given type URL may be dispatched.
+		IsDispatchAllowed(ctx context.Context, typeURL string) bool
+	}
+
+	// logslessEventEmitter is implemented by the precompile event manager, which can emit Cosmos
+	// events without building Eth logs from them.
+	logslessEventEmitter interface {
+		EmitEventsWithoutLogs(events sdk.Events)
+	}
+)
@@ -28,13 +28,23 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/distribution"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/precompile"
-	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
 	"pkg.furychain.dev/gridiron/eth/common"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in
+// distribution_benchmark_test.go, at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 1700
+	// txGas is charged by the state-changing methods.
+	txGas = 4800
+)
+
 // Contract is the precompile contract for the distribution module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -57,35 +67,73 @@ func NewPrecompileContract(
 	}
 }
 
-// CustomValueDecoders overrides the `coreprecompile.StatefulImpl` interface.
-func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
-	return ethprecompile.ValueDecoders{
-		distributiontypes.AttributeKeyWithdrawAddress: log.ConvertAccAddressFromBech32,
-	}
-}
-
 // PrecompileMethods implements the `coreprecompile.StatefulImpl` interface.
 func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 	return ethprecompile.Methods{
 		{
-			AbiSig:  "setWithdrawAddress(address)",
-			Execute: c.SetWithdrawAddress,
+			AbiSig:      "setWithdrawAddress(address)",
+			Execute:     c.SetWithdrawAddress,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "setWithdrawAddress(string)",
+			Execute:     c.SetWithdrawAddressBech32,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "withdrawDelegatorReward(address,address)",
+			Execute:     c.WithdrawDelegatorReward,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "withdrawDelegatorReward(string,string)",
+			Execute:     c.SetWithdrawAddressBech32,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getWithdrawEnabled()",
+			Execute:     c.GetWithdrawAddrEnabled,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "withdrawAllDelegatorRewards()",
+			Execute:     c.WithdrawAllDelegatorRewards,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "setWithdrawAddress(string)",
-			Execute: c.SetWithdrawAddressBech32,
+			AbiSig:      "withdrawValidatorCommission()",
+			Execute:     c.WithdrawValidatorCommission,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "withdrawDelegatorReward(address,address)",
-			Execute: c.WithdrawDelegatorReward,
+			AbiSig:      "fundCommunityPool((uint256,string)[])",
+			Execute:     c.FundCommunityPool,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "withdrawDelegatorReward(string,string)",
-			Execute: c.SetWithdrawAddressBech32,
+			AbiSig:      "getDelegationRewards(address,address)",
+			Execute:     c.GetDelegationRewards,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "getWithdrawEnabled()",
-			Execute: c.GetWithdrawAddrEnabled,
+			AbiSig:      "getDelegationTotalRewards(address)",
+			Execute:     c.GetDelegationTotalRewards,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getValidatorOutstandingRewards(address)",
+			Execute:     c.GetValidatorOutstandingRewards,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getValidatorCommission(address)",
+			Execute:     c.GetValidatorCommission,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getCommunityPool()",
+			Execute:     c.GetCommunityPool,
+			RequiredGas: queryGas,
 		},
 	}
 }
@@ -190,3 +238,136 @@ func (c *Contract) GetWithdrawAddrEnabled(
 ) ([]any, error) {
 	return c.getWithdrawAddrEnabled(ctx)
 }
+
+// WithdrawAllDelegatorRewards is the precompile contract method for the
+// `withdrawAllDelegatorRewards()` method.
+func (c *Contract) WithdrawAllDelegatorRewards(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	return c.withdrawAllDelegatorRewardsHelper(ctx, sdk.AccAddress(caller.Bytes()))
+}
+
+// WithdrawValidatorCommission is the precompile contract method for the
+// `withdrawValidatorCommission()` method.
+func (c *Contract) WithdrawValidatorCommission(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	return c.withdrawValidatorCommissionHelper(ctx, sdk.ValAddress(caller.Bytes()))
+}
+
+// FundCommunityPool is the precompile contract method for the `fundCommunityPool(Coin[])` method.
+func (c *Contract) FundCommunityPool(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	amount, err := cosmlib.ExtractCoinsFromInput(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.fundCommunityPoolHelper(ctx, sdk.AccAddress(caller.Bytes()), amount)
+}
+
+// GetDelegationRewards is the precompile contract method for the
+// `getDelegationRewards(address,address)` method.
+func (c *Contract) GetDelegationRewards(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	delegator, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	validator, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getDelegationRewardsHelper(
+		ctx, sdk.AccAddress(delegator.Bytes()), sdk.ValAddress(validator.Bytes()),
+	)
+}
+
+// GetDelegationTotalRewards is the precompile contract method for the
+// `getDelegationTotalRewards(address)` method.
+func (c *Contract) GetDelegationTotalRewards(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	delegator, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getDelegationTotalRewardsHelper(ctx, sdk.AccAddress(delegator.Bytes()))
+}
+
+// GetValidatorOutstandingRewards is the precompile contract method for the
+// `getValidatorOutstandingRewards(address)` method.
+func (c *Contract) GetValidatorOutstandingRewards(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	validator, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getValidatorOutstandingRewardsHelper(ctx, sdk.ValAddress(validator.Bytes()))
+}
+
+// GetValidatorCommission is the precompile contract method for the
+// `getValidatorCommission(address)` method.
+func (c *Contract) GetValidatorCommission(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	validator, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getValidatorCommissionHelper(ctx, sdk.ValAddress(validator.Bytes()))
+}
+
+// GetCommunityPool is the precompile contract method for the `getCommunityPool()` method.
+func (c *Contract) GetCommunityPool(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	return c.getCommunityPoolHelper(ctx)
+}
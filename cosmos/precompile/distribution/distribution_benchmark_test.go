@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package distribution
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	precomtest "pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// setupBenchmark returns a distribution precompile and funds Alice with coins.
+func setupBenchmark(b *testing.B, coins sdk.Coins) (sdk.Context, *Contract) {
+	ctx, ak, bk, sk := testutil.SetupMinimalKeepers()
+	encCfg := cosmostestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ak.SetModuleAccount(ctx, authtypes.NewEmptyModuleAccount(
+		distributiontypes.ModuleName, authtypes.Minter, authtypes.Burner,
+	))
+	dk := distrkeeper.NewKeeper(
+		encCfg.Codec, testutil.EvmKey, ak, bk, sk, "gov",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	params := distributiontypes.DefaultParams()
+	params.WithdrawAddrEnabled = true
+	if err := dk.SetParams(ctx, params); err != nil {
+		b.Fatal(err)
+	}
+	dk.SetFeePool(ctx, distributiontypes.InitialFeePool())
+	contract := utils.MustGetAs[*Contract](NewPrecompileContract(
+		distrkeeper.NewMsgServerImpl(dk),
+		distrkeeper.NewQuerier(dk),
+	))
+
+	alice := cosmlib.AddressToAccAddress(testutil.Alice)
+	if err := bk.MintCoins(ctx, distributiontypes.ModuleName, coins); err != nil {
+		b.Fatal(err)
+	}
+	if err := bk.SendCoinsFromModuleToAccount(
+		ctx, distributiontypes.ModuleName, alice, coins,
+	); err != nil {
+		b.Fatal(err)
+	}
+	return ctx, contract
+}
+
+func BenchmarkGetCommunityPool(b *testing.B) {
+	ctx, contract := setupBenchmark(b, sdk.NewCoins())
+	precomtest.BenchmarkMethod(b, ctx, nil, contract, testutil.Alice, "getCommunityPool")
+}
+
+func BenchmarkGetDelegationTotalRewards(b *testing.B) {
+	ctx, contract := setupBenchmark(b, sdk.NewCoins())
+	precomtest.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "getDelegationTotalRewards", testutil.Alice,
+	)
+}
+
+func BenchmarkSetWithdrawAddress(b *testing.B) {
+	ctx, contract := setupBenchmark(b, sdk.NewCoins())
+	precomtest.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "setWithdrawAddress", testutil.Bob,
+	)
+}
+
+func BenchmarkFundCommunityPool(b *testing.B) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("afury", 100))
+	ctx, contract := setupBenchmark(b, coins)
+	precomtest.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "fundCommunityPool",
+		cosmlib.SdkCoinsToUnnamedCoins(coins),
+	)
+}
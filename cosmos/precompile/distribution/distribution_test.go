@@ -40,10 +40,12 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	libgenerated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/lib"
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/distribution"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/precompile"
 	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
+	"pkg.furychain.dev/gridiron/eth/common"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/lib/utils"
 
@@ -145,7 +147,7 @@ var _ = Describe("Distribution Precompile Test", func() {
 
 	When("PrecompileMethods", func() {
 		It("should return the correct methods", func() {
-			Expect(contract.PrecompileMethods()).To(HaveLen(5))
+			Expect(contract.PrecompileMethods()).To(HaveLen(13))
 		})
 	})
 
@@ -410,6 +412,115 @@ var _ = Describe("Distribution Precompile Test", func() {
 			})
 
 		})
+		When("Querying rewards", func() {
+			It("should fail if not common address", func() {
+				_, err := contract.GetDelegationRewards(
+					ctx, nil, testutil.Alice, big.NewInt(0), true,
+					"0x0000000000", cosmlib.ValAddressToEthAddress(valAddr),
+				)
+				Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+
+				_, err = contract.GetValidatorCommission(
+					ctx, nil, testutil.Alice, big.NewInt(0), true, "0x0000000000",
+				)
+				Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+			})
+
+			It("should get the delegation rewards", func() {
+				rewards, _ := tokens.TruncateDecimal()
+
+				res, err := contract.GetDelegationRewards(
+					ctx, nil, testutil.Alice, big.NewInt(0), true,
+					cosmlib.AccAddressToEthAddress(addr), cosmlib.ValAddressToEthAddress(valAddr),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(rewards)))
+
+				res, err = contract.GetDelegationTotalRewards(
+					ctx, nil, testutil.Alice, big.NewInt(0), true, cosmlib.AccAddressToEthAddress(addr),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				perValidator := utils.MustGetAs[[]generated.IDistributionModuleValidatorReward](res[0])
+				Expect(perValidator).To(HaveLen(1))
+				Expect(perValidator[0].Validator).To(Equal(cosmlib.ValAddressToEthAddress(valAddr)))
+				Expect(perValidator[0].Rewards[0].Amount).To(Equal(rewards[0].Amount.BigInt()))
+				Expect(res[1]).To(Equal(cosmlib.SdkCoinsToEvmCoins(rewards)))
+			})
+
+			It("should get the validator rewards and commission", func() {
+				rewards, _ := tokens.TruncateDecimal()
+
+				res, err := contract.GetValidatorOutstandingRewards(
+					ctx, nil, testutil.Alice, big.NewInt(0), true, cosmlib.ValAddressToEthAddress(valAddr),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(rewards)))
+
+				accumulated := dk.GetValidatorAccumulatedCommission(ctx, valAddr)
+				commission, _ := accumulated.Commission.TruncateDecimal()
+				res, err = contract.GetValidatorCommission(
+					ctx, nil, testutil.Alice, big.NewInt(0), true, cosmlib.ValAddressToEthAddress(valAddr),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(commission)))
+			})
+		})
+
+		When("Withdrawing all rewards and commission", func() {
+			It("should withdraw the rewards of all delegations of the caller", func() {
+				res, err := contract.WithdrawAllDelegatorRewards(
+					ctx, nil, cosmlib.AccAddressToEthAddress(addr), big.NewInt(0), false,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				rewards, _ := tokens.TruncateDecimal()
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(rewards)))
+				Expect(bk.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount).To(Equal(rewards[0].Amount))
+			})
+
+			It("should withdraw the commission of the caller's validator", func() {
+				commission := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))}
+				dk.SetValidatorAccumulatedCommission(
+					ctx, valAddr, distributiontypes.ValidatorAccumulatedCommission{Commission: commission},
+				)
+
+				res, err := contract.WithdrawValidatorCommission(
+					ctx, nil, cosmlib.AccAddressToEthAddress(addr), big.NewInt(0), false,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))),
+				)))
+			})
+		})
+
+		When("Funding the community pool", func() {
+			It("should fail if the amount is not coins", func() {
+				_, err := contract.FundCommunityPool(
+					ctx, nil, cosmlib.AccAddressToEthAddress(addr), big.NewInt(0), false, "coins",
+				)
+				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+			})
+
+			It("should fund the community pool", func() {
+				amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+				Expect(bk.MintCoins(ctx, distributiontypes.ModuleName, amount)).To(Succeed())
+				Expect(bk.SendCoinsFromModuleToAccount(
+					ctx, distributiontypes.ModuleName, addr, amount,
+				)).To(Succeed())
+
+				res, err := contract.FundCommunityPool(
+					ctx, nil, cosmlib.AccAddressToEthAddress(addr), big.NewInt(0), false,
+					cosmlib.SdkCoinsToUnnamedCoins(amount),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(BeTrue())
+
+				res, err = contract.GetCommunityPool(ctx, nil, testutil.Alice, big.NewInt(0), true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(Equal(cosmlib.SdkCoinsToEvmCoins(amount)))
+			})
+		})
+
 		When("Reading Params", func() {
 			It("Should get if withdraw forwarding is enabled", func() {
 				res, err := contract.GetWithdrawAddrEnabled(ctx, nil, testutil.Alice, big.NewInt(0), true)
@@ -418,11 +529,18 @@ var _ = Describe("Distribution Precompile Test", func() {
 			})
 		})
 		When("Base Precompile Features", func() {
-			It("Should have custom value decoders", func() {
-				Expect(contract.CustomValueDecoders()).ToNot(BeNil())
+			It("Should decode the withdraw address with the default value decoders", func() {
+				Expect(contract.CustomValueDecoders()).To(BeNil())
+				event := sdk.NewEvent(
+					distributiontypes.EventTypeSetWithdrawAddress,
+					sdk.NewAttribute(distributiontypes.AttributeKeyWithdrawAddress, addr.String()),
+				)
+				log, err := f.Build(&event)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(log.Topics[1]).To(Equal(common.BytesToHash(addr.Bytes())))
 			})
 			It("Should have correct amount of precompile methods", func() {
-				Expect(contract.PrecompileMethods()).To(HaveLen(5))
+				Expect(contract.PrecompileMethods()).To(HaveLen(13))
 			})
 		})
 	})
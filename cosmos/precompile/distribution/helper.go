@@ -27,6 +27,8 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	libgenerated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/lib"
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/distribution"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 )
 
 // setWithdrawAddressHelper is a helper function for the `SetWithdrawAddress` method.
@@ -67,3 +69,178 @@ func (c *Contract) withdrawDelegatorRewardsHelper(
 
 	return []any{amount}, nil
 }
+
+// withdrawAllDelegatorRewardsHelper is a helper function for the `WithdrawAllDelegatorRewards`
+// method. It withdraws the rewards of every delegation of the delegator.
+func (c *Contract) withdrawAllDelegatorRewardsHelper(
+	ctx context.Context,
+	delegator sdk.AccAddress,
+) ([]any, error) {
+	res, err := c.querier.DelegatorValidators(ctx, &distributiontypes.QueryDelegatorValidatorsRequest{
+		DelegatorAddress: delegator.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, validator := range res.Validators {
+		var withdrawRes *distributiontypes.MsgWithdrawDelegatorRewardResponse
+		withdrawRes, err = c.msgServer.WithdrawDelegatorReward(
+			ctx, &distributiontypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: delegator.String(),
+				ValidatorAddress: validator,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(withdrawRes.Amount...)
+	}
+
+	return []any{cosmlib.SdkCoinsToEvmCoins(total)}, nil
+}
+
+// withdrawValidatorCommissionHelper is a helper function for the `WithdrawValidatorCommission`
+// method.
+func (c *Contract) withdrawValidatorCommissionHelper(
+	ctx context.Context,
+	validator sdk.ValAddress,
+) ([]any, error) {
+	res, err := c.msgServer.WithdrawValidatorCommission(
+		ctx, &distributiontypes.MsgWithdrawValidatorCommission{
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{cosmlib.SdkCoinsToEvmCoins(res.Amount)}, nil
+}
+
+// fundCommunityPoolHelper is a helper function for the `FundCommunityPool` method.
+func (c *Contract) fundCommunityPoolHelper(
+	ctx context.Context,
+	depositor sdk.AccAddress,
+	amount sdk.Coins,
+) ([]any, error) {
+	_, err := c.msgServer.FundCommunityPool(ctx, &distributiontypes.MsgFundCommunityPool{
+		Amount:    amount,
+		Depositor: depositor.String(),
+	})
+	return []any{err == nil}, err
+}
+
+// getDelegationRewardsHelper is a helper function for the `GetDelegationRewards` method.
+func (c *Contract) getDelegationRewardsHelper(
+	ctx context.Context,
+	delegator sdk.AccAddress,
+	validator sdk.ValAddress,
+) ([]any, error) {
+	res, err := c.querier.DelegationRewards(ctx, &distributiontypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{decCoinsToEvmCoins(res.Rewards)}, nil
+}
+
+// getDelegationTotalRewardsHelper is a helper function for the `GetDelegationTotalRewards`
+// method.
+func (c *Contract) getDelegationTotalRewardsHelper(
+	ctx context.Context,
+	delegator sdk.AccAddress,
+) ([]any, error) {
+	res, err := c.querier.DelegationTotalRewards(
+		ctx, &distributiontypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]generated.IDistributionModuleValidatorReward, 0, len(res.Rewards))
+	for _, reward := range res.Rewards {
+		var validator sdk.ValAddress
+		if validator, err = sdk.ValAddressFromBech32(reward.ValidatorAddress); err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, generated.IDistributionModuleValidatorReward{
+			Validator: cosmlib.ValAddressToEthAddress(validator),
+			Rewards:   decCoinsToGeneratedCoins(reward.Reward),
+		})
+	}
+
+	return []any{rewards, decCoinsToEvmCoins(res.Total)}, nil
+}
+
+// getValidatorOutstandingRewardsHelper is a helper function for the
+// `GetValidatorOutstandingRewards` method.
+func (c *Contract) getValidatorOutstandingRewardsHelper(
+	ctx context.Context,
+	validator sdk.ValAddress,
+) ([]any, error) {
+	res, err := c.querier.ValidatorOutstandingRewards(
+		ctx, &distributiontypes.QueryValidatorOutstandingRewardsRequest{
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{decCoinsToEvmCoins(res.Rewards.Rewards)}, nil
+}
+
+// getValidatorCommissionHelper is a helper function for the `GetValidatorCommission` method.
+func (c *Contract) getValidatorCommissionHelper(
+	ctx context.Context,
+	validator sdk.ValAddress,
+) ([]any, error) {
+	res, err := c.querier.ValidatorCommission(
+		ctx, &distributiontypes.QueryValidatorCommissionRequest{
+			ValidatorAddress: validator.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{decCoinsToEvmCoins(res.Commission.Commission)}, nil
+}
+
+// getCommunityPoolHelper is a helper function for the `GetCommunityPool` method.
+func (c *Contract) getCommunityPoolHelper(ctx context.Context) ([]any, error) {
+	res, err := c.querier.CommunityPool(ctx, &distributiontypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{decCoinsToEvmCoins(res.Pool)}, nil
+}
+
+// decCoinsToEvmCoins truncates sdk.DecCoins to whole amounts and converts them into
+// []libgenerated.CosmosCoin.
+func decCoinsToEvmCoins(decCoins sdk.DecCoins) []libgenerated.CosmosCoin {
+	coins, _ := decCoins.TruncateDecimal()
+	return cosmlib.SdkCoinsToEvmCoins(coins)
+}
+
+// decCoinsToGeneratedCoins truncates sdk.DecCoins to whole amounts and converts them into
+// []generated.CosmosCoin, as used by the `IDistributionModule.ValidatorReward` struct.
+func decCoinsToGeneratedCoins(decCoins sdk.DecCoins) []generated.CosmosCoin {
+	coins, _ := decCoins.TruncateDecimal()
+	generatedCoins := make([]generated.CosmosCoin, len(coins))
+	for i, coin := range coins {
+		generatedCoins[i] = generated.CosmosCoin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		}
+	}
+	return generatedCoins
+}
@@ -39,6 +39,19 @@ import (
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in erc20_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+//
+// The state-changing methods are measured on a transfer of an SDK coin paired with a token; the
+// gas of the calls into the token contracts is charged by the EVM on top.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 2000
+	// txGas is charged by the state-changing methods.
+	txGas = 6000
+)
+
 // Contract is the precompile contract for the auth module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -55,10 +68,7 @@ func NewPrecompileContract(bk bankkeeper.Keeper, em ERC20Module) ethprecompile.S
 	return &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			cpbindings.ERC20ModuleMetaData.ABI,
-			// cosmlib.AccAddressToEthAddress(
-			// 	authtypes.NewModuleAddress(erc20types.ModuleName),
-			// ),
-			common.HexToAddress("0x696969"), // TODO: module addresses are broken
+			erc20types.EscrowAddress,
 		),
 		bk:              bk,
 		em:              em,
@@ -81,56 +91,69 @@ func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 	return ethprecompile.Methods{
 		{
-			AbiSig:  "coinDenomForERC20Address(address)",
-			Execute: c.CoinDenomForERC20AddressAddrInput,
+			AbiSig:      "coinDenomForERC20Address(address)",
+			Execute:     c.CoinDenomForERC20AddressAddrInput,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "coinDenomForERC20Address(string)",
-			Execute: c.CoinDenomForERC20AddressStringInput,
+			AbiSig:      "coinDenomForERC20Address(string)",
+			Execute:     c.CoinDenomForERC20AddressStringInput,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "erc20AddressForCoinDenom(string)",
-			Execute: c.ERC20AddressForCoinDenom,
+			AbiSig:      "erc20AddressForCoinDenom(string)",
+			Execute:     c.ERC20AddressForCoinDenom,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "transferCoinToERC20(string,uint256)",
-			Execute: c.TransferCoinToERC20,
+			AbiSig:      "transferCoinToERC20(string,uint256)",
+			Execute:     c.TransferCoinToERC20,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferCoinToERC20From(string,address,address,uint256)",
-			Execute: c.TransferCoinToERC20FromAddrInput,
+			AbiSig:      "transferCoinToERC20From(string,address,address,uint256)",
+			Execute:     c.TransferCoinToERC20FromAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferCoinToERC20From(string,string,string,uint256)",
-			Execute: c.TransferCoinToERC20FromStringInput,
+			AbiSig:      "transferCoinToERC20From(string,string,string,uint256)",
+			Execute:     c.TransferCoinToERC20FromStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferCoinToERC20To(string,address,uint256)",
-			Execute: c.TransferCoinToERC20ToAddrInput,
+			AbiSig:      "transferCoinToERC20To(string,address,uint256)",
+			Execute:     c.TransferCoinToERC20ToAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferCoinToERC20To(string,string,uint256)",
-			Execute: c.TransferCoinToERC20ToStringInput,
+			AbiSig:      "transferCoinToERC20To(string,string,uint256)",
+			Execute:     c.TransferCoinToERC20ToStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferERC20ToCoin(address,uint256)",
-			Execute: c.TransferERC20ToCoin,
+			AbiSig:      "transferERC20ToCoin(address,uint256)",
+			Execute:     c.TransferERC20ToCoin,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferERC20ToCoinFrom(address,address,address,uint256)",
-			Execute: c.TransferERC20ToCoinFromAddrInput,
+			AbiSig:      "transferERC20ToCoinFrom(address,address,address,uint256)",
+			Execute:     c.TransferERC20ToCoinFromAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferERC20ToCoinFrom(address,string,string,uint256)",
-			Execute: c.TransferERC20ToCoinFromStringInput,
+			AbiSig:      "transferERC20ToCoinFrom(address,string,string,uint256)",
+			Execute:     c.TransferERC20ToCoinFromStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferERC20ToCoinTo(address,address,uint256)",
-			Execute: c.TransferERC20ToCoinToAddrInput,
+			AbiSig:      "transferERC20ToCoinTo(address,address,uint256)",
+			Execute:     c.TransferERC20ToCoinToAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "transferERC20ToCoinTo(address,string,uint256)",
-			Execute: c.TransferERC20ToCoinToStringInput,
+			AbiSig:      "transferERC20ToCoinTo(address,string,uint256)",
+			Execute:     c.TransferERC20ToCoinToStringInput,
+			RequiredGas: txGas,
 		},
 	}
 }
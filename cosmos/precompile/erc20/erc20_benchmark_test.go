@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package erc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/erc20"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	erc20keeper "pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+)
+
+const benchDenom = "ibc/1234"
+
+var benchToken = common.BytesToAddress([]byte("token"))
+
+// setupBenchmark returns an erc20 precompile with a coin <> ERC20 pair of `benchDenom`, which
+// Alice is funded with.
+func setupBenchmark(b *testing.B) (sdk.Context, ethprecompile.StatefulImpl) {
+	ctx, _, bk, _ := testutil.SetupMinimalKeepers()
+	k := erc20keeper.NewKeeper(
+		storetypes.NewKVStoreKey("erc20"), bk, authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	contract := erc20.NewPrecompileContract(bk, k)
+
+	k.RegisterCoinERC20Pair(ctx, benchDenom, benchToken)
+	bk.SetSendEnabled(ctx, benchDenom, true)
+	if err := cosmlib.MintCoinsToAddress(
+		ctx, bk, evmtypes.ModuleName, testutil.Alice, benchDenom, big.NewInt(1e18),
+	); err != nil {
+		b.Fatal(err)
+	}
+	return ctx, contract
+}
+
+func BenchmarkERC20AddressForCoinDenom(b *testing.B) {
+	ctx, contract := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "erc20AddressForCoinDenom", benchDenom,
+	)
+}
+
+func BenchmarkCoinDenomForERC20Address(b *testing.B) {
+	ctx, contract := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "coinDenomForERC20Address", benchToken,
+	)
+}
+
+func BenchmarkTransferCoinToERC20To(b *testing.B) {
+	ctx, contract := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "transferCoinToERC20To0",
+		benchDenom, testutil.Bob, big.NewInt(1),
+	)
+}
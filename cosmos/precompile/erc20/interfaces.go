@@ -37,5 +37,21 @@ type (
 
 		// RegisterCoinERC20Pair registers a new IBC-originated SDK Coin <> ERC20 token pair.
 		RegisterCoinERC20Pair(ctx sdk.Context, denom string, token common.Address)
+
+		// IsAllowlistEnabled returns true if only governance registered ERC20 originated tokens
+		// can be converted.
+		IsAllowlistEnabled(ctx sdk.Context) bool
+
+		// IsPairPaused returns true if the conversions of the token pair of the given ERC20 token
+		// are paused.
+		IsPairPaused(ctx sdk.Context, token common.Address) bool
+
+		// ERC20MetadataForDenom returns the ERC20 name, symbol and decimals of the token
+		// representation of the given SDK coin denomination.
+		ERC20MetadataForDenom(ctx sdk.Context, denom string) (string, string, uint8)
+
+		// RegisterERC20DenomMetadata registers the bank denom metadata of the Gridiron coin
+		// denomination of an ERC20 originated token.
+		RegisterERC20DenomMetadata(ctx sdk.Context, denom, name, symbol string, decimals uint8)
 	}
 )
@@ -31,6 +31,7 @@ import (
 	erc20types "pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
 	"pkg.furychain.dev/gridiron/eth/common"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
 const (
@@ -38,8 +39,20 @@ const (
 	transferFrom = `transferFrom`
 )
 
-// ErrTokenDoesNotExist is returned when a token contract does not exist.
-var ErrTokenDoesNotExist = errors.New("ERC20 token contract does not exist")
+var (
+	// ErrTokenDoesNotExist is returned when a token contract does not exist.
+	ErrTokenDoesNotExist = errors.New("ERC20 token contract does not exist")
+	// ErrInvalidERC20Metadata is returned when a token contract returns malformed metadata.
+	ErrInvalidERC20Metadata = errors.New("ERC20 token contract returned invalid metadata")
+	// ErrPairPaused is returned when the conversions of a token pair are paused.
+	ErrPairPaused = errors.New("token pair is paused")
+	// ErrPairNotRegistered is returned when converting an ERC20 token without a registered token
+	// pair while the allowlist is enabled.
+	ErrPairNotRegistered = errors.New("token pair is not registered")
+	// ErrEscrowMismatch is returned when the escrow balance of an ERC20 token does not increase by
+	// exactly the transferred amount, e.g. for fee-on-transfer tokens.
+	ErrEscrowMismatch = errors.New("ERC20 token escrow balance does not match transferred amount")
+)
 
 // transferCoinToERC20 transfers SDK/Gridiron coins to ERC20 tokens for an owner.
 func (c *Contract) transferCoinToERC20(
@@ -84,30 +97,30 @@ func (c *Contract) transferCoinToERC20(
 		return err
 	}
 	if resp.Token == "" { //nolint:nestif // readability.
-		// first occurrence of an IBC originated SDK coin
+		// Gridiron coins are only minted for registered pairs, so a missing pair means it was
+		// deregistered; never deploy a new token for them, as the escrowed tokens would be lost
+		if isGridironDenom {
+			return ErrPairNotRegistered
+		}
 
-		// TODO: require that the SDK coin has denom metadata registered.
-		// if !c.bk.HasDenomMetaData(sdkCtx, denom) {
-		// 	return fmt.Errorf("coin %s does not have metadata registered", denom)
-		// }
+		// first occurrence of an IBC originated SDK coin
 
-		// deploy the new GridironERC20 token contract
+		// deploy the new GridironERC20 token contract, named after the coin's denom metadata
 		// NOTE: deployer of this contract is the ERC20 precompile account, NOT the msg.sender
-		// NOTE: the incoming coin's denom must have a denomMetadata set in the bank keeper
-		// (ref: https://github.com/furychain/gridiron/issues/682)
+		name, symbol, decimals := c.em.ERC20MetadataForDenom(sdkCtx, denom)
 		var token common.Address
 		if token, _, err = cosmlib.DeployOnEVMFromPrecompile(
 			sdkCtx, c.GetPlugin(), evm,
 			c.RegistryKey(), c.gridironERC20ABI, value,
-			c.gridironERC20Bin, denom,
+			c.gridironERC20Bin, denom, name, symbol, decimals,
 		); err != nil {
 			return err
 		}
 
 		// create the new ERC20 token contract pairing with SDK coin denomination
 		c.em.RegisterCoinERC20Pair(sdkCtx, denom, token)
-	} else if isGridironDenom {
-		// subesequent occurrence of Gridiron coins
+	} else {
+		// subesequent occurrence of the SDK/Gridiron coins
 
 		// convert ERC20 token bech32 address to common.Address
 		var tokenAcc sdk.AccAddress
@@ -116,19 +129,26 @@ func (c *Contract) transferCoinToERC20(
 		}
 		token := cosmlib.AccAddressToEthAddress(tokenAcc)
 
-		// return an error if the ERC20 token contract does not exist to revert the tx
-		if !evm.GetStateDB().Exist(token) {
-			return ErrTokenDoesNotExist
+		// return an error if governance paused the conversions of the pair to revert the tx
+		if c.em.IsPairPaused(sdkCtx, token) {
+			return ErrPairPaused
 		}
 
-		// transfer escrowed amount ERC20-originated tokens to the recipient
-		// NOTE: it is guaranteed that the ERC20 tokens were transferred to the ERC20 module
-		if _, err = cosmlib.CallEVMFromPrecompile(
-			sdkCtx, c.GetPlugin(), evm,
-			c.RegistryKey(), token, c.gridironERC20ABI, big.NewInt(0),
-			transfer, recipient, amount,
-		); err != nil {
-			return err
+		if isGridironDenom {
+			// return an error if the ERC20 token contract does not exist to revert the tx
+			if !evm.GetStateDB().Exist(token) {
+				return ErrTokenDoesNotExist
+			}
+
+			// transfer escrowed amount ERC20-originated tokens to the recipient
+			// NOTE: it is guaranteed that the ERC20 tokens were transferred to the ERC20 module
+			if _, err = cosmlib.CallEVMFromPrecompile(
+				sdkCtx, c.GetPlugin(), evm,
+				c.RegistryKey(), token, c.gridironERC20ABI, big.NewInt(0),
+				transfer, recipient, amount,
+			); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -169,8 +189,19 @@ func (c *Contract) transferERC20ToCoin(
 
 	denom := resp.Denom
 	if denom == "" {
+		// only governance can register the pairs of ERC20 tokens while the allowlist is enabled
+		if c.em.IsAllowlistEnabled(sdkCtx) {
+			return ErrPairNotRegistered
+		}
+
 		// if denomination not found, create new pair with ERC20 token <> Gridiron coin denomination
 		denom = c.em.RegisterERC20CoinPair(sdkCtx, token)
+
+		// describe the new Gridiron coin with the ERC20 token's metadata
+		c.registerERC20DenomMetadata(sdkCtx, evm, token, denom)
+	} else if c.em.IsPairPaused(sdkCtx, token) {
+		// return an error if governance paused the conversions of the pair to revert the tx
+		return ErrPairPaused
 	}
 
 	//nolint:nestif // readability.
@@ -180,6 +211,11 @@ func (c *Contract) transferERC20ToCoin(
 			return ErrTokenDoesNotExist
 		}
 
+		escrowBefore, err := c.escrowBalance(sdkCtx, evm, token)
+		if err != nil {
+			return err
+		}
+
 		// caller transfers amount ERC20 tokens from owner to ERC20 module precompile contract in
 		// escrow
 		// NOTE: owner must have previously approved msg.sender to spend amount ERC20 tokens
@@ -191,6 +227,16 @@ func (c *Contract) transferERC20ToCoin(
 			return err
 		}
 
+		// only mint as many coins as were actually escrowed, which rejects fee-on-transfer and
+		// other tokens that do not move exactly amount
+		escrowAfter, err := c.escrowBalance(sdkCtx, evm, token)
+		if err != nil {
+			return err
+		}
+		if new(big.Int).Sub(escrowAfter, escrowBefore).Cmp(amount) != 0 {
+			return ErrEscrowMismatch
+		}
+
 		// mint amount Gridiron Coins to recipient
 		if err = cosmlib.MintCoinsToAddress(sdkCtx, c.bk, erc20types.ModuleName, recipient, denom, amount); err != nil {
 			return err
@@ -219,3 +265,75 @@ func (c *Contract) transferERC20ToCoin(
 	)
 	return nil
 }
+
+// registerERC20DenomMetadata registers the bank denom metadata of the Gridiron coin denomination
+// of an ERC20 originated token, read from the token's `name`, `symbol` and `decimals` methods. If
+// the token does not implement these methods, no metadata is registered.
+func (c *Contract) registerERC20DenomMetadata(
+	ctx sdk.Context, evm ethprecompile.EVM, token common.Address, denom string,
+) {
+	name, err := c.callERC20View(ctx, evm, token, "name")
+	if err != nil {
+		return
+	}
+	symbol, err := c.callERC20View(ctx, evm, token, "symbol")
+	if err != nil {
+		return
+	}
+	decimals, err := c.callERC20View(ctx, evm, token, "decimals")
+	if err != nil {
+		return
+	}
+
+	nameStr, ok := utils.GetAs[string](name)
+	if !ok {
+		return
+	}
+	symbolStr, ok := utils.GetAs[string](symbol)
+	if !ok {
+		return
+	}
+	decimalsUint8, ok := utils.GetAs[uint8](decimals)
+	if !ok {
+		return
+	}
+	c.em.RegisterERC20DenomMetadata(ctx, denom, nameStr, symbolStr, decimalsUint8)
+}
+
+// escrowBalance returns the ERC20 token balance escrowed by the ERC20 module precompile.
+func (c *Contract) escrowBalance(
+	ctx sdk.Context, evm ethprecompile.EVM, token common.Address,
+) (*big.Int, error) {
+	balance, err := c.callERC20View(ctx, evm, token, "balanceOf", c.RegistryKey())
+	if err != nil {
+		return nil, err
+	}
+	balanceBig, ok := utils.GetAs[*big.Int](balance)
+	if !ok {
+		return nil, ErrEscrowMismatch
+	}
+	return balanceBig, nil
+}
+
+// callERC20View calls the given view method of an ERC20 token and returns its single return value.
+func (c *Contract) callERC20View(
+	ctx sdk.Context, evm ethprecompile.EVM, token common.Address, method string, args ...any,
+) (any, error) {
+	ret, err := cosmlib.CallEVMFromPrecompile(
+		ctx, c.GetPlugin(), evm,
+		c.RegistryKey(), token, c.gridironERC20ABI, big.NewInt(0),
+		method, args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := c.gridironERC20ABI.Unpack(method, ret)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, ErrInvalidERC20Metadata
+	}
+	return values[0], nil
+}
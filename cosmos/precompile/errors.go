@@ -36,4 +36,5 @@ var (
 	ErrInvalidOptions       = errors.New("invalid options")
 	ErrInvalidBytes         = errors.New("invalid bytes")
 	ErrInvalidGrantType     = errors.New("invalid grant type")
+	ErrInvalidPageRequest   = errors.New("invalid page request")
 )
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feegrant
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/feegrant"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in feegrant_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 2600
+	// txGas is charged by the state-changing methods.
+	txGas = 4200
+)
+
+// Contract is the precompile contract for the feegrant module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	msgServer   feegrant.MsgServer
+	queryServer QueryKeeper
+	registry    codectypes.InterfaceRegistry
+}
+
+// NewPrecompileContract returns a new instance of the feegrant module precompile contract. Uses
+// the feegrant module's account address as the contract address. Queried allowances are decoded
+// with the interface `registry`.
+func NewPrecompileContract(
+	m feegrant.MsgServer,
+	q QueryKeeper,
+	registry codectypes.InterfaceRegistry,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.FeegrantModuleMetaData.ABI,
+			// Precompile Address: 0xABeEA5af75218ebf9B24447f73f3c7F7c021D947
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(feegrant.ModuleName)),
+		),
+		msgServer:   m,
+		queryServer: q,
+		registry:    registry,
+	}
+}
+
+// PrecompileMethods implements the `ethprecompile.StatefulImpl` interface.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:      "grantBasicAllowance(address,(uint256,string)[],uint256)",
+			Execute:     c.GrantBasicAllowance,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "grantPeriodicAllowance(address,(uint256,string)[],uint256,uint64,(uint256,string)[])",
+			Execute:     c.GrantPeriodicAllowance,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "grantAllowedMsgAllowance(address,(uint256,string)[],uint256,string[])",
+			Execute:     c.GrantAllowedMsgAllowance,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "revokeAllowance(address)",
+			Execute:     c.RevokeAllowance,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getAllowance(address,address)",
+			Execute:     c.GetAllowance,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getAllowances(address,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetAllowances,
+			RequiredGas: queryGas,
+		},
+	}
+}
+
+// CustomValueDecoders implements the `ethprecompile.StatefulImpl` interface.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		feegrant.AttributeKeyGranter: log.ConvertAccAddressFromBech32,
+		feegrant.AttributeKeyGrantee: log.ConvertAccAddressFromBech32,
+	}
+}
+
+// GrantBasicAllowance is the method for the `grantBasicAllowance` method of the feegrant
+// precompile contract.
+func (c *Contract) GrantBasicAllowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	basic, err := basicAllowanceFromInput(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grantAllowanceHelper(ctx, caller, grantee, basic)
+}
+
+// GrantPeriodicAllowance is the method for the `grantPeriodicAllowance` method of the feegrant
+// precompile contract.
+func (c *Contract) GrantPeriodicAllowance(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	basic, err := basicAllowanceFromInput(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	period, ok := utils.GetAs[uint64](args[3])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+	periodSpendLimit, err := cosmlib.ExtractCoinsFromInput(args[4])
+	if err != nil {
+		return nil, err
+	}
+	// The period spend limit cannot exceed the total spend limit, if there is one.
+	if !basic.SpendLimit.Empty() && !periodSpendLimit.IsAllLTE(basic.SpendLimit) {
+		return nil, ErrPeriodSpendLimitExceeded
+	}
+
+	// The first period starts at the current block time.
+	periodDuration := time.Duration(period) * time.Second
+	return c.grantAllowanceHelper(ctx, caller, grantee, &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           periodDuration,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      time.Unix(int64(evm.GetContext().Time), 0).Add(periodDuration),
+	})
+}
+
+// GrantAllowedMsgAllowance is the method for the `grantAllowedMsgAllowance` method of the feegrant
+// precompile contract.
+func (c *Contract) GrantAllowedMsgAllowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	basic, err := basicAllowanceFromInput(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	allowedMsgs, ok := utils.GetAs[[]string](args[3])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(basic, allowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grantAllowanceHelper(ctx, caller, grantee, allowance)
+}
+
+// RevokeAllowance is the method for the `revokeAllowance` method of the feegrant precompile
+// contract.
+func (c *Contract) RevokeAllowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(
+		cosmlib.AddressToAccAddress(caller), cosmlib.AddressToAccAddress(grantee),
+	)
+	if _, err := c.msgServer.RevokeAllowance(ctx, &msg); err != nil {
+		return nil, err
+	}
+	return []any{true}, nil
+}
+
+// GetAllowance is the method for the `getAllowance` method of the feegrant precompile contract.
+func (c *Contract) GetAllowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	granter, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	grantee, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getAllowanceHelper(
+		ctx, cosmlib.AddressToAccAddress(granter), cosmlib.AddressToAccAddress(grantee),
+	)
+}
+
+// GetAllowances is the method for the `getAllowances` method of the feegrant precompile contract.
+func (c *Contract) GetAllowances(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	pagination, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryServer.Allowances(ctx, &feegrant.QueryAllowancesRequest{
+		Grantee:    cosmlib.AddressToAccAddress(grantee).String(),
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowances := make([]generated.IFeegrantModuleAllowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		if allowances[i], err = c.grantToAllowance(grant); err != nil {
+			return nil, err
+		}
+	}
+	return []any{allowances, pageResponseToOutput(res.Pagination)}, nil
+}
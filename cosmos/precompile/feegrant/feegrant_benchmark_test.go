@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feegrant_test
+
+import (
+	"math/big"
+	"testing"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	feegrantmodule "cosmossdk.io/x/feegrant/module"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth/mock"
+	feegrantprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/feegrant"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+)
+
+var (
+	benchGranter = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("granter")))
+	benchGrantee = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("grantee")))
+	benchLimit   = sdk.NewCoins(sdk.NewInt64Coin("afury", 100))
+)
+
+// setupBenchmark returns a feegrant precompile and its evm. The granter has granted a basic
+// allowance to the grantee.
+func setupBenchmark(b *testing.B) (sdk.Context, *feegrantprecompile.Contract, *mock.PrecompileEVMMock) {
+	ctx, ak, _, _ := testutil.SetupMinimalKeepers()
+	encCfg := cosmostestutil.MakeTestEncodingConfig(feegrantmodule.AppModuleBasic{})
+	k := feegrantkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(testutil.EvmKey), ak)
+	contract := feegrantprecompile.NewPrecompileContract(
+		feegrantkeeper.NewMsgServerImpl(k), k, encCfg.InterfaceRegistry,
+	)
+
+	evm := mock.NewPrecompileEVMMock()
+	evm.GetContextFunc = func() *vm.BlockContext {
+		return &vm.BlockContext{Time: 100}
+	}
+	if _, err := contract.GrantBasicAllowance(
+		ctx, evm, benchGranter, big.NewInt(0), false,
+		benchGrantee, sdkCoinsToEvmCoins(benchLimit), big.NewInt(0),
+	); err != nil {
+		b.Fatal(err)
+	}
+	return ctx, contract, evm
+}
+
+func BenchmarkGetAllowance(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, common.Address{}, "getAllowance", benchGranter, benchGrantee,
+	)
+}
+
+func BenchmarkGrantBasicAllowance(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	other := cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("other")))
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, benchGranter, "grantBasicAllowance",
+		other, sdkCoinsToEvmCoins(benchLimit), big.NewInt(0),
+	)
+}
+
+func BenchmarkGrantPeriodicAllowance(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	other := cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("other")))
+	test.BenchmarkMethod(
+		b, ctx, evm, contract, benchGranter, "grantPeriodicAllowance",
+		other, sdkCoinsToEvmCoins(benchLimit), big.NewInt(0), uint64(10),
+		sdkCoinsToEvmCoins(benchLimit),
+	)
+}
+
+func BenchmarkRevokeAllowance(b *testing.B) {
+	ctx, contract, evm := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, evm, contract, benchGranter, "revokeAllowance", benchGrantee)
+}
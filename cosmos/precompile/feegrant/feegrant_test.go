@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feegrant_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	feegrantmodule "cosmossdk.io/x/feegrant/module"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/feegrant"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/auth/mock"
+	feegrantprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/feegrant"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+	"pkg.furychain.dev/gridiron/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFeegrantPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/feegrant")
+}
+
+var _ = Describe("Feegrant Precompile", func() {
+	var (
+		contract         *feegrantprecompile.Contract
+		ctx              sdk.Context
+		evm              *mock.PrecompileEVMMock
+		granter, grantee common.Address
+		limit            sdk.Coins
+		pageReq          any
+	)
+
+	BeforeEach(func() {
+		sdkCtx, ak, _, _ := testutil.SetupMinimalKeepers()
+		ctx = sdkCtx
+		encCfg := cosmostestutil.MakeTestEncodingConfig(feegrantmodule.AppModuleBasic{})
+		k := feegrantkeeper.NewKeeper(
+			encCfg.Codec, runtime.NewKVStoreService(testutil.EvmKey), ak,
+		)
+		contract = feegrantprecompile.NewPrecompileContract(
+			feegrantkeeper.NewMsgServerImpl(k), k, encCfg.InterfaceRegistry,
+		)
+
+		evm = mock.NewPrecompileEVMMock()
+		evm.GetContextFunc = func() *vm.BlockContext {
+			return &vm.BlockContext{Time: 100}
+		}
+		granter = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("granter")))
+		grantee = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("grantee")))
+		limit = sdk.NewCoins(sdk.NewInt64Coin("afury", 100))
+		pageReq = struct {
+			Key        []byte `json:"key"`
+			Offset     uint64 `json:"offset"`
+			Limit      uint64 `json:"limit"`
+			CountTotal bool   `json:"countTotal"`
+			Reverse    bool   `json:"reverse"`
+		}{Limit: 10, CountTotal: true}
+	})
+
+	It("should have the feegrant module address as registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(feegrant.ModuleName))),
+		)
+	})
+
+	It("should match the precompile methods", func() {
+		var cAbi abi.ABI
+		Expect(cAbi.UnmarshalJSON([]byte(generated.FeegrantModuleMetaData.ABI))).To(Succeed())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	It("should decode the feegrant event attributes", func() {
+		Expect(contract.CustomValueDecoders()).To(HaveKey(feegrant.AttributeKeyGranter))
+		Expect(contract.CustomValueDecoders()).To(HaveKey(feegrant.AttributeKeyGrantee))
+	})
+
+	It("should error on invalid inputs", func() {
+		_, err := contract.GrantBasicAllowance(
+			ctx, evm, granter, big.NewInt(0), false, "invalid", sdkCoinsToEvmCoins(limit), big.NewInt(0),
+		)
+		Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+
+		_, err = contract.GrantBasicAllowance(
+			ctx, evm, granter, big.NewInt(0), false, grantee, "invalid", big.NewInt(0),
+		)
+		Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+
+		_, err = contract.GetAllowances(
+			ctx, evm, common.Address{}, big.NewInt(0), true, grantee, "invalid",
+		)
+		Expect(err).To(MatchError(precompile.ErrInvalidPageRequest))
+	})
+
+	It("should return an empty allowance if none was granted", func() {
+		res, err := contract.GetAllowance(
+			ctx, evm, common.Address{}, big.NewInt(0), true, granter, grantee,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		allowance := utils.MustGetAs[generated.IFeegrantModuleAllowance](res[0])
+		Expect(allowance.AllowanceTypeUrl).To(BeEmpty())
+		Expect(allowance.Expiration).To(Equal(big.NewInt(0)))
+	})
+
+	It("should grant, query and revoke a basic allowance", func() {
+		res, err := contract.GrantBasicAllowance(
+			ctx, evm, granter, big.NewInt(0), false, grantee, sdkCoinsToEvmCoins(limit), big.NewInt(110),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{true}))
+
+		res, err = contract.GetAllowance(
+			ctx, evm, common.Address{}, big.NewInt(0), true, granter, grantee,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		allowance := utils.MustGetAs[generated.IFeegrantModuleAllowance](res[0])
+		Expect(allowance.Granter).To(Equal(granter))
+		Expect(allowance.Grantee).To(Equal(grantee))
+		Expect(allowance.AllowanceTypeUrl).To(Equal("/cosmos.feegrant.v1beta1.BasicAllowance"))
+		Expect(allowance.SpendLimit).To(Equal([]generated.CosmosCoin{
+			{Amount: big.NewInt(100), Denom: "afury"},
+		}))
+		Expect(allowance.Expiration).To(Equal(big.NewInt(110)))
+
+		// A second allowance from the same granter cannot be granted.
+		_, err = contract.GrantBasicAllowance(
+			ctx, evm, granter, big.NewInt(0), false, grantee, sdkCoinsToEvmCoins(limit), big.NewInt(0),
+		)
+		Expect(err).To(HaveOccurred())
+
+		res, err = contract.RevokeAllowance(ctx, evm, granter, big.NewInt(0), false, grantee)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{true}))
+
+		res, err = contract.GetAllowance(
+			ctx, evm, common.Address{}, big.NewInt(0), true, granter, grantee,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(utils.MustGetAs[generated.IFeegrantModuleAllowance](res[0]).AllowanceTypeUrl).
+			To(BeEmpty())
+	})
+
+	It("should grant a periodic allowance", func() {
+		_, err := contract.GrantPeriodicAllowance(
+			ctx, evm, granter, big.NewInt(0), false,
+			grantee, sdkCoinsToEvmCoins(limit), big.NewInt(0),
+			uint64(3600), sdkCoinsToEvmCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 10))),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := contract.GetAllowances(
+			ctx, evm, common.Address{}, big.NewInt(0), true, grantee, pageReq,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		allowances := utils.MustGetAs[[]generated.IFeegrantModuleAllowance](res[0])
+		Expect(allowances).To(HaveLen(1))
+		Expect(allowances[0].AllowanceTypeUrl).To(Equal("/cosmos.feegrant.v1beta1.PeriodicAllowance"))
+		Expect(allowances[0].SpendLimit).To(HaveLen(1))
+		Expect(res[1]).To(Equal(generated.IFeegrantModulePageResponse{Total: 1}))
+	})
+
+	It("should error on a periodic allowance exceeding its spend limit", func() {
+		_, err := contract.GrantPeriodicAllowance(
+			ctx, evm, granter, big.NewInt(0), false,
+			grantee, sdkCoinsToEvmCoins(limit), big.NewInt(0),
+			uint64(3600), sdkCoinsToEvmCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 1000))),
+		)
+		Expect(err).To(MatchError(feegrantprecompile.ErrPeriodSpendLimitExceeded))
+	})
+
+	It("should grant an allowed msg allowance", func() {
+		_, err := contract.GrantAllowedMsgAllowance(
+			ctx, evm, granter, big.NewInt(0), false,
+			grantee, sdkCoinsToEvmCoins(limit), big.NewInt(0),
+			[]string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := contract.GetAllowance(
+			ctx, evm, common.Address{}, big.NewInt(0), true, granter, grantee,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		allowance := utils.MustGetAs[generated.IFeegrantModuleAllowance](res[0])
+		Expect(allowance.AllowanceTypeUrl).To(Equal("/cosmos.feegrant.v1beta1.AllowedMsgAllowance"))
+		Expect(allowance.SpendLimit).To(Equal([]generated.CosmosCoin{
+			{Amount: big.NewInt(100), Denom: "afury"},
+		}))
+	})
+})
+
+func sdkCoinsToEvmCoins(sdkCoins sdk.Coins) []struct {
+	Amount *big.Int `json:"amount"`
+	Denom  string   `json:"denom"`
+} {
+	evmCoins := make([]struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	}, len(sdkCoins))
+	for i, coin := range sdkCoins {
+		evmCoins[i].Amount = coin.Amount.BigInt()
+		evmCoins[i].Denom = coin.Denom
+	}
+	return evmCoins
+}
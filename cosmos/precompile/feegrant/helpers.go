@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/feegrant"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// grantAllowanceHelper is the helper method to call the grant allowance method on the msgServer,
+// with the caller as the granter.
+func (c *Contract) grantAllowanceHelper(
+	ctx context.Context,
+	granter, grantee common.Address,
+	allowance feegrant.FeeAllowanceI,
+) ([]any, error) {
+	msg, err := feegrant.NewMsgGrantAllowance(
+		allowance, cosmlib.AddressToAccAddress(granter), cosmlib.AddressToAccAddress(grantee),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.msgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+	return []any{true}, nil
+}
+
+// getAllowanceHelper returns the allowance from the granter to the grantee. An allowance with an
+// empty type URL is returned, rather than an error, if there is none.
+func (c *Contract) getAllowanceHelper(
+	ctx context.Context,
+	granter, grantee sdk.AccAddress,
+) ([]any, error) {
+	// The allowance is read from the keeper, as the query server does not keep the not found error.
+	feeAllowance, err := c.queryServer.GetAllowance(ctx, granter, grantee)
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		return []any{generated.IFeegrantModuleAllowance{
+			Granter:    cosmlib.AccAddressToEthAddress(granter),
+			Grantee:    cosmlib.AccAddressToEthAddress(grantee),
+			SpendLimit: []generated.CosmosCoin{},
+			Expiration: big.NewInt(0),
+		}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := c.grantToAllowance(&grant)
+	if err != nil {
+		return nil, err
+	}
+	return []any{allowance}, nil
+}
+
+// grantToAllowance converts a feegrant grant to the `IFeegrantModule.Allowance` struct. The
+// allowance is returned protobuf encoded, along with its type URL, and the spend limit and
+// expiration of its basic allowance.
+func (c *Contract) grantToAllowance(
+	grant *feegrant.Grant,
+) (generated.IFeegrantModuleAllowance, error) {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return generated.IFeegrantModuleAllowance{}, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return generated.IFeegrantModuleAllowance{}, err
+	}
+
+	var allowance feegrant.FeeAllowanceI
+	if err = c.registry.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return generated.IFeegrantModuleAllowance{}, err
+	}
+	basic, err := basicAllowanceOf(allowance)
+	if err != nil {
+		return generated.IFeegrantModuleAllowance{}, err
+	}
+
+	expiration := big.NewInt(0)
+	if basic.Expiration != nil {
+		expiration = big.NewInt(basic.Expiration.Unix())
+	}
+
+	return generated.IFeegrantModuleAllowance{
+		Granter:          cosmlib.AccAddressToEthAddress(granter),
+		Grantee:          cosmlib.AccAddressToEthAddress(grantee),
+		AllowanceTypeUrl: grant.Allowance.TypeUrl,
+		Allowance:        grant.Allowance.Value,
+		SpendLimit:       sdkCoinsToEvmCoins(basic.SpendLimit),
+		Expiration:       expiration,
+	}, nil
+}
+
+// basicAllowanceOf returns the basic allowance that limits the given allowance.
+func basicAllowanceOf(allowance feegrant.FeeAllowanceI) (*feegrant.BasicAllowance, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a, nil
+	case *feegrant.PeriodicAllowance:
+		return &a.Basic, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		return basicAllowanceOf(inner)
+	default:
+		return nil, precompile.ErrInvalidGrantType
+	}
+}
+
+// basicAllowanceFromInput converts a spend limit and expiration from input (of type any) into a
+// basic allowance. If the expiration is 0, then the allowance is valid forever.
+func basicAllowanceFromInput(spendLimit, expiration any) (*feegrant.BasicAllowance, error) {
+	limit, err := cosmlib.ExtractCoinsFromInput(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+	exp, ok := utils.GetAs[*big.Int](expiration)
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	basic := &feegrant.BasicAllowance{SpendLimit: limit}
+	if exp.Sign() != 0 {
+		expirationTime := time.Unix(exp.Int64(), 0)
+		basic.Expiration = &expirationTime
+	}
+	return basic, nil
+}
+
+// sdkCoinsToEvmCoins converts sdk.Coins into []generated.CosmosCoin.
+func sdkCoinsToEvmCoins(sdkCoins sdk.Coins) []generated.CosmosCoin {
+	evmCoins := make([]generated.CosmosCoin, len(sdkCoins))
+	for i, coin := range sdkCoins {
+		evmCoins[i] = generated.CosmosCoin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		}
+	}
+	return evmCoins
+}
+
+// pageResponseToOutput converts a query.PageResponse to the `IFeegrantModule.PageResponse` struct.
+func pageResponseToOutput(pageRes *query.PageResponse) generated.IFeegrantModulePageResponse {
+	if pageRes == nil {
+		return generated.IFeegrantModulePageResponse{}
+	}
+	return generated.IFeegrantModulePageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
+	}
+}
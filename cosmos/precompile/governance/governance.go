@@ -40,16 +40,32 @@ import (
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in governance_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 1300
+	// txGas is charged by the state-changing methods.
+	txGas = 11100
+)
+
 // Contract is the precompile contract for the governance module.
 type Contract struct {
 	ethprecompile.BaseContract
 
 	msgServer v1.MsgServer
 	querier   v1.QueryServer
+	registry  codectypes.InterfaceRegistry
 }
 
-// NewPrecompileContract creates a new precompile contract for the governance module.
-func NewPrecompileContract(m v1.MsgServer, q v1.QueryServer) *Contract {
+// NewPrecompileContract creates a new precompile contract for the governance module. Proposal
+// messages given by type URL are decoded with the interface `registry`.
+func NewPrecompileContract(
+	m v1.MsgServer,
+	q v1.QueryServer,
+	registry codectypes.InterfaceRegistry,
+) *Contract {
 	return &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			generated.GovernanceModuleMetaData.ABI,
@@ -58,6 +74,7 @@ func NewPrecompileContract(m v1.MsgServer, q v1.QueryServer) *Contract {
 		),
 		msgServer: m,
 		querier:   q,
+		registry:  registry,
 	}
 }
 
@@ -65,28 +82,70 @@ func NewPrecompileContract(m v1.MsgServer, q v1.QueryServer) *Contract {
 func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 	return ethprecompile.Methods{
 		{
-			AbiSig:  "submitProposal(bytes,bytes)",
-			Execute: c.SubmitProposal,
+			AbiSig:      "submitProposal(bytes,bytes)",
+			Execute:     c.SubmitProposal,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "cancelProposal(uint64)",
-			Execute: c.CancelProposal,
+			AbiSig: "submitProposal((string,string,string,(uint256,string)[],bool)," +
+				"(address,(uint256,string)[])[],(address,(uint256,string)[])[],(string,bytes)[])",
+			Execute:     c.SubmitTypedProposal,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "vote(uint64,int32,string)",
-			Execute: c.Vote,
+			AbiSig:      "deposit(uint64,(uint256,string)[])",
+			Execute:     c.Deposit,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "voteWeighted(uint64,(int32,string)[],string)",
-			Execute: c.VoteWeighted,
+			AbiSig:      "cancelProposal(uint64)",
+			Execute:     c.CancelProposal,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "getProposal(uint64)",
-			Execute: c.GetProposal,
+			AbiSig:      "vote(uint64,int32,string)",
+			Execute:     c.Vote,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "getProposals(int32)",
-			Execute: c.GetProposals,
+			AbiSig:      "voteWeighted(uint64,(int32,string)[],string)",
+			Execute:     c.VoteWeighted,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getProposal(uint64)",
+			Execute:     c.GetProposal,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getProposals(int32)",
+			Execute:     c.GetProposals,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getDeposits(uint64,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetDeposits,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getTallyResult(uint64)",
+			Execute:     c.GetTallyResult,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getVote(uint64,address)",
+			Execute:     c.GetVote,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getVotes(uint64,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetVotes,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getParams()",
+			Execute:     c.GetParams,
+			RequiredGas: queryGas,
 		},
 	}
 }
@@ -124,6 +183,71 @@ func (c *Contract) SubmitProposal(
 	return c.submitProposalHelper(ctx, proposalBz, []*codectypes.Any{message})
 }
 
+// SubmitTypedProposal is the method for the typed `submitProposal` overload of the governance
+// precompile contract. The caller is the proposer.
+func (c *Contract) SubmitTypedProposal(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposal, err := proposalDetailsFromInput(args[0])
+	if err != nil {
+		return nil, err
+	}
+	proposal.Proposer = cosmlib.AddressToAccAddress(caller).String()
+
+	sends, err := bankSendsFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+	spends, err := communityPoolSpendsFromInput(args[2])
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := c.messagesFromInput(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range append(append(sends, spends...), msgs...) {
+		var anyMsg *codectypes.Any
+		if anyMsg, err = codectypes.NewAnyWithValue(msg); err != nil {
+			return nil, err
+		}
+		proposal.Messages = append(proposal.Messages, anyMsg)
+	}
+
+	res, err := c.msgServer.SubmitProposal(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	return []any{res.ProposalId}, nil
+}
+
+// Deposit is the method for the `deposit` method of the governance precompile contract.
+func (c *Contract) Deposit(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposalID, ok := utils.GetAs[uint64](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+	amount, err := cosmlib.ExtractCoinsFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.depositHelper(ctx, cosmlib.AddressToAccAddress(caller), proposalID, amount)
+}
+
 // CancelProposal is the method for the `cancelProposal` method of the governance precompile contract.
 func (c *Contract) CancelProposal(
 	ctx context.Context,
@@ -227,6 +351,99 @@ func (c *Contract) GetProposals(
 	return c.getProposalsHelper(ctx, proposalStatus)
 }
 
+// GetDeposits is the method for the `getDeposits` method of the governance precompile contract.
+func (c *Contract) GetDeposits(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposalID, ok := utils.GetAs[uint64](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getDepositsHelper(ctx, proposalID, pageReq)
+}
+
+// GetTallyResult is the method for the `getTallyResult` method of the governance precompile
+// contract.
+func (c *Contract) GetTallyResult(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposalID, ok := utils.GetAs[uint64](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+
+	return c.getTallyResultHelper(ctx, proposalID)
+}
+
+// GetVote is the method for the `getVote` method of the governance precompile contract.
+func (c *Contract) GetVote(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposalID, ok := utils.GetAs[uint64](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+	voter, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getVoteHelper(ctx, proposalID, cosmlib.AddressToAccAddress(voter))
+}
+
+// GetVotes is the method for the `getVotes` method of the governance precompile contract.
+func (c *Contract) GetVotes(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	proposalID, ok := utils.GetAs[uint64](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidUint64
+	}
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getVotesHelper(ctx, proposalID, pageReq)
+}
+
+// GetParams is the method for the `getParams` method of the governance precompile contract.
+func (c *Contract) GetParams(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	return c.getParamsHelper(ctx)
+}
+
 // unmarshalMsgAndReturnAny unmarshals `[]byte` into a `codectypes.Any` message.
 // TODO: This is a temporary solution until we have a better way to unmarshal messages.
 func unmarshalMsgAndReturnAny(bz []byte) (*codectypes.Any, error) {
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package governance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	governancekeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	governancetypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	precomtest "pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+func BenchmarkGetProposals(b *testing.B) {
+	ctx, _, gk := precomtest.Setup(gomock.NewController(b), cosmlib.AddressToAccAddress(testutil.Alice))
+	contract := utils.MustGetAs[*Contract](NewPrecompileContract(
+		governancekeeper.NewMsgServerImpl(gk),
+		gk,
+		codectypes.NewInterfaceRegistry(),
+	))
+	precomtest.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "getProposals", int32(v1.StatusNil),
+	)
+}
+
+func BenchmarkSubmitProposal(b *testing.B) {
+	caller := cosmlib.AddressToAccAddress(testutil.Alice)
+	ctx, bk, gk := precomtest.Setup(gomock.NewController(b), caller)
+	contract := utils.MustGetAs[*Contract](NewPrecompileContract(
+		governancekeeper.NewMsgServerImpl(gk),
+		gk,
+		codectypes.NewInterfaceRegistry(),
+	))
+
+	initDeposit := sdk.NewCoins(sdk.NewInt64Coin("afury", 100))
+	govAcct := gk.GetGovernanceAccount(ctx).GetAddress()
+	if err := cosmlib.MintCoinsToAddress(
+		ctx, bk, governancetypes.ModuleName,
+		cosmlib.AccAddressToEthAddress(govAcct), "afury", big.NewInt(100),
+	); err != nil {
+		b.Fatal(err)
+	}
+	msgBz, err := (&banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   caller.String(),
+		Amount:      initDeposit,
+	}).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	proposalBz, err := (&v1.MsgSubmitProposal{
+		InitialDeposit: initDeposit,
+		Proposer:       caller.String(),
+		Metadata:       "metadata",
+		Title:          "title",
+		Summary:        "summary",
+	}).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	precomtest.BenchmarkMethod(
+		b, ctx, nil, contract, testutil.Alice, "submitProposal", proposalBz, msgBz,
+	)
+}
@@ -32,8 +32,11 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	governancekeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	governancetypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -63,6 +66,7 @@ var _ = Describe("Governance Precompile", func() {
 		caller   sdk.AccAddress
 		mockCtrl *gomock.Controller
 		contract *Contract
+		pageReq  any
 	)
 
 	BeforeEach(func() {
@@ -73,7 +77,18 @@ var _ = Describe("Governance Precompile", func() {
 		contract = utils.MustGetAs[*Contract](NewPrecompileContract(
 			governancekeeper.NewMsgServerImpl(gk),
 			gk,
+			cosmostestutil.MakeTestEncodingConfig(
+				gov.AppModuleBasic{},
+				bank.AppModuleBasic{},
+			).InterfaceRegistry,
 		))
+		pageReq = struct {
+			Key        []byte `json:"key"`
+			Offset     uint64 `json:"offset"`
+			Limit      uint64 `json:"limit"`
+			CountTotal bool   `json:"countTotal"`
+			Reverse    bool   `json:"reverse"`
+		}{Limit: 10}
 	})
 
 	AfterEach(func() {
@@ -81,7 +96,7 @@ var _ = Describe("Governance Precompile", func() {
 	})
 
 	It("Should have precompile tests and custom value decoders", func() {
-		Expect(contract.PrecompileMethods()).To(HaveLen(6))
+		Expect(contract.PrecompileMethods()).To(HaveLen(13))
 		Expect(contract.CustomValueDecoders()).ToNot(BeNil())
 	})
 
@@ -188,6 +203,170 @@ var _ = Describe("Governance Precompile", func() {
 		})
 	})
 
+	When("Submitting a typed proposal", func() {
+		var (
+			details  any
+			sends    any
+			spends   any
+			messages any
+			govAcct  sdk.AccAddress
+		)
+
+		BeforeEach(func() {
+			govAcct = gk.GetGovernanceAccount(ctx).GetAddress()
+			amount := utils.MustGetAs[evmCoins](
+				cosmlib.SdkCoinsToUnnamedCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 100))),
+			)
+			details = struct {
+				Title          string   `json:"title"`
+				Summary        string   `json:"summary"`
+				Metadata       string   `json:"metadata"`
+				InitialDeposit evmCoins `json:"initialDeposit"`
+				Expedited      bool     `json:"expedited"`
+			}{"title", "summary", "metadata", amount, false}
+			sends = []struct {
+				ToAddress common.Address `json:"toAddress"`
+				Amount    evmCoins       `json:"amount"`
+			}{{testutil.Bob, amount}}
+			spends = []struct {
+				Recipient common.Address `json:"recipient"`
+				Amount    evmCoins       `json:"amount"`
+			}{}
+
+			msgBz, err := banktypes.NewMsgSend(
+				govAcct, cosmlib.AddressToAccAddress(testutil.Bob),
+				sdk.NewCoins(sdk.NewInt64Coin("afury", 50)),
+			).Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			messages = []struct {
+				TypeUrl string `json:"typeUrl"` //nolint:revive,stylecheck // matches the ABI.
+				Value   []byte `json:"value"`
+			}{{sdk.MsgTypeURL(&banktypes.MsgSend{}), msgBz}}
+		})
+
+		It("should fail if the proposal details are of invalid type", func() {
+			res, err := contract.SubmitTypedProposal(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				"invalid",
+				sends,
+				spends,
+				messages,
+			)
+			Expect(err).To(MatchError(ErrInvalidProposalDetails))
+			Expect(res).To(BeNil())
+		})
+
+		It("should fail if a message type URL is unknown", func() {
+			res, err := contract.SubmitTypedProposal(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				details,
+				sends,
+				spends,
+				[]struct {
+					TypeUrl string `json:"typeUrl"` //nolint:revive,stylecheck // matches the ABI.
+					Value   []byte `json:"value"`
+				}{{"/invalid.MsgInvalid", nil}},
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+
+		It("should convert community pool spends", func() {
+			msgs, err := communityPoolSpendsFromInput([]struct {
+				Recipient common.Address `json:"recipient"`
+				Amount    evmCoins       `json:"amount"`
+			}{{testutil.Bob, nil}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(msgs).To(HaveLen(1))
+			Expect(msgs[0].GetSigners()).To(Equal([]sdk.AccAddress{govAcct}))
+		})
+
+		It("should succeed and accept deposits", func() {
+			res, err := contract.SubmitTypedProposal(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				details,
+				sends,
+				spends,
+				messages,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			proposalID := utils.MustGetAs[uint64](res[0])
+
+			proposal, found := gk.GetProposal(ctx, proposalID)
+			Expect(found).To(BeTrue())
+			Expect(proposal.Proposer).To(Equal(caller.String()))
+			Expect(proposal.Messages).To(HaveLen(2))
+
+			res, err = contract.Deposit(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				proposalID,
+				cosmlib.SdkCoinsToUnnamedCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 10))),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{true}))
+
+			res, err = contract.GetDeposits(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				proposalID,
+				pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			deposits := utils.MustGetAs[[]generated.IGovernanceModuleDeposit](res[0])
+			Expect(deposits).To(HaveLen(1))
+			Expect(deposits[0].Depositor).To(Equal(cosmlib.AccAddressToEthAddress(caller)))
+			Expect(deposits[0].Amount[0].Amount).To(Equal(big.NewInt(110)))
+		})
+	})
+
+	When("Depositing to a proposal", func() {
+		It("should fail if the proposal ID is of invalid type", func() {
+			res, err := contract.Deposit(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				"invalid",
+				cosmlib.SdkCoinsToUnnamedCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 10))),
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidUint64))
+			Expect(res).To(BeNil())
+		})
+		It("should fail if the proposal does not exist", func() {
+			res, err := contract.Deposit(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				uint64(100),
+				cosmlib.SdkCoinsToUnnamedCoins(sdk.NewCoins(sdk.NewInt64Coin("afury", 10))),
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
 	When("Canceling a proposal", func() {
 		It("should fail if the proposal ID is invalid", func() {
 			res, err := contract.CancelProposal(
@@ -313,6 +492,41 @@ var _ = Describe("Governance Precompile", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).ToNot(BeNil())
 		})
+		It("should get the vote", func() {
+			_, err := contract.Vote(
+				ctx, nil, cosmlib.AccAddressToEthAddress(caller), big.NewInt(0), false,
+				uint64(1), int32(v1.OptionYes), "metadata",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.GetVote(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				uint64(1),
+				cosmlib.AccAddressToEthAddress(caller),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vote := utils.MustGetAs[generated.IGovernanceModuleVote](res[0])
+			Expect(vote.Voter).To(Equal(cosmlib.AccAddressToEthAddress(caller)))
+			Expect(vote.Options).To(HaveLen(1))
+			Expect(vote.Options[0].VoteOption).To(Equal(int32(v1.OptionYes)))
+			Expect(vote.Metadata).To(Equal("metadata"))
+
+			res, err = contract.GetVotes(
+				ctx,
+				nil,
+				cosmlib.AccAddressToEthAddress(caller),
+				big.NewInt(0),
+				false,
+				uint64(1),
+				pageReq,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(utils.MustGetAs[[]generated.IGovernanceModuleVote](res[0])).To(HaveLen(1))
+		})
 
 		When("Voting Weight", func() {
 			It("should fail if the proposal ID is of invalid type", func() {
@@ -458,6 +672,51 @@ var _ = Describe("Governance Precompile", func() {
 					Expect(res).To(HaveLen(1))
 				})
 			})
+			When("GetTallyResult", func() {
+				It("should fail if the proposal ID is of invalid type", func() {
+					res, err := contract.GetTallyResult(
+						ctx,
+						nil,
+						cosmlib.AccAddressToEthAddress(caller),
+						big.NewInt(0),
+						false,
+						"invalid",
+					)
+					Expect(err).To(MatchError(precompile.ErrInvalidUint64))
+					Expect(res).To(BeNil())
+				})
+				It("should get the tally result", func() {
+					res, err := contract.GetTallyResult(
+						ctx,
+						nil,
+						cosmlib.AccAddressToEthAddress(caller),
+						big.NewInt(0),
+						false,
+						uint64(2),
+					)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(HaveLen(1))
+				})
+			})
+			When("GetParams", func() {
+				It("should get the params", func() {
+					res, err := contract.GetParams(
+						ctx,
+						nil,
+						cosmlib.AccAddressToEthAddress(caller),
+						big.NewInt(0),
+						false,
+					)
+					Expect(err).ToNot(HaveOccurred())
+					params := utils.MustGetAs[generated.IGovernanceModuleParams](res[0])
+					defaultParams := v1.DefaultParams()
+					Expect(params.Quorum).To(Equal(defaultParams.Quorum))
+					Expect(params.VotingPeriod).To(Equal(
+						uint64(defaultParams.VotingPeriod.Seconds()),
+					))
+					Expect(params.MinDeposit).To(Equal(coinsToOutput(defaultParams.MinDeposit)))
+				})
+			})
 			When("GetProposals", func() {
 				BeforeEach(func() {
 					// Not filled proposal, hence will panic the parser.
@@ -23,14 +23,29 @@ package governance
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/governance"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
+// evmCoins is the unnamed type of a `Cosmos.Coin[]` nested in an input struct.
+type evmCoins = []struct {
+	Amount *big.Int `json:"amount"`
+	Denom  string   `json:"denom"`
+}
+
 // submitProposalHelper is a helper function for the `SubmitProposal` method of the governance precompile contract.
 func (c *Contract) submitProposalHelper(
 	ctx context.Context,
@@ -52,6 +67,35 @@ func (c *Contract) submitProposalHelper(
 	return []any{res.ProposalId}, nil
 }
 
+// depositHelper is a helper function for the `Deposit` method of the governance precompile
+// contract.
+func (c *Contract) depositHelper(
+	ctx context.Context,
+	depositor sdk.AccAddress,
+	proposalID uint64,
+	amount sdk.Coins,
+) ([]any, error) {
+	// When a deposit starts the voting period, the governance module emits an additional
+	// `proposal_deposit` event without a proposal id or amount, which has no Eth log equivalent.
+	// The deposit is made on a fresh event manager and all other events are re-emitted.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	em := sdk.NewEventManager()
+	if _, err := c.msgServer.Deposit(sdkCtx.WithEventManager(em), &v1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor.String(),
+		Amount:     amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, event := range em.Events() {
+		if !isVotingPeriodStartEvent(event) {
+			sdkCtx.EventManager().EmitEvent(event)
+		}
+	}
+	return []any{true}, nil
+}
+
 // cancelProposalHelper is a helper function for the `CancelProposal` method of the governance precompile contract.
 func (c *Contract) cancelProposalHelper(
 	ctx context.Context,
@@ -145,6 +189,18 @@ func (c *Contract) getProposalsHelper(
 	return []any{proposals}, nil
 }
 
+// coinsToOutput converts `sdk.Coins` to the governance precompile's `Cosmos.Coin` ABI type.
+func coinsToOutput(coins sdk.Coins) []generated.CosmosCoin {
+	out := make([]generated.CosmosCoin, 0, len(coins))
+	for _, coin := range coins {
+		out = append(out, generated.CosmosCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+	return out
+}
+
 // transformProposalToABIProposal is a helper function to transform a `v1.Proposal`
 // to an `IGovernanceModule.Proposal`.
 func transformProposalToABIProposal(proposal v1.Proposal) generated.IGovernanceModuleProposal {
@@ -153,30 +209,298 @@ func transformProposalToABIProposal(proposal v1.Proposal) generated.IGovernanceM
 		message = append(message, msg.Value...)
 	}
 
-	totalDeposit := make([]generated.CosmosCoin, 0)
-	for _, coin := range proposal.TotalDeposit {
-		totalDeposit = append(totalDeposit, generated.CosmosCoin{
-			Denom:  coin.Denom,
-			Amount: coin.Amount.BigInt(),
-		})
+	return generated.IGovernanceModuleProposal{
+		Id:               proposal.Id,
+		Message:          message,
+		Status:           int32(proposal.Status), // Status is an alias for int32.
+		FinalTallyResult: tallyResultToOutput(proposal.FinalTallyResult),
+		SubmitTime:       uint64(proposal.SubmitTime.Unix()),
+		DepositEndTime:   uint64(proposal.DepositEndTime.Unix()),
+		TotalDeposit:     coinsToOutput(proposal.TotalDeposit),
+		Metadata:         proposal.Metadata,
+		Title:            proposal.Title,
+		Summary:          proposal.Summary,
+		Proposer:         proposal.Proposer,
 	}
+}
 
-	return generated.IGovernanceModuleProposal{
-		Id:      proposal.Id,
-		Message: message,
-		Status:  int32(proposal.Status), // Status is an alias for int32.
-		FinalTallyResult: generated.IGovernanceModuleTallyResult{
-			YesCount:        proposal.FinalTallyResult.YesCount,
-			AbstainCount:    proposal.FinalTallyResult.AbstainCount,
-			NoCount:         proposal.FinalTallyResult.NoCount,
-			NoWithVetoCount: proposal.FinalTallyResult.NoWithVetoCount,
-		},
-		SubmitTime:     uint64(proposal.SubmitTime.Unix()),
-		DepositEndTime: uint64(proposal.DepositEndTime.Unix()),
-		TotalDeposit:   totalDeposit,
-		Metadata:       proposal.Metadata,
-		Title:          proposal.Title,
-		Summary:        proposal.Summary,
-		Proposer:       proposal.Proposer,
+// getDepositsHelper is a helper function for the `GetDeposits` method of the governance precompile
+// contract.
+func (c *Contract) getDepositsHelper(
+	ctx context.Context,
+	proposalID uint64,
+	pageReq *query.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.Deposits(ctx, &v1.QueryDepositsRequest{
+		ProposalId: proposalID,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]generated.IGovernanceModuleDeposit, len(res.Deposits))
+	for i, deposit := range res.Deposits {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return nil, err
+		}
+		deposits[i] = generated.IGovernanceModuleDeposit{
+			ProposalId: deposit.ProposalId,
+			Depositor:  cosmlib.AccAddressToEthAddress(depositor),
+			Amount:     coinsToOutput(deposit.Amount),
+		}
+	}
+	return []any{deposits, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// getTallyResultHelper is a helper function for the `GetTallyResult` method of the governance
+// precompile contract.
+func (c *Contract) getTallyResultHelper(ctx context.Context, proposalID uint64) ([]any, error) {
+	res, err := c.querier.TallyResult(ctx, &v1.QueryTallyResultRequest{
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []any{tallyResultToOutput(res.Tally)}, nil
+}
+
+// getVoteHelper is a helper function for the `GetVote` method of the governance precompile
+// contract.
+func (c *Contract) getVoteHelper(
+	ctx context.Context,
+	proposalID uint64,
+	voter sdk.AccAddress,
+) ([]any, error) {
+	res, err := c.querier.Vote(ctx, &v1.QueryVoteRequest{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := voteToOutput(res.Vote)
+	if err != nil {
+		return nil, err
+	}
+	return []any{vote}, nil
+}
+
+// getVotesHelper is a helper function for the `GetVotes` method of the governance precompile
+// contract.
+func (c *Contract) getVotesHelper(
+	ctx context.Context,
+	proposalID uint64,
+	pageReq *query.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.Votes(ctx, &v1.QueryVotesRequest{
+		ProposalId: proposalID,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]generated.IGovernanceModuleVote, len(res.Votes))
+	for i, vote := range res.Votes {
+		if votes[i], err = voteToOutput(vote); err != nil {
+			return nil, err
+		}
+	}
+	return []any{votes, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// getParamsHelper is a helper function for the `GetParams` method of the governance precompile
+// contract.
+func (c *Contract) getParamsHelper(ctx context.Context) ([]any, error) {
+	res, err := c.querier.Params(ctx, &v1.QueryParamsRequest{
+		ParamsType: v1.ParamDeposit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params := res.Params
+	output := generated.IGovernanceModuleParams{
+		MinDeposit:                 coinsToOutput(params.MinDeposit),
+		Quorum:                     params.Quorum,
+		Threshold:                  params.Threshold,
+		VetoThreshold:              params.VetoThreshold,
+		MinInitialDepositRatio:     params.MinInitialDepositRatio,
+		BurnVoteQuorum:             params.BurnVoteQuorum,
+		BurnProposalDepositPrevote: params.BurnProposalDepositPrevote,
+		BurnVoteVeto:               params.BurnVoteVeto,
+	}
+	if params.MaxDepositPeriod != nil {
+		output.MaxDepositPeriod = uint64(params.MaxDepositPeriod.Seconds())
+	}
+	if params.VotingPeriod != nil {
+		output.VotingPeriod = uint64(params.VotingPeriod.Seconds())
+	}
+	return []any{output}, nil
+}
+
+// proposalDetailsFromInput converts a `IGovernanceModule.ProposalDetails` from input (of type any)
+// into a proposal without a proposer or messages.
+func proposalDetailsFromInput(input any) (*v1.MsgSubmitProposal, error) {
+	// note: we have to use an unnamed struct here, otherwise the compiler cannot cast the any
+	// type input into the generated ProposalDetails struct.
+	details, ok := utils.GetAs[struct {
+		Title          string   `json:"title"`
+		Summary        string   `json:"summary"`
+		Metadata       string   `json:"metadata"`
+		InitialDeposit evmCoins `json:"initialDeposit"`
+		Expedited      bool     `json:"expedited"`
+	}](input)
+	if !ok {
+		return nil, ErrInvalidProposalDetails
+	}
+
+	initialDeposit, err := cosmlib.ExtractCoinsFromInput(details.InitialDeposit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Metadata:       details.Metadata,
+		Title:          details.Title,
+		Summary:        details.Summary,
+		Expedited:      details.Expedited,
+	}, nil
+}
+
+// bankSendsFromInput converts `IGovernanceModule.BankSend`s from input (of type any) into bank
+// sends from the governance module account.
+func bankSendsFromInput(input any) ([]sdk.Msg, error) {
+	sends, ok := utils.GetAs[[]struct {
+		ToAddress common.Address `json:"toAddress"`
+		Amount    evmCoins       `json:"amount"`
+	}](input)
+	if !ok {
+		return nil, ErrInvalidBankSends
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgs := make([]sdk.Msg, len(sends))
+	for i, send := range sends {
+		amount, err := cosmlib.ExtractCoinsFromInput(send.Amount)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = banktypes.NewMsgSend(
+			govAddr, cosmlib.AddressToAccAddress(send.ToAddress), amount,
+		)
+	}
+	return msgs, nil
+}
+
+// communityPoolSpendsFromInput converts `IGovernanceModule.CommunityPoolSpend`s from input (of
+// type any) into community pool spends authorized by the governance module account.
+func communityPoolSpendsFromInput(input any) ([]sdk.Msg, error) {
+	spends, ok := utils.GetAs[[]struct {
+		Recipient common.Address `json:"recipient"`
+		Amount    evmCoins       `json:"amount"`
+	}](input)
+	if !ok {
+		return nil, ErrInvalidCommunityPoolSpends
+	}
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgs := make([]sdk.Msg, len(spends))
+	for i, spend := range spends {
+		amount, err := cosmlib.ExtractCoinsFromInput(spend.Amount)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = &distributiontypes.MsgCommunityPoolSpend{
+			Authority: authority,
+			Recipient: cosmlib.AddressToAccAddress(spend.Recipient).String(),
+			Amount:    amount,
+		}
+	}
+	return msgs, nil
+}
+
+// messagesFromInput decodes `IGovernanceModule.Message`s from input (of type any) into messages
+// with the interface registry.
+func (c *Contract) messagesFromInput(input any) ([]sdk.Msg, error) {
+	messages, ok := utils.GetAs[[]struct {
+		TypeUrl string `json:"typeUrl"` //nolint:revive,stylecheck // matches the ABI.
+		Value   []byte `json:"value"`
+	}](input)
+	if !ok {
+		return nil, ErrInvalidMessages
+	}
+
+	msgs := make([]sdk.Msg, len(messages))
+	for i, message := range messages {
+		if err := c.registry.UnpackAny(
+			&codectypes.Any{TypeUrl: message.TypeUrl, Value: message.Value}, &msgs[i],
+		); err != nil {
+			return nil, err
+		}
+	}
+	return msgs, nil
+}
+
+// isVotingPeriodStartEvent returns true if the event is the `proposal_deposit` event emitted by
+// the governance module when a deposit starts the voting period of a proposal.
+func isVotingPeriodStartEvent(event sdk.Event) bool {
+	if event.Type != govtypes.EventTypeProposalDeposit {
+		return false
+	}
+	for _, attr := range event.Attributes {
+		if attr.Key == govtypes.AttributeKeyVotingPeriodStart {
+			return true
+		}
+	}
+	return false
+}
+
+// voteToOutput converts a `v1.Vote` to an `IGovernanceModule.Vote`.
+func voteToOutput(vote *v1.Vote) (generated.IGovernanceModuleVote, error) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return generated.IGovernanceModuleVote{}, err
+	}
+
+	options := make([]generated.IGovernanceModuleWeightedVoteOption, len(vote.Options))
+	for i, option := range vote.Options {
+		options[i] = generated.IGovernanceModuleWeightedVoteOption{
+			VoteOption: int32(option.Option),
+			Weight:     option.Weight,
+		}
+	}
+	return generated.IGovernanceModuleVote{
+		ProposalId: vote.ProposalId,
+		Voter:      cosmlib.AccAddressToEthAddress(voter),
+		Options:    options,
+		Metadata:   vote.Metadata,
+	}, nil
+}
+
+// tallyResultToOutput converts a `v1.TallyResult` to an `IGovernanceModule.TallyResult`.
+func tallyResultToOutput(tally *v1.TallyResult) generated.IGovernanceModuleTallyResult {
+	if tally == nil {
+		return generated.IGovernanceModuleTallyResult{}
+	}
+	return generated.IGovernanceModuleTallyResult{
+		YesCount:        tally.YesCount,
+		AbstainCount:    tally.AbstainCount,
+		NoCount:         tally.NoCount,
+		NoWithVetoCount: tally.NoWithVetoCount,
+	}
+}
+
+// pageResponseToOutput converts a `query.PageResponse` to an `IGovernanceModule.PageResponse`.
+func pageResponseToOutput(pageRes *query.PageResponse) generated.IGovernanceModulePageResponse {
+	if pageRes == nil {
+		return generated.IGovernanceModulePageResponse{}
+	}
+	return generated.IGovernanceModulePageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
 	}
 }
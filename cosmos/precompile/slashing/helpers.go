@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/slashing"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+)
+
+// signingInfoToOutput converts a validator signing info to the `ISlashingModule.SigningInfo`
+// struct.
+func signingInfoToOutput(
+	info slashingtypes.ValidatorSigningInfo,
+) (generated.ISlashingModuleSigningInfo, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return generated.ISlashingModuleSigningInfo{}, err
+	}
+
+	return generated.ISlashingModuleSigningInfo{
+		ConsAddr:            cosmlib.ConsAddressToEthAddress(consAddr),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// paramsToOutput converts the slashing module params to the `ISlashingModule.Params` struct.
+func paramsToOutput(params slashingtypes.Params) generated.ISlashingModuleParams {
+	return generated.ISlashingModuleParams{
+		SignedBlocksWindow:      params.SignedBlocksWindow,
+		MinSignedPerWindow:      params.MinSignedPerWindow.BigInt(),
+		DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: params.SlashFractionDoubleSign.BigInt(),
+		SlashFractionDowntime:   params.SlashFractionDowntime.BigInt(),
+	}
+}
+
+// pageResponseToOutput converts a query.PageResponse to the `ISlashingModule.PageResponse` struct.
+func pageResponseToOutput(pageRes *query.PageResponse) generated.ISlashingModulePageResponse {
+	if pageRes == nil {
+		return generated.ISlashingModulePageResponse{}
+	}
+	return generated.ISlashingModulePageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
+	}
+}
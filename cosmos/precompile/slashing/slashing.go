@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"context"
+	"math/big"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/slashing"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in slashing_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 1900
+	// txGas is charged by the state-changing methods.
+	txGas = 5900
+)
+
+// Contract is the precompile contract for the slashing module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	msgServer   slashingtypes.MsgServer
+	queryServer slashingtypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the slashing module precompile contract. Uses
+// the slashing module's account address as the contract address. The attributes of slashing
+// module events are decoded by the default Cosmos value decoders.
+func NewPrecompileContract(
+	m slashingtypes.MsgServer,
+	q slashingtypes.QueryServer,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.SlashingModuleMetaData.ABI,
+			// Precompile Address: 0x31Af10958C444C19b6Bc71C82b07c30Cd24661f7
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(slashingtypes.ModuleName)),
+		),
+		msgServer:   m,
+		queryServer: q,
+	}
+}
+
+// PrecompileMethods implements the `ethprecompile.StatefulImpl` interface.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:      "unjail()",
+			Execute:     c.Unjail,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "getSigningInfo(address)",
+			Execute:     c.GetSigningInfo,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getSigningInfos((bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetSigningInfos,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getParams()",
+			Execute:     c.GetParams,
+			RequiredGas: queryGas,
+		},
+	}
+}
+
+// Unjail is the method for the `unjail` method of the slashing precompile contract. The caller
+// is the operator of the validator to unjail.
+func (c *Contract) Unjail(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	_, err := c.msgServer.Unjail(
+		ctx, slashingtypes.NewMsgUnjail(cosmlib.AddressToValAddress(caller)),
+	)
+	return []any{err == nil}, err
+}
+
+// GetSigningInfo is the method for the `getSigningInfo` method of the slashing precompile
+// contract.
+func (c *Contract) GetSigningInfo(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	consAddr, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	res, err := c.queryServer.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: cosmlib.AddressToConsAddress(consAddr).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := signingInfoToOutput(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+	return []any{info}, nil
+}
+
+// GetSigningInfos is the method for the `getSigningInfos` method of the slashing precompile
+// contract.
+func (c *Contract) GetSigningInfos(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryServer.SigningInfos(ctx, &slashingtypes.QuerySigningInfosRequest{
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]generated.ISlashingModuleSigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		if infos[i], err = signingInfoToOutput(info); err != nil {
+			return nil, err
+		}
+	}
+	return []any{infos, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// GetParams is the method for the `getParams` method of the slashing precompile contract.
+func (c *Contract) GetParams(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	res, err := c.queryServer.Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return []any{paramsToOutput(res.Params)}, nil
+}
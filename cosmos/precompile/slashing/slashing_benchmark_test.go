@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	slashingprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/slashing"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+// setupBenchmark returns a slashing precompile and the consensus address of a jailed validator,
+// which is operated by Alice and can be unjailed.
+func setupBenchmark(b *testing.B) (sdk.Context, *slashingprecompile.Contract, common.Address) {
+	ctx, _, _, sk := testutil.SetupMinimalKeepers()
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	encCfg := cosmostestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{})
+	k := slashingkeeper.NewKeeper(
+		encCfg.Codec, encCfg.Amino, testutil.EvmKey, sk, authtypes.NewModuleAddress("gov").String(),
+	)
+	if err := k.SetParams(ctx, slashingtypes.DefaultParams()); err != nil {
+		b.Fatal(err)
+	}
+	contract := slashingprecompile.NewPrecompileContract(slashingkeeper.NewMsgServerImpl(k), k)
+
+	valAddr := cosmlib.AddressToValAddress(testutil.Alice)
+	validator, err := stakingtypes.NewValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{},
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+	validator, shares := validator.AddTokensFromDel(sdk.NewInt(1e6))
+	validator.Jailed = true
+	sk.SetValidator(ctx, validator)
+	if err = sk.SetValidatorByConsAddr(ctx, validator); err != nil {
+		b.Fatal(err)
+	}
+	sk.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(valAddr), valAddr, shares))
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		b.Fatal(err)
+	}
+	k.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, 0, 0, time.Unix(0, 0), false, 0,
+	))
+	return ctx, contract, cosmlib.ConsAddressToEthAddress(consAddr)
+}
+
+func BenchmarkGetSigningInfo(b *testing.B) {
+	ctx, contract, consAddr := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, common.Address{}, "getSigningInfo", consAddr)
+}
+
+func BenchmarkGetParams(b *testing.B) {
+	ctx, contract, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, common.Address{}, "getParams")
+}
+
+func BenchmarkUnjail(b *testing.B) {
+	ctx, contract, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, testutil.Alice, "unjail")
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/slashing"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	slashingprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/slashing"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSlashingPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/slashing")
+}
+
+var _ = Describe("Slashing Precompile", func() {
+	var (
+		contract *slashingprecompile.Contract
+		ctx      sdk.Context
+		k        slashingkeeper.Keeper
+		consAddr sdk.ConsAddress
+		pageReq  any
+	)
+
+	BeforeEach(func() {
+		sdkCtx, _, _, sk := testutil.SetupMinimalKeepers()
+		ctx = sdkCtx
+		encCfg := cosmostestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{})
+		k = slashingkeeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.Amino,
+			testutil.EvmKey,
+			sk,
+			authtypes.NewModuleAddress("gov").String(),
+		)
+		Expect(k.SetParams(ctx, slashingtypes.DefaultParams())).To(Succeed())
+		contract = slashingprecompile.NewPrecompileContract(
+			slashingkeeper.NewMsgServerImpl(k), k,
+		)
+
+		consAddr = sdk.ConsAddress(common.BytesToAddress([]byte("validator")).Bytes())
+		pageReq = struct {
+			Key        []byte `json:"key"`
+			Offset     uint64 `json:"offset"`
+			Limit      uint64 `json:"limit"`
+			CountTotal bool   `json:"countTotal"`
+			Reverse    bool   `json:"reverse"`
+		}{Limit: 10, CountTotal: true}
+	})
+
+	It("should have the slashing module address as registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(slashingtypes.ModuleName))),
+		)
+	})
+
+	It("should match the precompile methods", func() {
+		var cAbi abi.ABI
+		Expect(cAbi.UnmarshalJSON([]byte(generated.SlashingModuleMetaData.ABI))).To(Succeed())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	It("should convert the slashing module events to logs", func() {
+		f := log.NewFactory([]ethprecompile.Registrable{contract})
+		var cAbi abi.ABI
+		Expect(cAbi.UnmarshalJSON([]byte(generated.SlashingModuleMetaData.ABI))).To(Succeed())
+
+		slash := sdk.NewEvent(
+			slashingtypes.EventTypeSlash,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyPower, "10"),
+			sdk.NewAttribute(slashingtypes.AttributeKeyReason, slashingtypes.AttributeValueDoubleSign),
+			sdk.NewAttribute(slashingtypes.AttributeKeyBurnedCoins, "500"),
+		)
+		slashLog, err := f.Build(&slash)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(slashLog.Address).To(Equal(contract.RegistryKey()))
+		Expect(slashLog.Topics).To(Equal([]common.Hash{cAbi.Events["Slash"].ID}))
+		values, err := cAbi.Events["Slash"].Inputs.Unpack(slashLog.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal([]any{
+			int64(10), slashingtypes.AttributeValueDoubleSign, big.NewInt(500),
+		}))
+
+		liveness := sdk.NewEvent(
+			slashingtypes.EventTypeLiveness,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyMissedBlocks, "3"),
+			sdk.NewAttribute(slashingtypes.AttributeKeyHeight, "100"),
+		)
+		livenessLog, err := f.Build(&liveness)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(livenessLog.Address).To(Equal(contract.RegistryKey()))
+		Expect(livenessLog.Topics).To(Equal([]common.Hash{cAbi.Events["Liveness"].ID}))
+		values, err = cAbi.Events["Liveness"].Inputs.Unpack(livenessLog.Data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal([]any{int64(3), int64(100)}))
+	})
+
+	It("should error on invalid inputs", func() {
+		_, err := contract.GetSigningInfo(
+			ctx, nil, common.Address{}, big.NewInt(0), true, "invalid",
+		)
+		Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+
+		_, err = contract.GetSigningInfos(
+			ctx, nil, common.Address{}, big.NewInt(0), true, "invalid",
+		)
+		Expect(err).To(MatchError(precompile.ErrInvalidPageRequest))
+	})
+
+	It("should fail to unjail a non-existent validator", func() {
+		res, err := contract.Unjail(ctx, nil, testutil.Alice, big.NewInt(0), false)
+		Expect(err).To(HaveOccurred())
+		Expect(res).To(Equal([]any{false}))
+	})
+
+	It("should get the params", func() {
+		res, err := contract.GetParams(ctx, nil, common.Address{}, big.NewInt(0), true)
+		Expect(err).ToNot(HaveOccurred())
+		params := utils.MustGetAs[generated.ISlashingModuleParams](res[0])
+		defaultParams := slashingtypes.DefaultParams()
+		Expect(params.SignedBlocksWindow).To(Equal(defaultParams.SignedBlocksWindow))
+		Expect(params.MinSignedPerWindow).To(Equal(defaultParams.MinSignedPerWindow.BigInt()))
+		Expect(params.DowntimeJailDuration).To(Equal(
+			int64(defaultParams.DowntimeJailDuration.Seconds()),
+		))
+	})
+
+	It("should get the signing infos", func() {
+		_, err := contract.GetSigningInfo(
+			ctx, nil, common.Address{}, big.NewInt(0), true,
+			cosmlib.ConsAddressToEthAddress(consAddr),
+		)
+		Expect(err).To(HaveOccurred())
+
+		jailedUntil := time.Unix(1000, 0).UTC()
+		k.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+			consAddr, 5, 2, jailedUntil, false, 1,
+		))
+
+		res, err := contract.GetSigningInfo(
+			ctx, nil, common.Address{}, big.NewInt(0), true,
+			cosmlib.ConsAddressToEthAddress(consAddr),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		info := utils.MustGetAs[generated.ISlashingModuleSigningInfo](res[0])
+		Expect(info).To(Equal(generated.ISlashingModuleSigningInfo{
+			ConsAddr:            cosmlib.ConsAddressToEthAddress(consAddr),
+			StartHeight:         5,
+			IndexOffset:         2,
+			JailedUntil:         1000,
+			Tombstoned:          false,
+			MissedBlocksCounter: 1,
+		}))
+
+		res, err = contract.GetSigningInfos(
+			ctx, nil, common.Address{}, big.NewInt(0), true, pageReq,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		infos := utils.MustGetAs[[]generated.ISlashingModuleSigningInfo](res[0])
+		Expect(infos).To(ConsistOf(info))
+		pageRes := utils.MustGetAs[generated.ISlashingModulePageResponse](res[1])
+		Expect(pageRes.Total).To(Equal(uint64(1)))
+	})
+})
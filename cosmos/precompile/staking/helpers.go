@@ -27,11 +27,17 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/staking"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
 // delegationHelper is the helper function for `getDelegation`.
@@ -229,6 +235,177 @@ func (c *Contract) activeValidatorsHelper(ctx context.Context) ([]any, error) {
 	return []any{addrs}, nil
 }
 
+// validatorHelper is the helper function for `getValidator`.
+func (c *Contract) validatorHelper(ctx context.Context, val sdk.ValAddress) ([]any, error) {
+	res, err := c.querier.Validator(ctx, &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: val.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := validatorToOutput(res.Validator)
+	if err != nil {
+		return nil, err
+	}
+	return []any{validator}, nil
+}
+
+// validatorsHelper is the helper function for `getValidators`.
+func (c *Contract) validatorsHelper(
+	ctx context.Context,
+	bondStatus string,
+	pageRequest *query.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+		Status:     bondStatus,
+		Pagination: pageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]generated.IStakingModuleValidator, 0, len(res.Validators))
+	for _, val := range res.Validators {
+		var validator generated.IStakingModuleValidator
+		if validator, err = validatorToOutput(val); err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+	return []any{validators, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// delegatorDelegationsHelper is the helper function for `getDelegatorDelegations`.
+func (c *Contract) delegatorDelegationsHelper(
+	ctx context.Context,
+	del sdk.AccAddress,
+	pageRequest *query.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: del.String(),
+		Pagination:    pageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := delegationsToOutput(res.DelegationResponses)
+	if err != nil {
+		return nil, err
+	}
+	return []any{delegations, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// validatorDelegationsHelper is the helper function for `getValidatorDelegations`.
+func (c *Contract) validatorDelegationsHelper(
+	ctx context.Context,
+	val sdk.ValAddress,
+	pageRequest *query.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
+		ValidatorAddr: val.String(),
+		Pagination:    pageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := delegationsToOutput(res.DelegationResponses)
+	if err != nil {
+		return nil, err
+	}
+	return []any{delegations, pageResponseToOutput(res.Pagination)}, nil
+}
+
+// paramsHelper is the helper function for `getParams`.
+func (c *Contract) paramsHelper(ctx context.Context) ([]any, error) {
+	res, err := c.querier.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{generated.IStakingModuleParams{
+		UnbondingTime:     int64(res.Params.UnbondingTime.Seconds()),
+		MaxValidators:     res.Params.MaxValidators,
+		MaxEntries:        res.Params.MaxEntries,
+		HistoricalEntries: res.Params.HistoricalEntries,
+		BondDenom:         res.Params.BondDenom,
+		MinCommissionRate: res.Params.MinCommissionRate.BigInt(),
+	}}, nil
+}
+
+// poolHelper is the helper function for `getPool`.
+func (c *Contract) poolHelper(ctx context.Context) ([]any, error) {
+	res, err := c.querier.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Pool.NotBondedTokens.BigInt(), res.Pool.BondedTokens.BigInt()}, nil
+}
+
+// createValidatorHelper is the helper function for `createValidator`. The caller is the operator
+// of the created validator and self-delegates the given amount to it.
+func (c *Contract) createValidatorHelper(
+	ctx context.Context,
+	caller common.Address,
+	description stakingtypes.Description,
+	commissionRates stakingtypes.CommissionRates,
+	minSelfDelegation *big.Int,
+	pubKey []byte,
+	amount *big.Int,
+) ([]any, error) {
+	if len(pubKey) != ed25519.PubKeySize {
+		return nil, ErrInvalidPubKey
+	}
+	denom, err := c.bondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		cosmlib.AddressToValAddress(caller),
+		&ed25519.PubKey{Key: pubKey},
+		sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)),
+		description,
+		commissionRates,
+		sdk.NewIntFromBigInt(minSelfDelegation),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.msgServer.CreateValidator(ctx, msg)
+	return []any{err == nil}, err
+}
+
+// editValidatorHelper is the helper function for `editValidator`. A negative commission rate or
+// min self delegation leaves the corresponding value of the caller's validator unchanged.
+func (c *Contract) editValidatorHelper(
+	ctx context.Context,
+	caller common.Address,
+	description stakingtypes.Description,
+	commissionRate *big.Int,
+	minSelfDelegation *big.Int,
+) ([]any, error) {
+	var newRate *sdkmath.LegacyDec
+	if commissionRate.Sign() >= 0 {
+		rate := sdkmath.LegacyNewDecFromBigIntWithPrec(commissionRate, sdkmath.LegacyPrecision)
+		newRate = &rate
+	}
+	var newMinSelfDelegation *sdkmath.Int
+	if minSelfDelegation.Sign() >= 0 {
+		minSelf := sdkmath.NewIntFromBigInt(minSelfDelegation)
+		newMinSelfDelegation = &minSelf
+	}
+
+	_, err := c.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		cosmlib.AddressToValAddress(caller), description, newRate, newMinSelfDelegation,
+	))
+	return []any{err == nil}, err
+}
+
 // bondDenom returns the bond denom from the staking module.
 func (c *Contract) bondDenom(ctx context.Context) (string, error) {
 	res, err := c.querier.Params(ctx, &stakingtypes.QueryParamsRequest{})
@@ -238,3 +415,122 @@ func (c *Contract) bondDenom(ctx context.Context) (string, error) {
 
 	return res.Params.BondDenom, nil
 }
+
+// descriptionFromInput converts a `IStakingModule.Description` from input (of type any) into a
+// validator description.
+func descriptionFromInput(input any) (stakingtypes.Description, error) {
+	// note: we have to use an unnamed struct here, otherwise the compiler cannot cast the any
+	// type input into the generated Description struct.
+	description, ok := utils.GetAs[struct {
+		Moniker         string `json:"moniker"`
+		Identity        string `json:"identity"`
+		Website         string `json:"website"`
+		SecurityContact string `json:"securityContact"`
+		Details         string `json:"details"`
+	}](input)
+	if !ok {
+		return stakingtypes.Description{}, ErrInvalidDescription
+	}
+
+	return stakingtypes.NewDescription(
+		description.Moniker,
+		description.Identity,
+		description.Website,
+		description.SecurityContact,
+		description.Details,
+	), nil
+}
+
+// commissionRatesFromInput converts a `IStakingModule.CommissionRates` from input (of type any)
+// into validator commission rates.
+func commissionRatesFromInput(input any) (stakingtypes.CommissionRates, error) {
+	rates, ok := utils.GetAs[struct {
+		Rate          *big.Int `json:"rate"`
+		MaxRate       *big.Int `json:"maxRate"`
+		MaxChangeRate *big.Int `json:"maxChangeRate"`
+	}](input)
+	if !ok {
+		return stakingtypes.CommissionRates{}, ErrInvalidCommissionRates
+	}
+
+	return stakingtypes.NewCommissionRates(
+		sdkmath.LegacyNewDecFromBigIntWithPrec(rates.Rate, sdkmath.LegacyPrecision),
+		sdkmath.LegacyNewDecFromBigIntWithPrec(rates.MaxRate, sdkmath.LegacyPrecision),
+		sdkmath.LegacyNewDecFromBigIntWithPrec(rates.MaxChangeRate, sdkmath.LegacyPrecision),
+	), nil
+}
+
+// validatorToOutput converts a staking validator to the `IStakingModule.Validator` struct.
+func validatorToOutput(val stakingtypes.Validator) (generated.IStakingModuleValidator, error) {
+	operator, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+	if err != nil {
+		return generated.IStakingModuleValidator{}, err
+	}
+	var pubKey []byte
+	if val.ConsensusPubkey != nil {
+		pubKey = val.ConsensusPubkey.Value
+	}
+
+	return generated.IStakingModuleValidator{
+		OperatorAddress: cosmlib.ValAddressToEthAddress(operator),
+		ConsensusPubkey: pubKey,
+		Jailed:          val.Jailed,
+		Status:          val.Status.String(),
+		Tokens:          val.Tokens.BigInt(),
+		DelegatorShares: val.DelegatorShares.BigInt(),
+		Description: generated.IStakingModuleDescription{
+			Moniker:         val.Description.Moniker,
+			Identity:        val.Description.Identity,
+			Website:         val.Description.Website,
+			SecurityContact: val.Description.SecurityContact,
+			Details:         val.Description.Details,
+		},
+		UnbondingHeight: val.UnbondingHeight,
+		UnbondingTime:   val.UnbondingTime.Unix(),
+		Commission: generated.IStakingModuleCommission{
+			CommissionRates: generated.IStakingModuleCommissionRates{
+				Rate:          val.Commission.Rate.BigInt(),
+				MaxRate:       val.Commission.MaxRate.BigInt(),
+				MaxChangeRate: val.Commission.MaxChangeRate.BigInt(),
+			},
+			UpdateTime: val.Commission.UpdateTime.Unix(),
+		},
+		MinSelfDelegation: val.MinSelfDelegation.BigInt(),
+	}, nil
+}
+
+// delegationsToOutput converts staking delegation responses to `IStakingModule.Delegation`
+// structs.
+func delegationsToOutput(
+	responses stakingtypes.DelegationResponses,
+) ([]generated.IStakingModuleDelegation, error) {
+	delegations := make([]generated.IStakingModuleDelegation, 0, len(responses))
+	for _, res := range responses {
+		del, err := sdk.AccAddressFromBech32(res.Delegation.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		val, err := sdk.ValAddressFromBech32(res.Delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, generated.IStakingModuleDelegation{
+			Delegator: cosmlib.AccAddressToEthAddress(del),
+			Validator: cosmlib.ValAddressToEthAddress(val),
+			Shares:    res.Delegation.Shares.BigInt(),
+			Balance:   res.Balance.Amount.BigInt(),
+		})
+	}
+	return delegations, nil
+}
+
+// pageResponseToOutput converts a query.PageResponse to the `IStakingModule.PageResponse` struct.
+func pageResponseToOutput(pageRes *query.PageResponse) generated.IStakingModulePageResponse {
+	if pageRes == nil {
+		return generated.IStakingModulePageResponse{}
+	}
+	return generated.IStakingModulePageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
+	}
+}
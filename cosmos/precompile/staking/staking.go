@@ -32,11 +32,22 @@ import (
 	generated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/precompile/staking"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/precompile"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile/log"
 	"pkg.furychain.dev/gridiron/eth/common"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
+// Static gas charged by the precompile methods, on top of their metered store access. The values
+// are the execution time of the methods measured by the benchmarks in staking_benchmark_test.go,
+// at 10ns per unit of gas, rounded up over the methods of each kind.
+const (
+	// queryGas is charged by the read-only query methods.
+	queryGas = 3200
+	// txGas is charged by the state-changing methods.
+	txGas = 11200
+)
+
 // Contract is the precompile contract for the staking module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -61,68 +72,132 @@ func NewPrecompileContract(sk *stakingkeeper.Keeper) *Contract {
 func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 	return ethprecompile.Methods{
 		{
-			AbiSig:  "getDelegation(address,address)",
-			Execute: c.GetDelegationAddrInput,
+			AbiSig:      "getDelegation(address,address)",
+			Execute:     c.GetDelegationAddrInput,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getDelegation(string,string)",
+			Execute:     c.GetDelegationStringInput,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getUnbondingDelegation(address,address)",
+			Execute:     c.GetUnbondingDelegationAddrInput,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getUnbondingDelegation(string,string)",
+			Execute:     c.GetUnbondingDelegationStringInput,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getRedelegations(address,address,address)",
+			Execute:     c.GetRedelegationsAddrInput,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "getDelegation(string,string)",
-			Execute: c.GetDelegationStringInput,
+			AbiSig:      "getRedelegations(string,string,string)",
+			Execute:     c.GetRedelegationsStringInput,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "getUnbondingDelegation(address,address)",
-			Execute: c.GetUnbondingDelegationAddrInput,
+			AbiSig:      "delegate(address,uint256)",
+			Execute:     c.DelegateAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "getUnbondingDelegation(string,string)",
-			Execute: c.GetUnbondingDelegationStringInput,
+			AbiSig:      "delegate(string,uint256)",
+			Execute:     c.DelegateStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "getRedelegations(address,address,address)",
-			Execute: c.GetRedelegationsAddrInput,
+			AbiSig:      "undelegate(address,uint256)",
+			Execute:     c.UndelegateAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "getRedelegations(string,string,string)",
-			Execute: c.GetRedelegationsStringInput,
+			AbiSig:      "undelegate(string,uint256)",
+			Execute:     c.UndelegateStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "delegate(address,uint256)",
-			Execute: c.DelegateAddrInput,
+			AbiSig:      "beginRedelegate(address,address,uint256)",
+			Execute:     c.BeginRedelegateAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "delegate(string,uint256)",
-			Execute: c.DelegateStringInput,
+			AbiSig:      "beginRedelegate(string,string,uint256)",
+			Execute:     c.BeginRedelegateStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "undelegate(address,uint256)",
-			Execute: c.UndelegateAddrInput,
+			AbiSig:      "cancelUnbondingDelegation(address,uint256,int64)",
+			Execute:     c.CancelUnbondingDelegationAddrInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "undelegate(string,uint256)",
-			Execute: c.UndelegateStringInput,
+			AbiSig:      "cancelUnbondingDelegation(string,uint256,int64)",
+			Execute:     c.CancelUnbondingDelegationStringInput,
+			RequiredGas: txGas,
 		},
 		{
-			AbiSig:  "beginRedelegate(address,address,uint256)",
-			Execute: c.BeginRedelegateAddrInput,
+			AbiSig:      "getActiveValidators()",
+			Execute:     c.GetActiveValidators,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "beginRedelegate(string,string,uint256)",
-			Execute: c.BeginRedelegateStringInput,
+			AbiSig:      "getValidator(address)",
+			Execute:     c.GetValidator,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "cancelUnbondingDelegation(address,uint256,int64)",
-			Execute: c.CancelUnbondingDelegationAddrInput,
+			AbiSig:      "getValidators(string,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetValidators,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "cancelUnbondingDelegation(string,uint256,int64)",
-			Execute: c.CancelUnbondingDelegationStringInput,
+			AbiSig:      "getDelegatorDelegations(address,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetDelegatorDelegations,
+			RequiredGas: queryGas,
 		},
 		{
-			AbiSig:  "getActiveValidators()",
-			Execute: c.GetActiveValidators,
+			AbiSig:      "getValidatorDelegations(address,(bytes,uint64,uint64,bool,bool))",
+			Execute:     c.GetValidatorDelegations,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getParams()",
+			Execute:     c.GetParams,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig:      "getPool()",
+			Execute:     c.GetPool,
+			RequiredGas: queryGas,
+		},
+		{
+			AbiSig: "createValidator((string,string,string,string,string)," +
+				"(uint256,uint256,uint256),uint256,bytes,uint256)",
+			Execute:     c.CreateValidator,
+			RequiredGas: txGas,
+		},
+		{
+			AbiSig:      "editValidator((string,string,string,string,string),int256,int256)",
+			Execute:     c.EditValidator,
+			RequiredGas: txGas,
 		},
 	}
 }
 
+// CustomValueDecoders implements StatefulImpl.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		stakingtypes.AttributeKeyCommissionRate:    log.ReturnStringAsIs,
+		stakingtypes.AttributeKeyMinSelfDelegation: log.ReturnStringAsIs,
+	}
+}
+
 // GetDelegationAddrInput implements `getDelegation(address)` method.
 func (c *Contract) GetDelegationAddrInput(
 	ctx context.Context,
@@ -519,3 +594,168 @@ func (c *Contract) GetActiveValidators(
 ) ([]any, error) {
 	return c.activeValidatorsHelper(ctx)
 }
+
+// GetValidator implements the `getValidator(address)` method.
+func (c *Contract) GetValidator(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	val, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.validatorHelper(ctx, cosmlib.AddressToValAddress(val))
+}
+
+// GetValidators implements the `getValidators(string,PageRequest)` method.
+func (c *Contract) GetValidators(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	status, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	pageRequest, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.validatorsHelper(ctx, status, pageRequest)
+}
+
+// GetDelegatorDelegations implements the `getDelegatorDelegations(address,PageRequest)` method.
+func (c *Contract) GetDelegatorDelegations(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	del, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	pageRequest, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.delegatorDelegationsHelper(ctx, cosmlib.AddressToAccAddress(del), pageRequest)
+}
+
+// GetValidatorDelegations implements the `getValidatorDelegations(address,PageRequest)` method.
+func (c *Contract) GetValidatorDelegations(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	val, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	pageRequest, err := cosmlib.ExtractPageRequestFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return c.validatorDelegationsHelper(ctx, cosmlib.AddressToValAddress(val), pageRequest)
+}
+
+// GetParams implements the `getParams()` method.
+func (c *Contract) GetParams(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	return c.paramsHelper(ctx)
+}
+
+// GetPool implements the `getPool()` method.
+func (c *Contract) GetPool(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	return c.poolHelper(ctx)
+}
+
+// CreateValidator implements the
+// `createValidator(Description,CommissionRates,uint256,bytes,uint256)` method.
+func (c *Contract) CreateValidator(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	description, err := descriptionFromInput(args[0])
+	if err != nil {
+		return nil, err
+	}
+	commissionRates, err := commissionRatesFromInput(args[1])
+	if err != nil {
+		return nil, err
+	}
+	minSelfDelegation, ok := utils.GetAs[*big.Int](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+	pubKey, ok := utils.GetAs[[]byte](args[3])
+	if !ok {
+		return nil, precompile.ErrInvalidBytes
+	}
+	amount, ok := utils.GetAs[*big.Int](args[4])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	return c.createValidatorHelper(
+		ctx, caller, description, commissionRates, minSelfDelegation, pubKey, amount,
+	)
+}
+
+// EditValidator implements the `editValidator(Description,int256,int256)` method.
+func (c *Contract) EditValidator(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	description, err := descriptionFromInput(args[0])
+	if err != nil {
+		return nil, err
+	}
+	commissionRate, ok := utils.GetAs[*big.Int](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+	minSelfDelegation, ok := utils.GetAs[*big.Int](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	return c.editValidatorHelper(ctx, caller, description, commissionRate, minSelfDelegation)
+}
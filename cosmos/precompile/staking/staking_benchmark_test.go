@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/precompile/test"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// setupBenchmark returns a staking precompile with a bonded validator and a funded delegator,
+// which has delegated to the validator.
+func setupBenchmark(b *testing.B) (sdk.Context, *Contract, common.Address, common.Address) {
+	ctx, _, bk, sk := testutil.SetupMinimalKeepers()
+	contract := utils.MustGetAs[*Contract](NewPrecompileContract(&sk))
+
+	params := stakingtypes.DefaultParams()
+	params.BondDenom = "stake"
+	if err := sk.SetParams(ctx, params); err != nil {
+		b.Fatal(err)
+	}
+
+	delegates, validators := createValAddrs(1)
+	del, val := delegates[0], validators[0]
+	validator, err := NewValidator(val, PKs[0])
+	if err != nil {
+		b.Fatal(err)
+	}
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(1e18))
+	// bonding the validator moves its tokens out of the not bonded pool
+	bonded := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1e18)))
+	if err = bk.MintCoins(ctx, stakingtypes.ModuleName, bonded); err != nil {
+		b.Fatal(err)
+	}
+	if err = bk.SendCoinsFromModuleToModule(
+		ctx, stakingtypes.ModuleName, stakingtypes.NotBondedPoolName, bonded,
+	); err != nil {
+		b.Fatal(err)
+	}
+	stakingkeeper.TestingUpdateValidator(&sk, ctx, validator, true)
+	sk.SetDelegation(ctx, stakingtypes.NewDelegation(del, val, math.LegacyNewDec(1e9)))
+
+	if err = FundAccount(ctx, bk, del, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1e18)))); err != nil {
+		b.Fatal(err)
+	}
+
+	return ctx, contract, cosmlib.AccAddressToEthAddress(del), cosmlib.ValAddressToEthAddress(val)
+}
+
+func BenchmarkGetDelegation(b *testing.B) {
+	ctx, contract, del, val := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, del, "getDelegation", del, val)
+}
+
+func BenchmarkGetActiveValidators(b *testing.B) {
+	ctx, contract, del, _ := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, del, "getActiveValidators")
+}
+
+func BenchmarkDelegate(b *testing.B) {
+	ctx, contract, del, val := setupBenchmark(b)
+	test.BenchmarkMethod(b, ctx, nil, contract, del, "delegate", val, big.NewInt(1e6))
+}
@@ -108,8 +108,9 @@ var _ = Describe("Staking", func() {
 	})
 
 	When("CustomValueDecoders", func() {
-		It("should be a no-op", func() {
-			Expect(contract.CustomValueDecoders()).To(BeNil())
+		It("should decode the edit validator event attributes", func() {
+			Expect(contract.CustomValueDecoders()).To(HaveKey(stakingtypes.AttributeKeyCommissionRate))
+			Expect(contract.CustomValueDecoders()).To(HaveKey(stakingtypes.AttributeKeyMinSelfDelegation))
 		})
 	})
 
@@ -1035,6 +1036,198 @@ var _ = Describe("Staking", func() {
 				Expect(addrs[0]).To(Equal(cosmlib.ValAddressToEthAddress(val)))
 			})
 		})
+
+		When("Querying validators", func() {
+			var pageReq any
+
+			BeforeEach(func() {
+				pageReq = struct {
+					Key        []byte `json:"key"`
+					Offset     uint64 `json:"offset"`
+					Limit      uint64 `json:"limit"`
+					CountTotal bool   `json:"countTotal"`
+					Reverse    bool   `json:"reverse"`
+				}{Limit: 10, CountTotal: true}
+			})
+
+			It("should fail on invalid inputs", func() {
+				_, err := contract.GetValidator(ctx, nil, caller, big.NewInt(0), true, "0x")
+				Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+
+				_, err = contract.GetValidators(ctx, nil, caller, big.NewInt(0), true, "", "invalid")
+				Expect(err).To(MatchError(precompile.ErrInvalidPageRequest))
+			})
+
+			It("gets a validator", func() {
+				res, err := contract.GetValidator(
+					ctx, nil, caller, big.NewInt(0), true, cosmlib.ValAddressToEthAddress(val),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				validatorOut := utils.MustGetAs[generated.IStakingModuleValidator](res[0])
+				Expect(validatorOut.OperatorAddress).To(Equal(cosmlib.ValAddressToEthAddress(val)))
+				Expect(validatorOut.Tokens).To(Equal(validator.Tokens.BigInt()))
+				Expect(validatorOut.DelegatorShares).To(Equal(validator.DelegatorShares.BigInt()))
+				Expect(validatorOut.Status).To(Equal(validator.Status.String()))
+				Expect(validatorOut.Jailed).To(BeFalse())
+				Expect(validatorOut.ConsensusPubkey).ToNot(BeEmpty())
+			})
+
+			It("gets validators filtered by status", func() {
+				res, err := contract.GetValidators(
+					ctx, nil, caller, big.NewInt(0), true, "", pageReq,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(utils.MustGetAs[[]generated.IStakingModuleValidator](res[0])).To(HaveLen(2))
+				pageRes := utils.MustGetAs[generated.IStakingModulePageResponse](res[1])
+				Expect(pageRes.Total).To(Equal(uint64(2)))
+
+				validator.Status = stakingtypes.Unbonding
+				sk.SetValidator(ctx, validator)
+				res, err = contract.GetValidators(
+					ctx, nil, caller, big.NewInt(0), true,
+					stakingtypes.BondStatusUnbonding, pageReq,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				validators := utils.MustGetAs[[]generated.IStakingModuleValidator](res[0])
+				Expect(validators).To(HaveLen(1))
+				Expect(validators[0].OperatorAddress).To(Equal(cosmlib.ValAddressToEthAddress(val)))
+			})
+
+			It("gets delegator and validator delegations", func() {
+				res, err := contract.GetDelegatorDelegations(
+					ctx, nil, caller, big.NewInt(0), true, caller, pageReq,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				delegations := utils.MustGetAs[[]generated.IStakingModuleDelegation](res[0])
+				Expect(delegations).To(HaveLen(1))
+				Expect(delegations[0].Delegator).To(Equal(caller))
+				Expect(delegations[0].Validator).To(Equal(cosmlib.ValAddressToEthAddress(val)))
+				Expect(delegations[0].Shares).To(Equal(math.LegacyNewDec(9).BigInt()))
+
+				res, err = contract.GetValidatorDelegations(
+					ctx, nil, caller, big.NewInt(0), true, cosmlib.ValAddressToEthAddress(val), pageReq,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				delegations = utils.MustGetAs[[]generated.IStakingModuleDelegation](res[0])
+				Expect(delegations).To(HaveLen(1))
+				Expect(delegations[0].Delegator).To(Equal(caller))
+			})
+
+			It("gets the params and pool", func() {
+				res, err := contract.GetParams(ctx, nil, caller, big.NewInt(0), true)
+				Expect(err).ToNot(HaveOccurred())
+				params := utils.MustGetAs[generated.IStakingModuleParams](res[0])
+				Expect(params.BondDenom).To(Equal("stake"))
+				Expect(params.MaxValidators).To(Equal(stakingtypes.DefaultMaxValidators))
+				Expect(params.UnbondingTime).To(
+					Equal(int64(stakingtypes.DefaultUnbondingTime.Seconds())),
+				)
+
+				res, err = contract.GetPool(ctx, nil, caller, big.NewInt(0), true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(2))
+				pool := sk.GetBondedPool(ctx)
+				Expect(res[1]).To(Equal(bk.GetBalance(ctx, pool.GetAddress(), "stake").Amount.BigInt()))
+			})
+		})
+
+		When("Managing a validator", func() {
+			var (
+				operator    sdk.AccAddress
+				opCaller    common.Address
+				description any
+				rates       any
+				amount      *big.Int
+			)
+
+			BeforeEach(func() {
+				description = struct {
+					Moniker         string `json:"moniker"`
+					Identity        string `json:"identity"`
+					Website         string `json:"website"`
+					SecurityContact string `json:"securityContact"`
+					Details         string `json:"details"`
+				}{Moniker: "contract validator"}
+				rates = struct {
+					Rate          *big.Int `json:"rate"`
+					MaxRate       *big.Int `json:"maxRate"`
+					MaxChangeRate *big.Int `json:"maxChangeRate"`
+				}{
+					Rate:          math.LegacyNewDecWithPrec(1, 1).BigInt(),
+					MaxRate:       math.LegacyNewDecWithPrec(2, 1).BigInt(),
+					MaxChangeRate: math.LegacyNewDecWithPrec(1, 2).BigInt(),
+				}
+				amount = big.NewInt(1000000)
+				operator = simtestutil.CreateIncrementalAccounts(3)[2]
+				opCaller = cosmlib.AccAddressToEthAddress(operator)
+				Expect(FundAccount(
+					ctx, bk, operator, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromBigInt(amount))),
+				)).To(Succeed())
+			})
+
+			It("should fail on invalid inputs", func() {
+				_, err := contract.CreateValidator(
+					ctx, nil, opCaller, big.NewInt(0), false,
+					"invalid", rates, big.NewInt(1), PKs[2].Bytes(), amount,
+				)
+				Expect(err).To(MatchError(ErrInvalidDescription))
+
+				_, err = contract.CreateValidator(
+					ctx, nil, opCaller, big.NewInt(0), false,
+					description, "invalid", big.NewInt(1), PKs[2].Bytes(), amount,
+				)
+				Expect(err).To(MatchError(ErrInvalidCommissionRates))
+
+				_, err = contract.CreateValidator(
+					ctx, nil, opCaller, big.NewInt(0), false,
+					description, rates, big.NewInt(1), []byte{1, 2, 3}, amount,
+				)
+				Expect(err).To(MatchError(ErrInvalidPubKey))
+			})
+
+			It("creates and edits a validator", func() {
+				res, err := contract.CreateValidator(
+					ctx, nil, opCaller, big.NewInt(0), false,
+					description, rates, big.NewInt(1), PKs[2].Bytes(), amount,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(BeTrue())
+
+				created, found := sk.GetValidator(ctx, sdk.ValAddress(operator))
+				Expect(found).To(BeTrue())
+				Expect(created.Description.Moniker).To(Equal("contract validator"))
+				Expect(created.Tokens.BigInt()).To(Equal(amount))
+				Expect(created.Commission.Rate.Equal(math.LegacyNewDecWithPrec(1, 1))).To(BeTrue())
+
+				res, err = contract.EditValidator(
+					ctx, nil, opCaller, big.NewInt(0), false,
+					struct {
+						Moniker         string `json:"moniker"`
+						Identity        string `json:"identity"`
+						Website         string `json:"website"`
+						SecurityContact string `json:"securityContact"`
+						Details         string `json:"details"`
+					}{
+						Moniker:         "renamed",
+						Identity:        stakingtypes.DoNotModifyDesc,
+						Website:         stakingtypes.DoNotModifyDesc,
+						SecurityContact: stakingtypes.DoNotModifyDesc,
+						Details:         "operated by a contract",
+					},
+					big.NewInt(-1),
+					big.NewInt(-1),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0]).To(BeTrue())
+
+				edited, found := sk.GetValidator(ctx, sdk.ValAddress(operator))
+				Expect(found).To(BeTrue())
+				Expect(edited.Description.Moniker).To(Equal("renamed"))
+				Expect(edited.Description.Details).To(Equal("operated by a contract"))
+				Expect(edited.Commission.Rate.Equal(created.Commission.Rate)).To(BeTrue())
+				Expect(edited.MinSelfDelegation.Equal(created.MinSelfDelegation)).To(BeTrue())
+			})
+		})
 	})
 })
 
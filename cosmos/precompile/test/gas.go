@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+)
+
+// BenchmarkMethod benchmarks the precompile method with the given ABI method name, called with
+// args by caller, through the stateful container of the contract. The evm may be nil if the method
+// does not use it. The store accesses are charged
+// with the default x/evm precompile KV gas params and reported as the "gas/op" metric. The
+// remaining cost of the method, ABI decoding and execution, is reported as ns/op and is what the
+// `RequiredGas` of the method should cover.
+func BenchmarkMethod(
+	b *testing.B, ctx sdk.Context, evm ethprecompile.EVM, contract ethprecompile.StatefulImpl,
+	caller common.Address, method string, args ...any,
+) {
+	b.Helper()
+
+	container, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	abiMethod, ok := contract.ABIMethods()[method]
+	if !ok {
+		b.Fatalf("method %s not found", method)
+	}
+	packed, err := abiMethod.Inputs.Pack(args...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	input := append(append([]byte{}, abiMethod.ID...), packed...)
+
+	params := evmtypes.DefaultParams()
+	ctx = ctx.WithKVGasConfig(params.PrecompileKVGas.GasConfig()).
+		WithTransientKVGasConfig(params.PrecompileTransientKVGas.GasConfig())
+
+	// run each iteration on a cached context, so that every iteration starts from the same state
+	var gasUsed uint64
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gm := storetypes.NewInfiniteGasMeter()
+		cacheCtx, _ := ctx.WithGasMeter(gm).CacheContext()
+		if _, err = container.Run(cacheCtx, evm, input, caller, new(big.Int), false); err != nil {
+			b.Fatal(err)
+		}
+		gasUsed += gm.GasConsumed()
+	}
+	b.ReportMetric(float64(gasUsed)/float64(b.N), "gas/op")
+	b.ReportMetric(float64(container.RequiredGas(input)), "requiredgas")
+}
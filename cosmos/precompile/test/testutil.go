@@ -38,6 +38,7 @@ import (
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/testutil"
 	governancetypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"pkg.furychain.dev/gridiron/cosmos/lib"
 	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
@@ -104,6 +105,11 @@ func Setup(ctrl *gomock.Controller, caller sdk.AccAddress) (sdk.Context, bankkee
 	}
 	gk.SetProposalID(ctx, 1)
 
+	// Set the staking params, as the bond denom is used to tally votes.
+	if err = sk.SetParams(ctx, stakingtypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
 	// Fund the caller with some coins.
 	err = lib.MintCoinsToAddress(
 		//nolint:gomnd // magic number is fine here.
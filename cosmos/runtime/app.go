@@ -167,7 +167,11 @@ func NewGridironApp( //nolint:funlen // as defined by the sdk.
 		homePath = DefaultNodeHome
 	}
 
-	// setup evm keeper and all of its plugins.
+	// setup evm keeper and all of its plugins, building the bank ERC20 token precompiles of the
+	// denominations listed in the x/evm params.
+	app.EVMKeeper.SetDenomPrecompileFactory(
+		gridironbaseapp.BankERC20PrecompileFactory(&app.GridironBaseApp),
+	)
 	app.EVMKeeper.Setup(
 		offchainKey,
 		app.CreateQueryContext,
@@ -176,12 +180,18 @@ func NewGridironApp( //nolint:funlen // as defined by the sdk.
 		homePath+"/data/gridiron",
 	)
 
-	opt := ante.HandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		SignModeHandler: app.TxConfig().SignModeHandler(),
-		FeegrantKeeper:  app.FeeGrantKeeper,
-		SigGasConsumer:  evmante.SigVerificationGasConsumer,
+	// the erc20 keeper reads the state of ERC20 token contracts through the evm keeper.
+	app.ERC20Keeper.SetEVMKeeper(app.EVMKeeper)
+
+	opt := evmante.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: app.TxConfig().SignModeHandler(),
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SigGasConsumer:  evmante.SigVerificationGasConsumer,
+		},
+		EVMKeeper: app.EVMKeeper,
 	}
 	ch, _ := evmante.NewAnteHandler(
 		opt,
@@ -21,26 +21,40 @@
 package baseapp
 
 import (
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 
 	authprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/auth"
 	bankprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/bank"
+	bankerc20precompile "pkg.furychain.dev/gridiron/cosmos/precompile/bankerc20"
+	dispatchprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/dispatch"
 	distrprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/distribution"
 	erc20precompile "pkg.furychain.dev/gridiron/cosmos/precompile/erc20"
+	feegrantprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/feegrant"
 	govprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/governance"
+	slashingprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/slashing"
 	stakingprecompile "pkg.furychain.dev/gridiron/cosmos/precompile/staking"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 )
 
 // PrecompilesToInject returns a function that provides the initialization of the standard
 // set of precompiles.
-func PrecompilesToInject(app *GridironBaseApp, customPcs ...ethprecompile.Registrable) func() *ethprecompile.Injector {
+func PrecompilesToInject(
+	app *GridironBaseApp, customPcs ...ethprecompile.Registrable,
+) func() *ethprecompile.Injector {
 	return func() *ethprecompile.Injector {
 		// Create the precompile injector with the standard precompiles.
 		pcs := ethprecompile.NewPrecompiles([]ethprecompile.Registrable{
-			authprecompile.NewPrecompileContract(app.AuthzKeeper, app.AuthzKeeper),
+			authprecompile.NewPrecompileContract(
+				app.AuthzKeeper,
+				app.AuthzKeeper,
+				app.InterfaceRegistry(),
+				app.EVMKeeper,
+			),
 			bankprecompile.NewPrecompileContract(
 				bankkeeper.NewMsgServerImpl(app.BankKeeper),
 				app.BankKeeper,
@@ -49,12 +63,28 @@ func PrecompilesToInject(app *GridironBaseApp, customPcs ...ethprecompile.Regist
 				distrkeeper.NewMsgServerImpl(app.DistrKeeper),
 				distrkeeper.NewQuerier(app.DistrKeeper),
 			),
+			dispatchprecompile.NewPrecompileContract(
+				app.MsgServiceRouter(),
+				app.InterfaceRegistry(),
+				app.LegacyAmino(),
+				app.EVMKeeper,
+			),
 			erc20precompile.NewPrecompileContract(
 				app.BankKeeper, app.ERC20Keeper,
 			),
+			feegrantprecompile.NewPrecompileContract(
+				feegrantkeeper.NewMsgServerImpl(app.FeeGrantKeeper),
+				app.FeeGrantKeeper,
+				app.InterfaceRegistry(),
+			),
 			govprecompile.NewPrecompileContract(
 				govkeeper.NewMsgServerImpl(app.GovKeeper),
 				app.GovKeeper,
+				app.InterfaceRegistry(),
+			),
+			slashingprecompile.NewPrecompileContract(
+				slashingkeeper.NewMsgServerImpl(app.SlashingKeeper),
+				app.SlashingKeeper,
 			),
 			stakingprecompile.NewPrecompileContract(app.StakingKeeper),
 		}...)
@@ -66,3 +96,16 @@ func PrecompilesToInject(app *GridironBaseApp, customPcs ...ethprecompile.Regist
 		return pcs
 	}
 }
+
+// BankERC20PrecompileFactory returns the factory of the bank ERC20 token precompiles, which the
+// x/evm module uses to build the token precompiles of the denominations listed in its params.
+func BankERC20PrecompileFactory(app *GridironBaseApp) func(denom string) ethprecompile.Registrable {
+	return func(denom string) ethprecompile.Registrable {
+		return bankerc20precompile.NewPrecompileContract(
+			denom,
+			app.BankKeeper,
+			bankkeeper.NewMsgServerImpl(app.BankKeeper),
+			app.AuthzKeeper,
+		)
+	}
+}
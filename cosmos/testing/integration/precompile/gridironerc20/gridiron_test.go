@@ -23,8 +23,11 @@ package gridironerc20_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	cbindings "pkg.furychain.dev/gridiron/contracts/bindings/cosmos"
 	tbindings "pkg.furychain.dev/gridiron/contracts/bindings/testing"
@@ -70,6 +73,9 @@ var _ = Describe("ERC20", func() {
 				tf.GenerateTransactOpts("alice"),
 				tf.EthClient,
 				"bAKT",
+				"bAKT",
+				"bAKT",
+				uint8(0),
 			)
 			Expect(err).ToNot(HaveOccurred())
 			ExpectSuccessReceipt(tf.EthClient, tx)
@@ -111,4 +117,146 @@ var _ = Describe("ERC20", func() {
 		})
 
 	})
+
+	Describe("EIP-3009 transfer authorizations", func() {
+		var (
+			token      *cbindings.GridironERC20
+			value      = big.NewInt(10)
+			validAfter = big.NewInt(0)
+		)
+
+		BeforeEach(func() {
+			_, tx, t, err := cbindings.DeployGridironERC20(
+				tf.GenerateTransactOpts("alice"), tf.EthClient, "bATOM", "Atom", "ATOM", uint8(18),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+			token = t
+		})
+
+		// charlieBalance returns the bATOM balance of charlie, which is shared by every token.
+		charlieBalance := func() *big.Int {
+			balance, err := token.BalanceOf(nil, tf.Address("charlie"))
+			Expect(err).ToNot(HaveOccurred())
+			return balance
+		}
+
+		// signAuthorization returns the v, r and s of alice's EIP-712 signature over the given
+		// authorization under the token's domain.
+		signAuthorization := func(typehash [32]byte, fields ...[]byte) (uint8, [32]byte, [32]byte) {
+			domainSeparator, err := token.DOMAINSEPARATOR(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			structHash := crypto.Keccak256(append([][]byte{typehash[:]}, fields...)...)
+			digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator[:], structHash)
+			sig, err := crypto.Sign(digest, tf.PrivKey("alice"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var r, s [32]byte
+			copy(r[:], sig[:32])
+			copy(s[:], sig[32:64])
+			return sig[64] + 27, r, s //nolint:gomnd // ethereum v offset.
+		}
+
+		signTransfer := func(
+			to common.Address, validBefore *big.Int, nonce [32]byte,
+		) (uint8, [32]byte, [32]byte) {
+			typehash, err := token.TRANSFERWITHAUTHORIZATIONTYPEHASH(nil)
+			Expect(err).ToNot(HaveOccurred())
+			return signAuthorization(
+				typehash,
+				common.LeftPadBytes(tf.Address("alice").Bytes(), 32),
+				common.LeftPadBytes(to.Bytes(), 32),
+				common.LeftPadBytes(value.Bytes(), 32),
+				common.LeftPadBytes(validAfter.Bytes(), 32),
+				common.LeftPadBytes(validBefore.Bytes(), 32),
+				nonce[:],
+			)
+		}
+
+		// failingOpts skips gas estimation, so that reverting transactions are mined.
+		failingOpts := func(name string) *bind.TransactOpts {
+			txr := tf.GenerateTransactOpts(name)
+			txr.GasLimit = 1_000_000
+			return txr
+		}
+
+		It("should transfer once and refuse to replay the authorization", func() {
+			nonce := [32]byte{1}
+			validBefore := big.NewInt(time.Now().Add(time.Hour).Unix())
+			v, r, s := signTransfer(tf.Address("charlie"), validBefore, nonce)
+			before := charlieBalance()
+
+			tx, err := token.TransferWithAuthorization(
+				tf.GenerateTransactOpts("bob"), tf.Address("alice"), tf.Address("charlie"),
+				value, validAfter, validBefore, nonce, v, r, s,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+
+			Expect(charlieBalance()).To(Equal(new(big.Int).Add(before, value)))
+			used, err := token.AuthorizationState(nil, tf.Address("alice"), nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeTrue())
+
+			// the same signed authorization cannot be used twice
+			tx, err = token.TransferWithAuthorization(
+				failingOpts("bob"), tf.Address("alice"), tf.Address("charlie"),
+				value, validAfter, validBefore, nonce, v, r, s,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectFailedReceipt(tf.EthClient, tx)
+
+			Expect(charlieBalance()).To(Equal(new(big.Int).Add(before, value)))
+		})
+
+		It("should refuse an expired authorization", func() {
+			nonce := [32]byte{2}
+			validBefore := big.NewInt(time.Now().Add(-time.Hour).Unix())
+			v, r, s := signTransfer(tf.Address("charlie"), validBefore, nonce)
+
+			tx, err := token.TransferWithAuthorization(
+				failingOpts("bob"), tf.Address("alice"), tf.Address("charlie"),
+				value, validAfter, validBefore, nonce, v, r, s,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectFailedReceipt(tf.EthClient, tx)
+
+			used, err := token.AuthorizationState(nil, tf.Address("alice"), nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeFalse())
+		})
+
+		It("should refuse a canceled authorization", func() {
+			nonce := [32]byte{3}
+			validBefore := big.NewInt(time.Now().Add(time.Hour).Unix())
+
+			typehash, err := token.CANCELAUTHORIZATIONTYPEHASH(nil)
+			Expect(err).ToNot(HaveOccurred())
+			v, r, s := signAuthorization(
+				typehash, common.LeftPadBytes(tf.Address("alice").Bytes(), 32), nonce[:],
+			)
+			tx, err := token.CancelAuthorization(
+				tf.GenerateTransactOpts("bob"), tf.Address("alice"), nonce, v, r, s,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+
+			used, err := token.AuthorizationState(nil, tf.Address("alice"), nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeTrue())
+
+			// a transfer signed with the canceled nonce is refused
+			v, r, s = signTransfer(tf.Address("charlie"), validBefore, nonce)
+			before := charlieBalance()
+			tx, err = token.TransferWithAuthorization(
+				failingOpts("bob"), tf.Address("alice"), tf.Address("charlie"),
+				value, validAfter, validBefore, nonce, v, r, s,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			ExpectFailedReceipt(tf.EthClient, tx)
+
+			Expect(charlieBalance()).To(Equal(before))
+		})
+	})
 })
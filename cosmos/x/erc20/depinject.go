@@ -46,6 +46,9 @@ type DepInjectInput struct {
 	Key       *store.KVStoreKey
 
 	BankKeeper BankKeeper
+
+	// DenomTraceKeeper is provided by apps with IBC transfers, to name the tokens of IBC vouchers.
+	DenomTraceKeeper keeper.DenomTraceKeeper `optional:"true"`
 }
 
 // DepInjectOutput is the output for the dep inject framework.
@@ -70,6 +73,9 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.BankKeeper,
 		authority,
 	)
+	if in.DenomTraceKeeper != nil {
+		k.SetDenomTraceKeeper(in.DenomTraceKeeper)
+	}
 
 	m := NewAppModule(k, in.BankKeeper)
 
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+// InitGenesis stores the parameters and all the token pairs of the genesis state.
+func (k *Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	ds := k.DenomKVStore(ctx)
+	for _, pair := range genState.TokenPairs {
+		ds.SetAddressDenomPair(pair.TokenAddress(), pair.Denom)
+		ds.SetPairPaused(pair.TokenAddress(), pair.Paused)
+	}
+}
+
+// ExportGenesis returns the exported genesis state. The token pairs are ordered by ascending token
+// address.
+func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var (
+		pairs []types.TokenPair
+		ds    = k.DenomKVStore(ctx)
+	)
+	ds.IterateAddressDenomPairs(func(address common.Address, denom string) bool {
+		pairs = append(pairs, newTokenPair(ds, address, denom))
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), pairs)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Genesis", func() {
+	var k *keeper.Keeper
+	var ctx sdk.Context
+
+	BeforeEach(func() {
+		var bk keeper.BankKeeper
+		ctx, _, bk, _ = utils.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			storetypes.NewKVStoreKey("erc20"), bk, authtypes.NewModuleAddress(govtypes.ModuleName),
+		)
+	})
+
+	It("should import and export the token pairs in order", func() {
+		usdc := common.HexToAddress("0x2000000000000000000000000000000000000000")
+		osmo := common.HexToAddress("0x1000000000000000000000000000000000000000")
+
+		genState := types.NewGenesisState(*types.DefaultParams(), []types.TokenPair{
+			types.NewTokenPair(usdc, types.NewGridironDenomForAddress(usdc)),
+			types.NewTokenPair(osmo, "osmo"),
+		})
+		Expect(types.ValidateGenesis(*genState)).To(Succeed())
+		k.InitGenesis(ctx, *genState)
+
+		Expect(k.DenomKVStore(ctx).GetAddressForDenom("osmo")).To(Equal(osmo))
+		Expect(k.DenomKVStore(ctx).GetDenomForAddress(usdc)).To(
+			Equal(types.NewGridironDenomForAddress(usdc)),
+		)
+
+		exported := k.ExportGenesis(ctx)
+		Expect(exported.TokenPairs).To(Equal([]types.TokenPair{
+			genState.TokenPairs[1], genState.TokenPairs[0],
+		}))
+	})
+})
@@ -22,17 +22,33 @@ package keeper
 
 import (
 	"context"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/store"
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
 	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
 // Compile-time interface assertion.
 var _ types.QueryServiceServer = (*Keeper)(nil)
 
+// Params queries the parameters of the module.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	return &types.ParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
 // ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 func (k *Keeper) ERC20AddressForCoinDenom(
 	ctx context.Context, req *types.ERC20AddressForCoinDenomRequest,
@@ -63,3 +79,130 @@ func (k *Keeper) CoinDenomForERC20Address(
 		),
 	}, nil
 }
+
+// Pairs queries a page of all registered SDK coin <> ERC20 token pairs.
+func (k *Keeper) Pairs(
+	ctx context.Context, req *types.PairsRequest,
+) (*types.PairsResponse, error) {
+	var (
+		pairs []types.TokenPair
+		ds    = k.DenomKVStore(sdk.UnwrapSDKContext(ctx))
+	)
+	pageRes, err := ds.PaginateAddressDenomPairs(
+		req.Pagination,
+		func(address common.Address, denom string) {
+			pairs = append(pairs, newTokenPair(ds, address, denom))
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.PairsResponse{Pairs: pairs, Pagination: pageRes}, nil
+}
+
+// Pair queries the SDK coin <> ERC20 token pair for a given ERC20 token address or SDK coin
+// denomination.
+func (k *Keeper) Pair(
+	ctx context.Context, req *types.PairRequest,
+) (*types.PairResponse, error) {
+	pair, found := k.tokenPair(sdk.UnwrapSDKContext(ctx), req.TokenOrDenom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no token pair for %s", req.TokenOrDenom)
+	}
+	return &types.PairResponse{Pair: pair}, nil
+}
+
+// TokenInfo queries the ERC20 metadata of a given ERC20 token by calling the token contract.
+func (k *Keeper) TokenInfo(
+	ctx context.Context, req *types.TokenInfoRequest,
+) (*types.TokenInfoResponse, error) {
+	token, ok := parseTokenAddress(req.Token)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token address %s", req.Token)
+	}
+
+	var (
+		res = &types.TokenInfoResponse{}
+		err error
+	)
+	if res.Name, err = callERC20[string](ctx, k, token, "name"); err != nil {
+		return nil, err
+	}
+	if res.Symbol, err = callERC20[string](ctx, k, token, "symbol"); err != nil {
+		return nil, err
+	}
+	decimals, err := callERC20[uint8](ctx, k, token, "decimals")
+	if err != nil {
+		return nil, err
+	}
+	res.Decimals = uint32(decimals)
+	totalSupply, err := callERC20[*big.Int](ctx, k, token, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	res.TotalSupply = sdkmath.NewIntFromBigInt(totalSupply)
+
+	return res, nil
+}
+
+// callERC20 calls the given view method of an ERC20 token and returns its single return value.
+func callERC20[T any](
+	ctx context.Context, k *Keeper, token common.Address, method string, args ...any,
+) (T, error) {
+	var zero T
+	input, err := k.erc20ABI.Pack(method, args...)
+	if err != nil {
+		return zero, err
+	}
+
+	ret, err := k.evmKeeper.StaticCall(
+		ctx, cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(types.ModuleName)), token, input,
+	)
+	if err != nil {
+		return zero, status.Errorf(codes.Internal, "failed to call %s of %s: %s", method, token.Hex(), err)
+	}
+
+	values, err := k.erc20ABI.Unpack(method, ret)
+	if err != nil || len(values) != 1 {
+		return zero, status.Errorf(codes.FailedPrecondition, "%s is not an ERC20 token", token.Hex())
+	}
+	value, ok := utils.GetAs[T](values[0])
+	if !ok {
+		return zero, status.Errorf(codes.FailedPrecondition, "%s is not an ERC20 token", token.Hex())
+	}
+	return value, nil
+}
+
+// tokenPair returns the token pair of the given ERC20 token address or SDK coin denomination.
+func (k *Keeper) tokenPair(ctx sdk.Context, tokenOrDenom string) (types.TokenPair, bool) {
+	ds := k.DenomKVStore(ctx)
+	if token, ok := parseTokenAddress(tokenOrDenom); ok {
+		if denom := ds.GetDenomForAddress(token); denom != "" {
+			return newTokenPair(ds, token, denom), true
+		}
+	} else if ds.HasAddressForDenom(tokenOrDenom) {
+		return newTokenPair(ds, ds.GetAddressForDenom(tokenOrDenom), tokenOrDenom), true
+	}
+	return types.TokenPair{}, false
+}
+
+// newTokenPair returns the token pair of the given ERC20 token address and SDK coin denomination,
+// including its paused status.
+func newTokenPair(ds store.DenomKVStore, address common.Address, denom string) types.TokenPair {
+	pair := types.NewTokenPair(address, denom)
+	pair.Paused = ds.IsPairPaused(address)
+	return pair
+}
+
+// parseTokenAddress parses either a hex or a bech32 ERC20 token address.
+func parseTokenAddress(token string) (common.Address, bool) {
+	if common.IsHexAddress(token) {
+		return common.HexToAddress(token), true
+	}
+	addr, err := sdk.AccAddressFromBech32(token)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return cosmlib.AccAddressToEthAddress(addr), true
+}
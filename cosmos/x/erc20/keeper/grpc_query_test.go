@@ -21,16 +21,26 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	cbindings "pkg.furychain.dev/gridiron/contracts/bindings/cosmos"
 	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/testing/utils"
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
 	"pkg.furychain.dev/gridiron/eth/common"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -102,4 +112,88 @@ var _ = Describe("GRPC Query Server", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(resp.Token).To(Equal(cosmlib.AddressToAccAddress(tokenAddr).String()))
 	})
+
+	It("should paginate all pairs", func() {
+		osmo := common.HexToAddress("0x1000000000000000000000000000000000000000")
+		usdc := common.HexToAddress("0x2000000000000000000000000000000000000000")
+		k.RegisterCoinERC20Pair(ctx, "osmo", osmo)
+		usdcDenom := k.RegisterERC20CoinPair(ctx, usdc)
+
+		resp, err := qs.Pairs(ctx, &types.PairsRequest{Pagination: &query.PageRequest{Limit: 1}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Pairs).To(Equal([]types.TokenPair{
+			{Denom: "osmo", Address: osmo.Hex(), Origin: types.TokenOriginCoin},
+		}))
+		Expect(resp.Pagination.NextKey).ToNot(BeNil())
+
+		resp, err = qs.Pairs(ctx, &types.PairsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Pairs).To(Equal([]types.TokenPair{
+			{Denom: usdcDenom, Address: usdc.Hex(), Origin: types.TokenOriginERC20},
+		}))
+	})
+
+	It("should query a pair by token address or denom", func() {
+		tokenAddr := common.BytesToAddress([]byte("osmo"))
+		k.RegisterCoinERC20Pair(ctx, "osmo", tokenAddr)
+		pair := types.NewTokenPair(tokenAddr, "osmo")
+
+		for _, tokenOrDenom := range []string{
+			"osmo", tokenAddr.Hex(), cosmlib.AddressToAccAddress(tokenAddr).String(),
+		} {
+			resp, err := qs.Pair(ctx, &types.PairRequest{TokenOrDenom: tokenOrDenom})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Pair).To(Equal(pair))
+		}
+
+		_, err := qs.Pair(ctx, &types.PairRequest{TokenOrDenom: "atom"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should query the token info through the EVM", func() {
+		tokenAddr := common.BytesToAddress([]byte("USDC"))
+		erc20ABI := abi.MustUnmarshalJSON(cbindings.GridironERC20MetaData.ABI)
+		k.SetEVMKeeper(&mockEVMKeeper{
+			token: tokenAddr,
+			abi:   erc20ABI,
+			outputs: map[string]any{
+				"name":        "USD Coin",
+				"symbol":      "USDC",
+				"decimals":    uint8(6),
+				"totalSupply": big.NewInt(1000000),
+			},
+		})
+
+		resp, err := qs.TokenInfo(ctx, &types.TokenInfoRequest{Token: tokenAddr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Name).To(Equal("USD Coin"))
+		Expect(resp.Symbol).To(Equal("USDC"))
+		Expect(resp.Decimals).To(Equal(uint32(6)))
+		Expect(resp.TotalSupply.Int64()).To(Equal(int64(1000000)))
+
+		_, err = qs.TokenInfo(ctx, &types.TokenInfoRequest{Token: "not an address"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
 })
+
+// mockEVMKeeper answers static calls of the no-argument methods of a single token contract.
+type mockEVMKeeper struct {
+	token   common.Address
+	abi     abi.ABI
+	outputs map[string]any
+}
+
+func (m *mockEVMKeeper) StaticCall(
+	_ context.Context, _, to common.Address, input []byte,
+) ([]byte, error) {
+	if to != m.token {
+		return nil, errors.New("no contract")
+	}
+	method, err := m.abi.MethodById(input[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(m.outputs[method.Name])
+}
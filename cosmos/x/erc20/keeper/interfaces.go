@@ -20,11 +20,19 @@
 
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+)
 
 // BankKeeper defines the expected bank keeper.
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string,
 		recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress,
@@ -32,4 +40,18 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	HasDenomMetaData(ctx sdk.Context, denom string) bool
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+}
+
+// EVMKeeper defines the expected EVM keeper.
+type EVMKeeper interface {
+	StaticCall(ctx context.Context, from, to common.Address, input []byte) ([]byte, error)
+}
+
+// DenomTraceKeeper defines the expected IBC transfer keeper, used to resolve the denom traces of
+// IBC vouchers.
+type DenomTraceKeeper interface {
+	DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 }
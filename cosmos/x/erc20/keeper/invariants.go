@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+// RegisterInvariants registers all x/erc20 invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-supply", EscrowSupplyInvariant(k))
+}
+
+// EscrowSupplyInvariant checks that, for every active ERC20 originated token pair, the ERC20
+// module escrows at least as many tokens as the bank supply of the pair's Gridiron coin. Escrows
+// that cannot be read are reported as well.
+//
+// The invariant is read-only: a pair that breaks it, e.g. because its token rebases downwards,
+// must be paused by governance through `TogglePair`, after which it is no longer checked.
+func EscrowSupplyInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// the escrow balances can only be read through the EVM
+		if k.evmKeeper == nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow supply", "no EVM keeper\n"), false
+		}
+
+		ds := k.DenomKVStore(ctx)
+		ds.IterateAddressDenomPairs(func(token common.Address, denom string) bool {
+			if !types.IsGridironDenom(denom) || ds.IsPairPaused(token) {
+				return false
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+			escrow, err := callERC20[*big.Int](ctx, k, token, "balanceOf", types.EscrowAddress)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tfailed to read escrow of %s: %s\n", token.Hex(), err)
+				return false
+			}
+			if escrow.Cmp(supply.BigInt()) < 0 {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s escrow %s is less than %s supply %s\n", token.Hex(), escrow, denom, supply,
+				)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow supply", fmt.Sprintf(
+			"found %d ERC20 token pairs with an unreadable or insufficient escrow\n%s", count, msg,
+		)), count != 0
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	cbindings "pkg.furychain.dev/gridiron/contracts/bindings/cosmos"
+	"pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Invariants", func() {
+	var (
+		k     *keeper.Keeper
+		bk    keeper.BankKeeper
+		ctx   sdk.Context
+		evm   *mockEVMKeeper
+		token = common.HexToAddress("0x1000000000000000000000000000000000000000")
+	)
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = utils.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			storetypes.NewKVStoreKey("erc20"), bk, authtypes.NewModuleAddress(govtypes.ModuleName),
+		)
+		evm = &mockEVMKeeper{
+			token:   token,
+			abi:     abi.MustUnmarshalJSON(cbindings.GridironERC20MetaData.ABI),
+			outputs: map[string]any{"balanceOf": big.NewInt(100)},
+		}
+		k.SetEVMKeeper(evm)
+
+		denom := k.RegisterERC20CoinPair(ctx, token)
+		Expect(bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))).
+			To(Succeed())
+	})
+
+	It("should hold when the escrow covers the supply", func() {
+		_, broken := keeper.EscrowSupplyInvariant(k)(ctx)
+		Expect(broken).To(BeFalse())
+	})
+
+	It("should break when the escrow is less than the supply", func() {
+		evm.outputs["balanceOf"] = big.NewInt(99)
+		msg, broken := keeper.EscrowSupplyInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring(token.Hex()))
+
+		// the invariant does not modify the state
+		Expect(k.IsPairPaused(ctx, token)).To(BeFalse())
+	})
+
+	It("should break when the escrow cannot be read", func() {
+		evm.token = common.Address{}
+		msg, broken := keeper.EscrowSupplyInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("failed to read escrow of " + token.Hex()))
+		Expect(k.IsPairPaused(ctx, token)).To(BeFalse())
+	})
+
+	It("should not check pairs paused by governance", func() {
+		evm.outputs["balanceOf"] = big.NewInt(99)
+		k.DenomKVStore(ctx).SetPairPaused(token, true)
+		_, broken := keeper.EscrowSupplyInvariant(k)(ctx)
+		Expect(broken).To(BeFalse())
+	})
+})
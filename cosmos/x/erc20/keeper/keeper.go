@@ -28,8 +28,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cbindings "pkg.furychain.dev/gridiron/contracts/bindings/cosmos"
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/store"
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
 	"pkg.furychain.dev/gridiron/eth/common"
 )
 
@@ -37,7 +39,13 @@ import (
 type Keeper struct {
 	storeKey   storetypes.StoreKey
 	bankKeeper BankKeeper
+	evmKeeper  EVMKeeper
 	authority  sdk.AccAddress
+
+	// dtk is an optional IBC transfer keeper, used to name the tokens of IBC vouchers.
+	dtk DenomTraceKeeper
+
+	erc20ABI abi.ABI
 }
 
 // NewKeeper creates new instances of the erc20 Keeper.
@@ -50,9 +58,21 @@ func NewKeeper(
 		storeKey:   storeKey,
 		bankKeeper: bk,
 		authority:  authority,
+		erc20ABI:   abi.MustUnmarshalJSON(cbindings.GridironERC20MetaData.ABI),
 	}
 }
 
+// SetEVMKeeper sets the EVM keeper used to read the state of ERC20 token contracts.
+func (k *Keeper) SetEVMKeeper(ek EVMKeeper) {
+	k.evmKeeper = ek
+}
+
+// SetDenomTraceKeeper sets the IBC transfer keeper used to resolve the denom traces of IBC
+// vouchers.
+func (k *Keeper) SetDenomTraceKeeper(dtk DenomTraceKeeper) {
+	k.dtk = dtk
+}
+
 // DenomKVStore returns a KVStore for the given denom.
 func (k *Keeper) DenomKVStore(ctx sdk.Context) store.DenomKVStore {
 	return store.NewDenomKVStore(ctx.KVStore(k.storeKey))
@@ -73,6 +93,39 @@ func (k *Keeper) RegisterCoinERC20Pair(ctx sdk.Context, denom string, token comm
 	k.DenomKVStore(ctx).SetAddressDenomPair(token, denom)
 }
 
+// ERC20MetadataForDenom returns the ERC20 name, symbol and decimals of the token representation of
+// the given SDK coin denomination, derived from its bank denom metadata or, for IBC vouchers
+// without metadata, its denom trace. Otherwise the denom is used as name and symbol.
+func (k *Keeper) ERC20MetadataForDenom(ctx sdk.Context, denom string) (string, string, uint8) {
+	if md, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return types.ERC20MetadataFromDenomMetadata(md)
+	}
+	if k.dtk != nil && types.IsIBCDenom(denom) {
+		if path, err := k.dtk.DenomPathFromHash(ctx, denom); err == nil {
+			return types.ERC20MetadataForDenomTrace(path)
+		}
+	}
+	return denom, denom, 0
+}
+
+// RegisterERC20DenomMetadata registers the bank denom metadata of the Gridiron coin denom of an
+// ERC20 originated token with the given name, symbol and decimals. Existing metadata is never
+// overwritten, and invalid metadata (e.g. an empty symbol) is not registered.
+func (k *Keeper) RegisterERC20DenomMetadata(
+	ctx sdk.Context, denom, name, symbol string, decimals uint8,
+) {
+	if k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return
+	}
+
+	md := types.NewERC20DenomMetadata(denom, name, symbol, decimals)
+	if err := md.Validate(); err != nil {
+		k.Logger(ctx).Debug("skipping invalid ERC20 denom metadata", "denom", denom, "error", err)
+		return
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type mockDenomTraceKeeper map[string]string
+
+func (m mockDenomTraceKeeper) DenomPathFromHash(_ sdk.Context, denom string) (string, error) {
+	if path, found := m[denom]; found {
+		return path, nil
+	}
+	return "", types.ErrInvalidTokenPair
+}
+
+var _ = Describe("Denom Metadata", func() {
+	var k *keeper.Keeper
+	var bk keeper.BankKeeper
+	var ctx sdk.Context
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = utils.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			storetypes.NewKVStoreKey("erc20"), bk, authtypes.NewModuleAddress(govtypes.ModuleName),
+		)
+	})
+
+	It("should use the bank denom metadata of a coin", func() {
+		bk.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uosmo", Exponent: 0},
+				{Denom: "osmo", Exponent: 6},
+			},
+			Base:    "uosmo",
+			Display: "osmo",
+			Name:    "Osmosis",
+			Symbol:  "OSMO",
+		})
+
+		name, symbol, decimals := k.ERC20MetadataForDenom(ctx, "uosmo")
+		Expect(name).To(Equal("Osmosis"))
+		Expect(symbol).To(Equal("OSMO"))
+		Expect(decimals).To(Equal(uint8(6)))
+	})
+
+	It("should use the denom trace of an IBC voucher without metadata", func() {
+		denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+		k.SetDenomTraceKeeper(mockDenomTraceKeeper{denom: "transfer/channel-0/uatom"})
+
+		name, symbol, decimals := k.ERC20MetadataForDenom(ctx, denom)
+		Expect(name).To(Equal("transfer/channel-0/uatom"))
+		Expect(symbol).To(Equal("uatom"))
+		Expect(decimals).To(BeZero())
+	})
+
+	It("should fall back to the denom", func() {
+		name, symbol, decimals := k.ERC20MetadataForDenom(ctx, "stake")
+		Expect(name).To(Equal("stake"))
+		Expect(symbol).To(Equal("stake"))
+		Expect(decimals).To(BeZero())
+	})
+
+	It("should register the denom metadata of an ERC20 originated coin", func() {
+		denom := types.NewGridironDenomForAddress(
+			common.HexToAddress("0x1000000000000000000000000000000000000000"),
+		)
+		k.RegisterERC20DenomMetadata(ctx, denom, "USD Coin", "USDC", 6)
+
+		md, found := bk.GetDenomMetaData(ctx, denom)
+		Expect(found).To(BeTrue())
+		Expect(md.Base).To(Equal(denom))
+		Expect(md.Display).To(Equal("USDC"))
+
+		name, symbol, decimals := k.ERC20MetadataForDenom(ctx, denom)
+		Expect(name).To(Equal("USD Coin"))
+		Expect(symbol).To(Equal("USDC"))
+		Expect(decimals).To(Equal(uint8(6)))
+
+		// existing metadata is not overwritten
+		k.RegisterERC20DenomMetadata(ctx, denom, "Other", "OTHER", 18)
+		md, _ = bk.GetDenomMetaData(ctx, denom)
+		Expect(md.Symbol).To(Equal("USDC"))
+	})
+
+	It("should not register invalid denom metadata", func() {
+		denom := types.NewGridironDenomForAddress(
+			common.HexToAddress("0x1000000000000000000000000000000000000000"),
+		)
+		k.RegisterERC20DenomMetadata(ctx, denom, "", "", 6)
+		Expect(bk.HasDenomMetaData(ctx, denom)).To(BeFalse())
+	})
+})
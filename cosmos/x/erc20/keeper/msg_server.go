@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+// Compile-time check to ensure `Keeper` implements the `MsgServiceServer` interface.
+var _ types.MsgServiceServer = (*Keeper)(nil)
+
+// UpdateParams implements the MsgServiceServer interface. It updates the parameters of the module.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, req *types.UpdateParamsRequest,
+) (*types.UpdateParamsResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	k.SetParams(sdk.UnwrapSDKContext(ctx), req.Params)
+	return &types.UpdateParamsResponse{}, nil
+}
+
+// RegisterPair implements the MsgServiceServer interface. It registers the token pair of an ERC20
+// originated token and its Gridiron coin, and describes the coin with the token's metadata.
+func (k *Keeper) RegisterPair(
+	ctx context.Context, req *types.RegisterPairRequest,
+) (*types.RegisterPairResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	token := common.HexToAddress(req.Token)
+	if k.DenomKVStore(sdkCtx).HasDenomForAddress(token) {
+		return nil, errorsmod.Wrapf(types.ErrDuplicateTokenPair, "token %s", token.Hex())
+	}
+
+	denom := k.RegisterERC20CoinPair(sdkCtx, token)
+	if k.evmKeeper != nil {
+		k.registerTokenDenomMetadata(ctx, token, denom)
+	}
+	return &types.RegisterPairResponse{Denom: denom}, nil
+}
+
+// TogglePair implements the MsgServiceServer interface. It pauses the conversions of an active
+// token pair, or resumes the conversions of a paused one.
+func (k *Keeper) TogglePair(
+	ctx context.Context, req *types.TogglePairRequest,
+) (*types.TogglePairResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pair, found := k.tokenPair(sdkCtx, req.TokenOrDenom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "%s", req.TokenOrDenom)
+	}
+
+	paused := !pair.Paused
+	k.DenomKVStore(sdkCtx).SetPairPaused(pair.TokenAddress(), paused)
+	return &types.TogglePairResponse{Paused: paused}, nil
+}
+
+// DeregisterPair implements the MsgServiceServer interface. It removes the token pair of an ERC20
+// originated token once none of its Gridiron coins are in circulation, as the escrowed tokens of
+// outstanding coins would no longer be redeemable. Coin originated pairs cannot be deregistered,
+// as their `GridironERC20` token would no longer be redeemable; they can be paused instead.
+func (k *Keeper) DeregisterPair(
+	ctx context.Context, req *types.DeregisterPairRequest,
+) (*types.DeregisterPairResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pair, found := k.tokenPair(sdkCtx, req.TokenOrDenom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "%s", req.TokenOrDenom)
+	}
+	if pair.Origin != types.TokenOriginERC20 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidTokenPair, "coin originated pair of %s cannot be deregistered", pair.Denom,
+		)
+	}
+	if supply := k.bankKeeper.GetSupply(sdkCtx, pair.Denom); supply.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidTokenPair, "%s of pair %s still in circulation", supply, pair.Denom,
+		)
+	}
+
+	k.DenomKVStore(sdkCtx).DeleteAddressDenomPair(pair.TokenAddress())
+	return &types.DeregisterPairResponse{}, nil
+}
+
+// validateAuthority returns an error if the given address is not the authority of the module.
+func (k *Keeper) validateAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority, expected %s, got %s", k.authority, authority,
+		)
+	}
+	return nil
+}
+
+// registerTokenDenomMetadata registers the bank denom metadata of the Gridiron coin of an ERC20
+// token from the token's name, symbol and decimals. If the token does not implement these methods,
+// no metadata is registered.
+func (k *Keeper) registerTokenDenomMetadata(ctx context.Context, token common.Address, denom string) {
+	name, err := callERC20[string](ctx, k, token, "name")
+	if err != nil {
+		return
+	}
+	symbol, err := callERC20[string](ctx, k, token, "symbol")
+	if err != nil {
+		return
+	}
+	decimals, err := callERC20[uint8](ctx, k, token, "decimals")
+	if err != nil {
+		return
+	}
+	k.RegisterERC20DenomMetadata(sdk.UnwrapSDKContext(ctx), denom, name, symbol, decimals)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Msg Server", func() {
+	var (
+		k         *keeper.Keeper
+		bk        keeper.BankKeeper
+		ctx       sdk.Context
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+		token     = common.HexToAddress("0x1000000000000000000000000000000000000000")
+	)
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = utils.SetupMinimalKeepers()
+		k = keeper.NewKeeper(storetypes.NewKVStoreKey("erc20"), bk, authority)
+	})
+
+	It("should reject requests not signed by the authority", func() {
+		other := sdk.AccAddress([]byte("other"))
+
+		_, err := k.UpdateParams(ctx, &types.UpdateParamsRequest{
+			Authority: other.String(), Params: types.Params{EnableAllowlist: true},
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+		Expect(k.IsAllowlistEnabled(ctx)).To(BeFalse())
+
+		_, err = k.RegisterPair(ctx, types.NewRegisterPairRequest(other, token))
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+		Expect(k.DenomKVStore(ctx).HasDenomForAddress(token)).To(BeFalse())
+	})
+
+	It("should update the params", func() {
+		_, err := k.UpdateParams(ctx, &types.UpdateParamsRequest{
+			Authority: authority.String(), Params: types.Params{EnableAllowlist: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.IsAllowlistEnabled(ctx)).To(BeTrue())
+		Expect(k.ExportGenesis(ctx).Params.EnableAllowlist).To(BeTrue())
+	})
+
+	It("should register, pause and deregister an ERC20 token pair", func() {
+		resp, err := k.RegisterPair(ctx, types.NewRegisterPairRequest(authority, token))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Denom).To(Equal(types.NewGridironDenomForAddress(token)))
+		Expect(k.DenomKVStore(ctx).GetDenomForAddress(token)).To(Equal(resp.Denom))
+
+		_, err = k.RegisterPair(ctx, types.NewRegisterPairRequest(authority, token))
+		Expect(err).To(MatchError(types.ErrDuplicateTokenPair))
+
+		toggled, err := k.TogglePair(ctx, types.NewTogglePairRequest(authority, resp.Denom))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toggled.Paused).To(BeTrue())
+		Expect(k.IsPairPaused(ctx, token)).To(BeTrue())
+		Expect(k.ExportGenesis(ctx).TokenPairs[0].Paused).To(BeTrue())
+
+		toggled, err = k.TogglePair(ctx, types.NewTogglePairRequest(authority, token.Hex()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toggled.Paused).To(BeFalse())
+		Expect(k.IsPairPaused(ctx, token)).To(BeFalse())
+
+		_, err = k.DeregisterPair(ctx, types.NewDeregisterPairRequest(authority, token.Hex()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.DenomKVStore(ctx).HasDenomForAddress(token)).To(BeFalse())
+		Expect(k.DenomKVStore(ctx).HasAddressForDenom(resp.Denom)).To(BeFalse())
+
+		_, err = k.TogglePair(ctx, types.NewTogglePairRequest(authority, token.Hex()))
+		Expect(err).To(MatchError(types.ErrTokenPairNotFound))
+	})
+
+	It("should not deregister a pair while its coins are in circulation", func() {
+		resp, err := k.RegisterPair(ctx, types.NewRegisterPairRequest(authority, token))
+		Expect(err).ToNot(HaveOccurred())
+		coins := sdk.NewCoins(sdk.NewInt64Coin(resp.Denom, 100))
+		Expect(bk.MintCoins(ctx, types.ModuleName, coins)).To(Succeed())
+
+		_, err = k.DeregisterPair(ctx, types.NewDeregisterPairRequest(authority, token.Hex()))
+		Expect(err).To(MatchError(types.ErrInvalidTokenPair))
+		Expect(k.DenomKVStore(ctx).HasDenomForAddress(token)).To(BeTrue())
+
+		Expect(bk.BurnCoins(ctx, types.ModuleName, coins)).To(Succeed())
+		_, err = k.DeregisterPair(ctx, types.NewDeregisterPairRequest(authority, token.Hex()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.DenomKVStore(ctx).HasDenomForAddress(token)).To(BeFalse())
+	})
+
+	It("should not deregister a coin originated token pair", func() {
+		k.RegisterCoinERC20Pair(ctx, "osmo", token)
+
+		_, err := k.DeregisterPair(ctx, types.NewDeregisterPairRequest(authority, "osmo"))
+		Expect(err).To(MatchError(types.ErrInvalidTokenPair))
+		Expect(k.DenomKVStore(ctx).HasAddressForDenom("osmo")).To(BeTrue())
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+)
+
+// GetParams returns the parameters of the module.
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return *types.DefaultParams()
+	}
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz, err := params.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte{types.ParamsKey}, bz)
+}
+
+// IsAllowlistEnabled returns true if only governance registered ERC20 originated tokens can be
+// converted.
+func (k *Keeper) IsAllowlistEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableAllowlist
+}
+
+// IsPairPaused returns true if the conversions of the token pair of the given ERC20 token are
+// paused.
+func (k *Keeper) IsPairPaused(ctx sdk.Context, token common.Address) bool {
+	return k.DenomKVStore(ctx).IsPairPaused(token)
+}
@@ -21,6 +21,8 @@
 package erc20
 
 import (
+	"context"
+
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -65,16 +67,16 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // RegisterInterfaces registers the module's interface types.
 func (b AppModuleBasic) RegisterInterfaces(r cdctypes.InterfaceRegistry) {
-	// types.RegisterInterfaces(r)
+	types.RegisterInterfaces(r)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the evm module.
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the erc20 module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
-	// if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux,
-	// types.NewQueryClient(clientCtx)); err != nil {
-	// 	panic(err)
-	// }
-	// evmrpc.RegisterJSONRPCServer(clientCtx, mux, app.EVMKeeper.GetRPCProvider()) maybe here?
+	if err := types.RegisterQueryServiceHandlerClient(
+		context.Background(), mux, types.NewQueryServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns no root tx command for the evm module.
@@ -116,14 +118,15 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
-// RegisterInvariants registers the evm module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the erc20 module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
-// RegisterServices registers a gRPC query service to respond to the
-// module-specific gRPC queries.
+// RegisterServices registers the gRPC query and msg services of the module.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServiceServer(cfg.QueryServer(), am.keeper)
-	// types.RegisterMsgServiceServer(cfg.MsgServer(), am.keeper)
+	types.RegisterMsgServiceServer(cfg.MsgServer(), am.keeper)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
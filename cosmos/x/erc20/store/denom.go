@@ -24,6 +24,8 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"pkg.furychain.dev/gridiron/cosmos/x/erc20/types"
 	"pkg.furychain.dev/gridiron/eth/common"
 )
@@ -35,6 +37,13 @@ type DenomKVStore interface {
 	HasDenomForAddress(address common.Address) bool
 	GetAddressForDenom(denom string) common.Address
 	HasAddressForDenom(denom string) bool
+	DeleteAddressDenomPair(address common.Address)
+	SetPairPaused(address common.Address, paused bool)
+	IsPairPaused(address common.Address) bool
+	IterateAddressDenomPairs(cb func(address common.Address, denom string) (stop bool))
+	PaginateAddressDenomPairs(
+		pageReq *query.PageRequest, cb func(address common.Address, denom string),
+	) (*query.PageResponse, error)
 }
 
 // denomStore is a store that stores information regarding ERC20 token address <-> SDK Coin
@@ -42,6 +51,7 @@ type DenomKVStore interface {
 type denomStore struct {
 	addressToDenom storetypes.KVStore
 	denomToAddress storetypes.KVStore
+	pausedPairs    storetypes.KVStore
 }
 
 // NewDenomKVStore creates a new DenomKVStore.
@@ -49,6 +59,7 @@ func NewDenomKVStore(store storetypes.KVStore) DenomKVStore {
 	return &denomStore{
 		addressToDenom: prefix.NewStore(store, []byte{types.AddressToDenomKeyPrefix}),
 		denomToAddress: prefix.NewStore(store, []byte{types.DenomToAddressKeyPrefix}),
+		pausedPairs:    prefix.NewStore(store, []byte{types.PausedPairKeyPrefix}),
 	}
 }
 
@@ -59,6 +70,53 @@ func (ds *denomStore) SetAddressDenomPair(address common.Address, denom string)
 	ds.denomToAddress.Set(bz, address.Bytes())
 }
 
+// DeleteAddressDenomPair deletes the ERC20 address <-> SDK coin denomination pair of the given
+// ERC20 address, including its paused status.
+func (ds *denomStore) DeleteAddressDenomPair(address common.Address) {
+	if bz := ds.addressToDenom.Get(address.Bytes()); bz != nil {
+		ds.denomToAddress.Delete(bz)
+	}
+	ds.addressToDenom.Delete(address.Bytes())
+	ds.pausedPairs.Delete(address.Bytes())
+}
+
+// SetPairPaused sets whether the conversions of the pair of the given ERC20 address are paused.
+func (ds *denomStore) SetPairPaused(address common.Address, paused bool) {
+	if paused {
+		ds.pausedPairs.Set(address.Bytes(), []byte{1})
+	} else {
+		ds.pausedPairs.Delete(address.Bytes())
+	}
+}
+
+// IsPairPaused returns true if the conversions of the pair of the given ERC20 address are paused.
+func (ds *denomStore) IsPairPaused(address common.Address) bool {
+	return ds.pausedPairs.Has(address.Bytes())
+}
+
+// IterateAddressDenomPairs iterates over all ERC20 address <-> SDK coin denomination pairs in
+// ascending order of the ERC20 address, stopping early if the callback returns true.
+func (ds *denomStore) IterateAddressDenomPairs(cb func(address common.Address, denom string) bool) {
+	it := ds.addressToDenom.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if cb(common.BytesToAddress(it.Key()), string(it.Value())) {
+			return
+		}
+	}
+}
+
+// PaginateAddressDenomPairs iterates over a page of the ERC20 address <-> SDK coin denomination
+// pairs in ascending order of the ERC20 address.
+func (ds *denomStore) PaginateAddressDenomPairs(
+	pageReq *query.PageRequest, cb func(address common.Address, denom string),
+) (*query.PageResponse, error) {
+	return query.Paginate(ds.addressToDenom, pageReq, func(key, value []byte) error {
+		cb(common.BytesToAddress(key), string(value))
+		return nil
+	})
+}
+
 // ==============================================================================
 // ERC20 -> Denom
 // ==============================================================================
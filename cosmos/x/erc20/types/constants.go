@@ -20,6 +20,8 @@
 
 package types
 
+import "pkg.furychain.dev/gridiron/eth/common"
+
 const (
 	StoreKey   = "erc20"
 	ModuleName = "erc20"
@@ -28,8 +30,15 @@ const (
 const (
 	DenomToAddressKeyPrefix byte = iota
 	AddressToDenomKeyPrefix
+	PausedPairKeyPrefix
+	ParamsKey
 )
 
+// EscrowAddress is the address of the ERC20 module precompile, which escrows the ERC20 originated
+// tokens converted to Gridiron coins.
+// TODO: use the module address once module addresses are supported as precompile addresses.
+var EscrowAddress = common.HexToAddress("0x696969")
+
 var (
 	EventTypeTransferERC20ToCoin = "transfer_erc20_to_coin"
 	EventTypeTransferCoinToERC20 = "transfer_coin_to_erc20"
@@ -32,10 +32,10 @@ const (
 	gridironDenomPrefix = "gridiron/"
 
 	// lenGridironDenomPrefix is the length of the gridironDenomPrefix.
-	lenGridironDenomPrefix = 8
+	lenGridironDenomPrefix = 9
 
 	// lenGridironDenom is the length of the (gridironDenomPrefix + 20 bytes + "0x") for the address.
-	lenGridironDenom = 50
+	lenGridironDenom = 51
 )
 
 // NewGridironDenomForAddress returns a new Gridiron coin denomination for a given ERC20 originated
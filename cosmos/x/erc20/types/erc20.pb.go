@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gridiron/erc20/v1alpha1/erc20.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// `TokenOrigin` defines the side on which the asset of a token pair was created.
+type TokenOrigin int32
+
+const (
+	// `TOKEN_ORIGIN_UNSPECIFIED` is an invalid origin.
+	TokenOriginUnspecified TokenOrigin = 0
+	// `TOKEN_ORIGIN_COIN` marks a pair of an SDK coin (e.g. IBC-originated) and its auto-deployed
+	// `GridironERC20` token.
+	TokenOriginCoin TokenOrigin = 1
+	// `TOKEN_ORIGIN_ERC20` marks a pair of an ERC20 token and its `gridiron/0x...` coin.
+	TokenOriginERC20 TokenOrigin = 2
+)
+
+var TokenOrigin_name = map[int32]string{
+	0: "TOKEN_ORIGIN_UNSPECIFIED",
+	1: "TOKEN_ORIGIN_COIN",
+	2: "TOKEN_ORIGIN_ERC20",
+}
+
+var TokenOrigin_value = map[string]int32{
+	"TOKEN_ORIGIN_UNSPECIFIED": 0,
+	"TOKEN_ORIGIN_COIN":        1,
+	"TOKEN_ORIGIN_ERC20":       2,
+}
+
+func (x TokenOrigin) String() string {
+	return proto.EnumName(TokenOrigin_name, int32(x))
+}
+
+func (TokenOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f242823f03286abe, []int{0}
+}
+
+// `TokenPair` defines a pairing of an SDK coin denomination and an ERC20 token.
+type TokenPair struct {
+	// `denom` is the SDK coin denomination of the pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// `address` is the hex address of the ERC20 token contract of the pair.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// `origin` is the side on which the asset of the pair was created.
+	Origin TokenOrigin `protobuf:"varint,3,opt,name=origin,proto3,enum=gridiron.erc20.v1alpha1.TokenOrigin" json:"origin,omitempty"`
+	// `paused` is true if conversions between the coin and the token of the pair are disabled.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *TokenPair) Reset()         { *m = TokenPair{} }
+func (m *TokenPair) String() string { return proto.CompactTextString(m) }
+func (*TokenPair) ProtoMessage()    {}
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f242823f03286abe, []int{0}
+}
+func (m *TokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPair.Merge(m, src)
+}
+func (m *TokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPair proto.InternalMessageInfo
+
+func (m *TokenPair) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPair) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TokenPair) GetOrigin() TokenOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return TokenOriginUnspecified
+}
+
+func (m *TokenPair) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("gridiron.erc20.v1alpha1.TokenOrigin", TokenOrigin_name, TokenOrigin_value)
+	proto.RegisterType((*TokenPair)(nil), "gridiron.erc20.v1alpha1.TokenPair")
+}
+
+func init() {
+	proto.RegisterFile("gridiron/erc20/v1alpha1/erc20.proto", fileDescriptor_f242823f03286abe)
+}
+
+var fileDescriptor_f242823f03286abe = []byte{
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xf2, 0x40,
+	0x1c, 0xc6, 0x7b, 0xbc, 0xbc, 0x28, 0x67, 0xa2, 0xf5, 0x24, 0xd8, 0x74, 0x68, 0x1a, 0x75, 0x20,
+	0xc4, 0xb4, 0x80, 0x8b, 0x83, 0x93, 0xb5, 0x9a, 0xc6, 0xa4, 0x25, 0x15, 0x16, 0x17, 0x52, 0xdb,
+	0xa3, 0x5c, 0x90, 0x5e, 0x73, 0x07, 0x44, 0xbe, 0x81, 0x61, 0xd2, 0x0f, 0xc0, 0xe4, 0xe0, 0x57,
+	0x71, 0x64, 0x74, 0x34, 0xf0, 0x45, 0x8c, 0x2d, 0x98, 0x32, 0xb8, 0xfd, 0x7f, 0x4f, 0x7e, 0x4f,
+	0xfe, 0xc3, 0x03, 0x8f, 0x43, 0x46, 0x02, 0xc2, 0x68, 0xa4, 0x63, 0xe6, 0x37, 0x6a, 0xfa, 0xb8,
+	0xee, 0x3d, 0xc6, 0x3d, 0xaf, 0x9e, 0xa2, 0x16, 0x33, 0x3a, 0xa4, 0xe8, 0x70, 0x2d, 0x69, 0x69,
+	0xba, 0x96, 0xe4, 0x52, 0x48, 0x43, 0x9a, 0x38, 0xfa, 0xcf, 0x95, 0xea, 0x47, 0xaf, 0x00, 0x16,
+	0x5b, 0xb4, 0x8f, 0xa3, 0xa6, 0x47, 0x18, 0x2a, 0xc1, 0xff, 0x01, 0x8e, 0xe8, 0x40, 0x02, 0x2a,
+	0xa8, 0x14, 0xdd, 0x14, 0x90, 0x04, 0xb7, 0xbc, 0x20, 0x60, 0x98, 0x73, 0x29, 0x97, 0xe4, 0x6b,
+	0x44, 0x17, 0xb0, 0x40, 0x19, 0x09, 0x49, 0x24, 0xfd, 0x53, 0x41, 0x65, 0xb7, 0x71, 0xa2, 0xfd,
+	0xf1, 0x5d, 0x4b, 0x7e, 0x38, 0x89, 0xeb, 0xae, 0x3a, 0xa8, 0x0c, 0x0b, 0xb1, 0x37, 0xe2, 0x38,
+	0x90, 0xf2, 0x2a, 0xa8, 0x6c, 0xbb, 0x2b, 0xaa, 0xbe, 0x03, 0xb8, 0x93, 0xf1, 0xd1, 0x39, 0x94,
+	0x5a, 0xce, 0xad, 0x69, 0x77, 0x1c, 0xd7, 0xba, 0xb1, 0xec, 0x4e, 0xdb, 0xbe, 0x6b, 0x9a, 0x86,
+	0x75, 0x6d, 0x99, 0x57, 0xa2, 0x20, 0xcb, 0xd3, 0x99, 0x5a, 0xce, 0xe8, 0xed, 0x88, 0xc7, 0xd8,
+	0x27, 0x5d, 0x82, 0x03, 0x54, 0x85, 0xfb, 0x1b, 0x4d, 0xc3, 0xb1, 0x6c, 0x11, 0xc8, 0x07, 0xd3,
+	0x99, 0xba, 0x97, 0xa9, 0x18, 0x94, 0x44, 0xe8, 0x14, 0xa2, 0x0d, 0xd7, 0x74, 0x8d, 0x46, 0x4d,
+	0xcc, 0xc9, 0xa5, 0xe9, 0x4c, 0x15, 0x33, 0x72, 0x92, 0xcb, 0xf9, 0xe7, 0x37, 0x45, 0xb8, 0xb4,
+	0x3e, 0x16, 0x0a, 0x98, 0x2f, 0x14, 0xf0, 0xb5, 0x50, 0xc0, 0xcb, 0x52, 0x11, 0xe6, 0x4b, 0x45,
+	0xf8, 0x5c, 0x2a, 0xc2, 0xbd, 0x1e, 0xf7, 0x43, 0xad, 0x3b, 0x62, 0x13, 0xbf, 0xe7, 0x91, 0x48,
+	0x0b, 0xf0, 0x58, 0xff, 0x5d, 0xcf, 0xa7, 0x7c, 0x40, 0xb9, 0xfe, 0xb4, 0x9a, 0x71, 0x38, 0x89,
+	0x31, 0x7f, 0x28, 0x24, 0x7b, 0x9c, 0x7d, 0x0f, 0x00, 0x74, 0xff, 0x5a, 0x0b, 0xe5, 0x01, 0x00,
+	0x00,
+}
+
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Origin != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovErc20(uint64(m.Origin))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= TokenOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipErc20(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthErc20
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupErc20
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthErc20
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthErc20        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowErc20          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupErc20 = fmt.Errorf("proto: unexpected end of group")
+)
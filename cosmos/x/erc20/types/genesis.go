@@ -20,6 +20,8 @@
 
 package types
 
+import "pkg.furychain.dev/gridiron/lib/errors"
+
 // DefaultGenesis is the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -29,13 +31,34 @@ func DefaultGenesis() *GenesisState {
 
 // ValidateGenesis is used to validate the genesis state.
 func ValidateGenesis(data GenesisState) error {
-	return data.Params.ValidateBasic()
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// every denom and every token address can be part of at most one pair
+	denoms := make(map[string]struct{}, len(data.TokenPairs))
+	addresses := make(map[string]struct{}, len(data.TokenPairs))
+	for _, pair := range data.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+		if _, ok := denoms[pair.Denom]; ok {
+			return errors.Wrapf(ErrDuplicateTokenPair, "denom %s", pair.Denom)
+		}
+		denoms[pair.Denom] = struct{}{}
+		address := pair.TokenAddress().Hex()
+		if _, ok := addresses[address]; ok {
+			return errors.Wrapf(ErrDuplicateTokenPair, "token %s", address)
+		}
+		addresses[address] = struct{}{}
+	}
+	return nil
 }
 
 // NewGenesisState creates a new `GenesisState` object.
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, tokenPairs []TokenPair) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:     params,
+		TokenPairs: tokenPairs,
 	}
 }
-
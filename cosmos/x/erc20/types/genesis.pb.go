@@ -27,13 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// token_pairs is the list of all registered SDK coin <> ERC20 token pairs.
+	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e5e786c63968335, []int{0}
+	return fileDescriptor_2f2d98f6c1b100e9, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -69,30 +71,39 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTokenPairs() []TokenPair {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gridiron.erc20.v1alpha1.GenesisState")
 }
 
 func init() {
-	proto.RegisterFile("gridiron/erc20/v1alpha1/genesis.proto", fileDescriptor_4e5e786c63968335)
+	proto.RegisterFile("gridiron/erc20/v1alpha1/genesis.proto", fileDescriptor_2f2d98f6c1b100e9)
 }
 
-var fileDescriptor_4e5e786c63968335 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc8, 0xcf, 0x49,
-	0x2c, 0xca, 0x2c, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8,
-	0x48, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xa9, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x71, 0x98, 0x59, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x35, 0x52, 0xc9, 0x87, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90,
-	0x0d, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0xbb, 0x9d,
-	0x7a, 0x01, 0x60, 0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xf5, 0x38, 0x79, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e, 0x41, 0x76, 0xba, 0x5e, 0x52,
-	0x6a, 0x51, 0x62, 0x72, 0x46, 0x62, 0x66, 0x9e, 0x5e, 0x4a, 0x6a, 0x99, 0x3e, 0xcc, 0x79, 0xc9,
-	0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x15, 0x50, 0x77, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0x9d, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x22, 0x39, 0xf7, 0xcf, 0x19, 0x01, 0x00,
-	0x00,
+var fileDescriptor_2f2d98f6c1b100e9 = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x2f, 0xca, 0x4c,
+	0xc9, 0x2c, 0xca, 0xcf, 0xd3, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29,
+	0xc8, 0x48, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x12, 0x87, 0x29, 0xd3, 0x03, 0x2b, 0xd3, 0x83, 0x29, 0x93, 0x12, 0x49, 0xcf, 0x4f,
+	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x94, 0x71, 0x99, 0x0a, 0xd1, 0x0d, 0x51,
+	0xa4, 0x82, 0x4b, 0x51, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x66, 0xa5, 0x19, 0x8c, 0x5c, 0x3c,
+	0xee, 0x10, 0xb7, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xd9, 0x72, 0xb1, 0x41, 0x14, 0x48, 0x30,
+	0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1, 0x70, 0x9b, 0x5e, 0x00, 0x58, 0x99, 0x13, 0xcb,
+	0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x4d, 0x42, 0x9e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79,
+	0xf1, 0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x4a, 0x38, 0xcd,
+	0x08, 0x01, 0xa9, 0x0d, 0x48, 0xcc, 0x2c, 0x82, 0x1a, 0xc3, 0x55, 0x02, 0x13, 0x28, 0x76, 0xf2,
+	0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
+	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0x82, 0xec, 0x74, 0xbd,
+	0xb4, 0xd2, 0xa2, 0xca, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0xbd, 0x94, 0xd4, 0x32, 0x7d, 0xb8, 0x67,
+	0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x2b, 0xa0, 0xbe, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
+	0x62, 0x03, 0x7b, 0xd6, 0x18, 0x30, 0x00, 0x3b, 0xe3, 0x97, 0x02, 0x8f, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +126,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +172,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +249,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
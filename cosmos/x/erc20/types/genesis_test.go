@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Genesis", func() {
+	token := common.BytesToAddress([]byte("USDC"))
+	other := common.BytesToAddress([]byte("osmo"))
+
+	It("should validate the default genesis", func() {
+		Expect(ValidateGenesis(*DefaultGenesis())).To(Succeed())
+	})
+
+	It("should validate token pairs of both origins", func() {
+		state := NewGenesisState(*DefaultParams(), []TokenPair{
+			NewTokenPair(token, NewGridironDenomForAddress(token)),
+			NewTokenPair(other, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+		})
+		Expect(state.TokenPairs[0].Origin).To(Equal(TokenOriginERC20))
+		Expect(state.TokenPairs[1].Origin).To(Equal(TokenOriginCoin))
+		Expect(ValidateGenesis(*state)).To(Succeed())
+	})
+
+	It("should fail on malformed token pairs", func() {
+		// ERC20 originated pair with the Gridiron denom of another token
+		pair := NewTokenPair(token, NewGridironDenomForAddress(other))
+		Expect(pair.Validate()).To(MatchError(ErrInvalidTokenPair))
+
+		// coin originated pair with a Gridiron denom
+		pair = NewTokenPair(token, NewGridironDenomForAddress(token))
+		pair.Origin = TokenOriginCoin
+		Expect(pair.Validate()).To(MatchError(ErrInvalidTokenPair))
+
+		// invalid token address
+		pair = NewTokenPair(token, "osmo")
+		pair.Address = "0x1234"
+		Expect(pair.Validate()).To(MatchError(ErrInvalidTokenPair))
+
+		// unspecified origin
+		pair = NewTokenPair(token, "osmo")
+		pair.Origin = TokenOriginUnspecified
+		Expect(pair.Validate()).To(MatchError(ErrInvalidTokenPair))
+	})
+
+	It("should fail on duplicate token pairs", func() {
+		state := NewGenesisState(*DefaultParams(), []TokenPair{
+			NewTokenPair(token, "osmo"),
+			NewTokenPair(other, "osmo"),
+		})
+		Expect(ValidateGenesis(*state)).To(MatchError(ErrDuplicateTokenPair))
+
+		state = NewGenesisState(*DefaultParams(), []TokenPair{
+			NewTokenPair(token, "osmo"),
+			NewTokenPair(token, "atom"),
+		})
+		Expect(ValidateGenesis(*state)).To(MatchError(ErrDuplicateTokenPair))
+	})
+})
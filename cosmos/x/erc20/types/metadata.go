@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ibcDenomPrefix is the prefix of the denominations of IBC vouchers.
+const ibcDenomPrefix = "ibc/"
+
+// IsIBCDenom returns true if the given denom is the denomination of an IBC voucher.
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, ibcDenomPrefix)
+}
+
+// ERC20MetadataForDenomTrace returns the ERC20 name, symbol and decimals for an IBC voucher without
+// denom metadata, given its denom trace path (e.g. `transfer/channel-0/uatom`). The name is the
+// full trace and the symbol is the base denomination.
+func ERC20MetadataForDenomTrace(path string) (string, string, uint8) {
+	return path, path[strings.LastIndex(path, "/")+1:], 0
+}
+
+// ERC20MetadataFromDenomMetadata returns the ERC20 name, symbol and decimals for an SDK coin with
+// the given bank denom metadata. The decimals are the exponent of the display denom unit.
+func ERC20MetadataFromDenomMetadata(md banktypes.Metadata) (string, string, uint8) {
+	name, symbol := md.Name, md.Symbol
+	if name == "" {
+		name = md.Display
+	}
+	if symbol == "" {
+		symbol = md.Display
+	}
+
+	var decimals uint8
+	for _, unit := range md.DenomUnits {
+		if unit.Denom == md.Display && unit.Exponent <= math.MaxUint8 {
+			decimals = uint8(unit.Exponent)
+		}
+	}
+	return name, symbol, decimals
+}
+
+// NewERC20DenomMetadata returns the bank denom metadata of the Gridiron coin denom of an ERC20
+// originated token with the given name, symbol and decimals. The display denom unit of the coin is
+// the symbol of the token.
+func NewERC20DenomMetadata(denom, name, symbol string, decimals uint8) banktypes.Metadata {
+	md := banktypes.Metadata{
+		Description: fmt.Sprintf("Gridiron coin of the ERC20 token %s", name),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        name,
+		Symbol:      symbol,
+	}
+	if decimals > 0 {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{
+			Denom: symbol, Exponent: uint32(decimals),
+		})
+		md.Display = symbol
+	}
+	return md
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/errors"
+)
+
+// Compile-time interface assertions.
+var (
+	_ sdk.Msg = (*UpdateParamsRequest)(nil)
+	_ sdk.Msg = (*RegisterPairRequest)(nil)
+	_ sdk.Msg = (*TogglePairRequest)(nil)
+	_ sdk.Msg = (*DeregisterPairRequest)(nil)
+)
+
+// GetSigners returns the expected signers for the request.
+func (m UpdateParamsRequest) GetSigners() []sdk.AccAddress {
+	return authoritySigners(m.Authority)
+}
+
+// ValidateBasic validates the request.
+func (m *UpdateParamsRequest) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewRegisterPairRequest creates a new `RegisterPairRequest`.
+func NewRegisterPairRequest(authority sdk.AccAddress, token common.Address) *RegisterPairRequest {
+	return &RegisterPairRequest{
+		Authority: authority.String(),
+		Token:     token.Hex(),
+	}
+}
+
+// GetSigners returns the expected signers for the request.
+func (m RegisterPairRequest) GetSigners() []sdk.AccAddress {
+	return authoritySigners(m.Authority)
+}
+
+// ValidateBasic validates the request.
+func (m *RegisterPairRequest) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.Token) || common.HexToAddress(m.Token) == (common.Address{}) {
+		return errors.Wrapf(ErrInvalidTokenPair, "invalid token address %s", m.Token)
+	}
+
+	return nil
+}
+
+// NewTogglePairRequest creates a new `TogglePairRequest`.
+func NewTogglePairRequest(authority sdk.AccAddress, tokenOrDenom string) *TogglePairRequest {
+	return &TogglePairRequest{
+		Authority:    authority.String(),
+		TokenOrDenom: tokenOrDenom,
+	}
+}
+
+// GetSigners returns the expected signers for the request.
+func (m TogglePairRequest) GetSigners() []sdk.AccAddress {
+	return authoritySigners(m.Authority)
+}
+
+// ValidateBasic validates the request.
+func (m *TogglePairRequest) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	return validateTokenOrDenom(m.TokenOrDenom)
+}
+
+// NewDeregisterPairRequest creates a new `DeregisterPairRequest`.
+func NewDeregisterPairRequest(authority sdk.AccAddress, tokenOrDenom string) *DeregisterPairRequest {
+	return &DeregisterPairRequest{
+		Authority:    authority.String(),
+		TokenOrDenom: tokenOrDenom,
+	}
+}
+
+// GetSigners returns the expected signers for the request.
+func (m DeregisterPairRequest) GetSigners() []sdk.AccAddress {
+	return authoritySigners(m.Authority)
+}
+
+// ValidateBasic validates the request.
+func (m *DeregisterPairRequest) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	return validateTokenOrDenom(m.TokenOrDenom)
+}
+
+// authoritySigners returns the authority as the only signer of a request.
+func authoritySigners(authority string) []sdk.AccAddress {
+	//#nosec G703: // purposely leave error unhandled.
+	addr, _ := sdk.AccAddressFromBech32(authority)
+	return []sdk.AccAddress{addr}
+}
+
+// validateAuthority validates the authority address of a request.
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	return nil
+}
+
+// validateTokenOrDenom validates that a token pair is identified by a token address or a denom.
+func validateTokenOrDenom(tokenOrDenom string) error {
+	if tokenOrDenom == "" {
+		return errors.Wrap(ErrInvalidTokenPair, "empty token address or denom")
+	}
+	return nil
+}
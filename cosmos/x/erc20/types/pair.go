@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/lib/errors"
+)
+
+// NewTokenPair returns a new token pair for the given ERC20 token address and SDK coin
+// denomination. The origin of the pair is derived from the denomination.
+func NewTokenPair(address common.Address, denom string) TokenPair {
+	origin := TokenOriginCoin
+	if IsGridironDenom(denom) {
+		origin = TokenOriginERC20
+	}
+	return TokenPair{
+		Denom:   denom,
+		Address: address.Hex(),
+		Origin:  origin,
+	}
+}
+
+// TokenAddress returns the ERC20 token address of the pair.
+func (tp TokenPair) TokenAddress() common.Address {
+	return common.HexToAddress(tp.Address)
+}
+
+// Validate returns an error if the token pair is malformed.
+func (tp TokenPair) Validate() error {
+	if !common.IsHexAddress(tp.Address) || tp.TokenAddress() == (common.Address{}) {
+		return errors.Wrapf(ErrInvalidTokenPair, "invalid token address %s", tp.Address)
+	}
+
+	switch tp.Origin {
+	case TokenOriginERC20:
+		// ERC20 originated tokens must be paired with the Gridiron denom of the token address.
+		if !IsGridironDenom(tp.Denom) || tp.Denom != NewGridironDenomForAddress(tp.TokenAddress()) {
+			return errors.Wrapf(
+				ErrInvalidTokenPair, "denom %s does not match token %s", tp.Denom, tp.Address,
+			)
+		}
+	case TokenOriginCoin:
+		if err := sdk.ValidateDenom(tp.Denom); err != nil {
+			return errors.Wrap(ErrInvalidTokenPair, err.Error())
+		}
+		if IsGridironDenom(tp.Denom) {
+			return errors.Wrapf(
+				ErrInvalidTokenPair, "coin originated denom %s is a Gridiron denom", tp.Denom,
+			)
+		}
+	default:
+		return errors.Wrapf(ErrInvalidTokenPair, "invalid origin %s", tp.Origin)
+	}
+
+	return nil
+}
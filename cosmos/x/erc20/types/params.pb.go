@@ -24,13 +24,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // `Params` defines the parameters for the x/erc20 module.
 type Params struct {
+	// `enable_allowlist` restricts the conversion of ERC20 originated tokens to the token pairs
+	// registered by governance. When disabled, the pair of an ERC20 token is registered on its
+	// first conversion. Coin originated pairs are always registered on first conversion, as their
+	// `GridironERC20` tokens are deployed by the module.
+	EnableAllowlist bool `protobuf:"varint,1,opt,name=enable_allowlist,json=enableAllowlist,proto3" json:"enable_allowlist,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4e561ad272007ba, []int{0}
+	return fileDescriptor_0967c50f7a629ab2, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -59,26 +64,35 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEnableAllowlist() bool {
+	if m != nil {
+		return m.EnableAllowlist
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gridiron.erc20.v1alpha1.Params")
 }
 
 func init() {
-	proto.RegisterFile("gridiron/erc20/v1alpha1/params.proto", fileDescriptor_d4e561ad272007ba)
+	proto.RegisterFile("gridiron/erc20/v1alpha1/params.proto", fileDescriptor_0967c50f7a629ab2)
 }
 
-var fileDescriptor_d4e561ad272007ba = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0xc8, 0xcf, 0x49,
-	0x2c, 0xca, 0x2c, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8,
-	0x48, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x83, 0x29, 0x52, 0xe2, 0xe0, 0x62, 0x0b, 0x00, 0xab,
-	0x73, 0xf2, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0x82, 0xec,
-	0x74, 0xbd, 0xa4, 0xd4, 0xa2, 0xc4, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0xbd, 0x94, 0xd4, 0x32, 0x7d,
-	0x98, 0x95, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x15, 0x50, 0xbb, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x56, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x5c, 0x49, 0x18,
-	0x99, 0x00, 0x00, 0x00,
+var fileDescriptor_0967c50f7a629ab2 = []byte{
+	// 179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2f, 0xca, 0x4c,
+	0xc9, 0x2c, 0xca, 0xcf, 0xd3, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29,
+	0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x12, 0x87, 0xa9, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xa9, 0x52, 0x32, 0xe6, 0x62, 0x0b, 0x00,
+	0x2b, 0x14, 0xd2, 0xe4, 0x12, 0x48, 0xcd, 0x4b, 0x4c, 0xca, 0x49, 0x8d, 0x4f, 0xcc, 0xc9, 0xc9,
+	0x2f, 0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x08, 0xe2, 0x87, 0x88, 0x3b,
+	0xc2, 0x84, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
+	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0xbf,
+	0x20, 0x3b, 0x5d, 0x2f, 0xad, 0xb4, 0xa8, 0x32, 0x39, 0x23, 0x31, 0x33, 0x4f, 0x2f, 0x25, 0xb5,
+	0x4c, 0x1f, 0xee, 0xbe, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0x0a, 0xa8, 0x43, 0x4b, 0x2a,
+	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xee, 0x33, 0x06, 0x0c, 0x00, 0x3b, 0x62, 0xd7, 0x0f, 0xc7,
+	0x00, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -101,6 +115,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableAllowlist {
+		i--
+		if m.EnableAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -121,6 +145,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EnableAllowlist {
+		n += 2
+	}
 	return n
 }
 
@@ -159,6 +186,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAllowlist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
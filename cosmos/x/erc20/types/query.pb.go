@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -30,6 +33,87 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ERC20AddressForCoinDenomRequest is the request type for the Query/ERC20AddressForCoinDenom RPC method.
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{0}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	// params are the parameters of the x/erc20 module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{1}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 type ERC20AddressForCoinDenomRequest struct {
 	// denom is the SDK coin denomination to query the address of the ERC20 token for.
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -39,7 +123,7 @@ func (m *ERC20AddressForCoinDenomRequest) Reset()         { *m = ERC20AddressFor
 func (m *ERC20AddressForCoinDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20AddressForCoinDenomRequest) ProtoMessage()    {}
 func (*ERC20AddressForCoinDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{0}
+	return fileDescriptor_08a64c09e4a55990, []int{2}
 }
 func (m *ERC20AddressForCoinDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +169,7 @@ func (m *ERC20AddressForCoinDenomResponse) Reset()         { *m = ERC20AddressFo
 func (m *ERC20AddressForCoinDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20AddressForCoinDenomResponse) ProtoMessage()    {}
 func (*ERC20AddressForCoinDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{1}
+	return fileDescriptor_08a64c09e4a55990, []int{3}
 }
 func (m *ERC20AddressForCoinDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +215,7 @@ func (m *CoinDenomForERC20AddressRequest) Reset()         { *m = CoinDenomForERC
 func (m *CoinDenomForERC20AddressRequest) String() string { return proto.CompactTextString(m) }
 func (*CoinDenomForERC20AddressRequest) ProtoMessage()    {}
 func (*CoinDenomForERC20AddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{2}
+	return fileDescriptor_08a64c09e4a55990, []int{4}
 }
 func (m *CoinDenomForERC20AddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +261,7 @@ func (m *CoinDenomForERC20AddressResponse) Reset()         { *m = CoinDenomForER
 func (m *CoinDenomForERC20AddressResponse) String() string { return proto.CompactTextString(m) }
 func (*CoinDenomForERC20AddressResponse) ProtoMessage()    {}
 func (*CoinDenomForERC20AddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{3}
+	return fileDescriptor_08a64c09e4a55990, []int{5}
 }
 func (m *CoinDenomForERC20AddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,43 +297,385 @@ func (m *CoinDenomForERC20AddressResponse) GetDenom() string {
 	return ""
 }
 
+// PairsRequest is the request type for the Query/Pairs RPC method.
+type PairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PairsRequest) Reset()         { *m = PairsRequest{} }
+func (m *PairsRequest) String() string { return proto.CompactTextString(m) }
+func (*PairsRequest) ProtoMessage()    {}
+func (*PairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{6}
+}
+func (m *PairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairsRequest.Merge(m, src)
+}
+func (m *PairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairsRequest proto.InternalMessageInfo
+
+func (m *PairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PairsResponse is the response type for the Query/Pairs RPC method.
+type PairsResponse struct {
+	// pairs is the page of SDK coin <> ERC20 token pairs, ordered by ERC20 token address.
+	Pairs []TokenPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PairsResponse) Reset()         { *m = PairsResponse{} }
+func (m *PairsResponse) String() string { return proto.CompactTextString(m) }
+func (*PairsResponse) ProtoMessage()    {}
+func (*PairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{7}
+}
+func (m *PairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairsResponse.Merge(m, src)
+}
+func (m *PairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairsResponse proto.InternalMessageInfo
+
+func (m *PairsResponse) GetPairs() []TokenPair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *PairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PairRequest is the request type for the Query/Pair RPC method.
+type PairRequest struct {
+	// token_or_denom is either the hex or bech32 address of the ERC20 token, or the SDK coin
+	// denomination of the pair.
+	TokenOrDenom string `protobuf:"bytes,1,opt,name=token_or_denom,json=tokenOrDenom,proto3" json:"token_or_denom,omitempty"`
+}
+
+func (m *PairRequest) Reset()         { *m = PairRequest{} }
+func (m *PairRequest) String() string { return proto.CompactTextString(m) }
+func (*PairRequest) ProtoMessage()    {}
+func (*PairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{8}
+}
+func (m *PairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairRequest.Merge(m, src)
+}
+func (m *PairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairRequest proto.InternalMessageInfo
+
+func (m *PairRequest) GetTokenOrDenom() string {
+	if m != nil {
+		return m.TokenOrDenom
+	}
+	return ""
+}
+
+// PairResponse is the response type for the Query/Pair RPC method.
+type PairResponse struct {
+	// pair is the SDK coin <> ERC20 token pair.
+	Pair TokenPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+}
+
+func (m *PairResponse) Reset()         { *m = PairResponse{} }
+func (m *PairResponse) String() string { return proto.CompactTextString(m) }
+func (*PairResponse) ProtoMessage()    {}
+func (*PairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{9}
+}
+func (m *PairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairResponse.Merge(m, src)
+}
+func (m *PairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairResponse proto.InternalMessageInfo
+
+func (m *PairResponse) GetPair() TokenPair {
+	if m != nil {
+		return m.Pair
+	}
+	return TokenPair{}
+}
+
+// TokenInfoRequest is the request type for the Query/TokenInfo RPC method.
+type TokenInfoRequest struct {
+	// token is the hex or bech32 address of the ERC20 token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *TokenInfoRequest) Reset()         { *m = TokenInfoRequest{} }
+func (m *TokenInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenInfoRequest) ProtoMessage()    {}
+func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{10}
+}
+func (m *TokenInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfoRequest.Merge(m, src)
+}
+func (m *TokenInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfoRequest proto.InternalMessageInfo
+
+func (m *TokenInfoRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// TokenInfoResponse is the response type for the Query/TokenInfo RPC method.
+type TokenInfoResponse struct {
+	// name is the name of the ERC20 token.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the symbol of the ERC20 token.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the number of decimals of the ERC20 token.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// total_supply is the total supply of the ERC20 token.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *TokenInfoResponse) Reset()         { *m = TokenInfoResponse{} }
+func (m *TokenInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenInfoResponse) ProtoMessage()    {}
+func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08a64c09e4a55990, []int{11}
+}
+func (m *TokenInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfoResponse.Merge(m, src)
+}
+func (m *TokenInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfoResponse proto.InternalMessageInfo
+
+func (m *TokenInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenInfoResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenInfoResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "gridiron.erc20.v1alpha1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "gridiron.erc20.v1alpha1.ParamsResponse")
 	proto.RegisterType((*ERC20AddressForCoinDenomRequest)(nil), "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomRequest")
 	proto.RegisterType((*ERC20AddressForCoinDenomResponse)(nil), "gridiron.erc20.v1alpha1.ERC20AddressForCoinDenomResponse")
 	proto.RegisterType((*CoinDenomForERC20AddressRequest)(nil), "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressRequest")
 	proto.RegisterType((*CoinDenomForERC20AddressResponse)(nil), "gridiron.erc20.v1alpha1.CoinDenomForERC20AddressResponse")
+	proto.RegisterType((*PairsRequest)(nil), "gridiron.erc20.v1alpha1.PairsRequest")
+	proto.RegisterType((*PairsResponse)(nil), "gridiron.erc20.v1alpha1.PairsResponse")
+	proto.RegisterType((*PairRequest)(nil), "gridiron.erc20.v1alpha1.PairRequest")
+	proto.RegisterType((*PairResponse)(nil), "gridiron.erc20.v1alpha1.PairResponse")
+	proto.RegisterType((*TokenInfoRequest)(nil), "gridiron.erc20.v1alpha1.TokenInfoRequest")
+	proto.RegisterType((*TokenInfoResponse)(nil), "gridiron.erc20.v1alpha1.TokenInfoResponse")
 }
 
 func init() {
-	proto.RegisterFile("gridiron/erc20/v1alpha1/query.proto", fileDescriptor_ffd29a6c0ff3273b)
-}
-
-var fileDescriptor_ffd29a6c0ff3273b = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x4e, 0xea, 0x40,
-	0x18, 0x85, 0xe9, 0xbd, 0xe1, 0x26, 0x77, 0xe2, 0xc6, 0x46, 0x0d, 0x12, 0x53, 0x48, 0x57, 0x26,
-	0xc6, 0x19, 0xc0, 0x05, 0x6c, 0x01, 0x25, 0xae, 0x34, 0xd6, 0x9d, 0x9b, 0x66, 0x68, 0x47, 0x68,
-	0x80, 0xf9, 0xcb, 0x4c, 0x21, 0xb2, 0xf5, 0x09, 0x4c, 0x7c, 0x01, 0x1f, 0xc2, 0x67, 0x30, 0x2e,
-	0x89, 0x6e, 0x5c, 0x1a, 0xf0, 0x41, 0x0c, 0x33, 0x85, 0x68, 0x42, 0xd3, 0xb0, 0xfc, 0xd3, 0x73,
-	0x4e, 0xcf, 0xf7, 0xcf, 0x0c, 0xb2, 0x43, 0xe8, 0x53, 0x11, 0x48, 0xc2, 0x84, 0x57, 0x29, 0x91,
-	0x71, 0x99, 0xf6, 0xc3, 0x2e, 0x2d, 0x93, 0xe1, 0x88, 0x89, 0x09, 0x0e, 0x05, 0x44, 0x60, 0xee,
-	0xc5, 0x1a, 0xac, 0x34, 0x78, 0xa9, 0xc9, 0xef, 0x7b, 0x20, 0x07, 0x20, 0x5d, 0xa5, 0x22, 0x7a,
-	0xd0, 0x96, 0xfc, 0x41, 0x07, 0xa0, 0xd3, 0x67, 0x84, 0x86, 0x01, 0xa1, 0x9c, 0x43, 0x44, 0xa3,
-	0x00, 0x78, 0xfc, 0xd5, 0xae, 0xa2, 0xc2, 0x99, 0xd3, 0xac, 0x94, 0xea, 0xbe, 0x2f, 0x98, 0x94,
-	0x2d, 0x10, 0x4d, 0x08, 0xf8, 0x29, 0xe3, 0x30, 0x70, 0xd8, 0x70, 0xc4, 0x64, 0x64, 0xee, 0xa0,
-	0xac, 0xbf, 0x98, 0x73, 0x46, 0xd1, 0x38, 0xfc, 0xef, 0xe8, 0xc1, 0xbe, 0x44, 0xc5, 0x64, 0xa3,
-	0x0c, 0x81, 0x4b, 0x66, 0x1e, 0xa1, 0x6c, 0x04, 0x3d, 0xc6, 0xb5, 0xb3, 0xb1, 0xfb, 0xf6, 0x7c,
-	0xbc, 0x1d, 0x77, 0xab, 0x7b, 0x5e, 0xec, 0x74, 0xb4, 0xc6, 0xbe, 0x40, 0x85, 0x55, 0x42, 0x0b,
-	0xc4, 0xcf, 0xf0, 0x65, 0x93, 0x8d, 0xf2, 0x6a, 0xa8, 0x98, 0x9c, 0x17, 0x17, 0x5c, 0x8b, 0x56,
-	0x79, 0xfa, 0x8b, 0xb6, 0xae, 0x16, 0x4b, 0xbf, 0x66, 0x62, 0x1c, 0x78, 0xcc, 0x7c, 0x31, 0x50,
-	0x2e, 0x09, 0xd6, 0xac, 0xe2, 0xf5, 0x67, 0x82, 0x53, 0xf6, 0x9a, 0xaf, 0x6d, 0x6e, 0xd4, 0xb5,
-	0xed, 0xea, 0xfd, 0xfb, 0xd7, 0xe3, 0x9f, 0xb2, 0x49, 0x48, 0xc2, 0x95, 0x51, 0xa3, 0x4b, 0x75,
-	0x84, 0x7b, 0x0b, 0xc2, 0x55, 0x64, 0x0a, 0x24, 0x69, 0x29, 0xc9, 0x20, 0x29, 0xc7, 0x92, 0x0c,
-	0x92, 0xb6, 0xff, 0x74, 0x10, 0x55, 0x5b, 0x01, 0xfc, 0x42, 0x6a, 0x9c, 0xbf, 0xce, 0x2c, 0x63,
-	0x3a, 0xb3, 0x8c, 0xcf, 0x99, 0x65, 0x3c, 0xcc, 0xad, 0xcc, 0x74, 0x6e, 0x65, 0x3e, 0xe6, 0x56,
-	0xe6, 0x06, 0x87, 0xbd, 0x0e, 0x6e, 0x33, 0x41, 0xbd, 0x2e, 0x0d, 0x38, 0xf6, 0xd9, 0x78, 0x95,
-	0xad, 0xaf, 0x0a, 0xb9, 0x8b, 0x7f, 0x12, 0x4d, 0x42, 0x26, 0xdb, 0xff, 0xd4, 0x3b, 0x38, 0xf9,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xa9, 0x2d, 0x4d, 0x7e, 0x03, 0x00, 0x00,
+	proto.RegisterFile("gridiron/erc20/v1alpha1/query.proto", fileDescriptor_08a64c09e4a55990)
+}
+
+var fileDescriptor_08a64c09e4a55990 = []byte{
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x21, 0xc9, 0x2e, 0x2f, 0x81, 0x5d, 0x46, 0xb0, 0x9b, 0xb5, 0x76, 0x93, 0xac, 0x21,
+	0x21, 0x0b, 0xc2, 0x26, 0xe1, 0xb0, 0x20, 0xed, 0x56, 0x02, 0x5a, 0xaa, 0x48, 0x15, 0x50, 0x53,
+	0xf5, 0xd0, 0x4b, 0x34, 0x49, 0x26, 0xc6, 0x22, 0xf1, 0x18, 0xdb, 0x89, 0x9a, 0x1e, 0x7a, 0xe0,
+	0x0f, 0xb4, 0x52, 0x2f, 0xfd, 0x0f, 0xbd, 0x72, 0xe9, 0x3f, 0xa0, 0x37, 0x44, 0x2f, 0x55, 0x0f,
+	0xa8, 0x82, 0xfe, 0x90, 0xca, 0x33, 0xe3, 0x10, 0xa4, 0xda, 0xa1, 0xdc, 0xf2, 0xc6, 0xef, 0xfb,
+	0xde, 0xf7, 0xbe, 0x79, 0xf3, 0x00, 0xe6, 0x0c, 0xc7, 0x6c, 0x9a, 0x0e, 0xb5, 0x34, 0xe2, 0x34,
+	0x2a, 0x2b, 0x5a, 0xaf, 0x8c, 0xdb, 0xf6, 0x01, 0x2e, 0x6b, 0x47, 0x5d, 0xe2, 0xf4, 0x55, 0xdb,
+	0xa1, 0x1e, 0x45, 0xbf, 0x07, 0x49, 0x2a, 0x4b, 0x52, 0x83, 0x24, 0x79, 0xb1, 0x41, 0xdd, 0x0e,
+	0x75, 0xb5, 0x3a, 0x76, 0x09, 0x47, 0x68, 0xbd, 0x72, 0x9d, 0x78, 0xb8, 0xac, 0xd9, 0xd8, 0x30,
+	0x2d, 0xec, 0x99, 0xd4, 0xe2, 0x24, 0xf2, 0x1f, 0x3c, 0xb7, 0xc6, 0x22, 0x8d, 0x07, 0xe2, 0xd3,
+	0x8c, 0x41, 0x0d, 0xca, 0xcf, 0xfd, 0x5f, 0xe2, 0xf4, 0x4f, 0x83, 0x52, 0xa3, 0x4d, 0x34, 0x6c,
+	0x9b, 0x1a, 0xb6, 0x2c, 0xea, 0x31, 0xb6, 0x00, 0x13, 0x2a, 0x9c, 0x4b, 0xe4, 0x49, 0xf3, 0x61,
+	0x49, 0x36, 0x76, 0x70, 0x47, 0x50, 0x29, 0xbf, 0xc0, 0xe4, 0x1e, 0x8b, 0x75, 0x72, 0xd4, 0x25,
+	0xae, 0xa7, 0xec, 0xc2, 0x54, 0x70, 0xe0, 0xda, 0xd4, 0x72, 0x09, 0xfa, 0x1f, 0x92, 0x1c, 0x92,
+	0x91, 0xf2, 0x52, 0x29, 0x55, 0xc9, 0xa9, 0x21, 0x96, 0xa8, 0x1c, 0xb8, 0x19, 0x3f, 0xbd, 0xc8,
+	0xc5, 0x74, 0x01, 0x52, 0xfe, 0x85, 0xdc, 0x03, 0x7d, 0xab, 0xb2, 0xb2, 0xd1, 0x6c, 0x3a, 0xc4,
+	0x75, 0xb7, 0xa9, 0xb3, 0x45, 0x4d, 0xeb, 0x3e, 0xb1, 0x68, 0x47, 0xd4, 0x44, 0x33, 0x90, 0x68,
+	0xfa, 0x31, 0x2b, 0x30, 0xa1, 0xf3, 0x40, 0xd9, 0x85, 0x7c, 0x38, 0x50, 0x68, 0x5b, 0x82, 0x84,
+	0x47, 0x0f, 0x89, 0xc5, 0x91, 0x9b, 0xb3, 0xe7, 0x27, 0xcb, 0xd3, 0xc2, 0xde, 0x8d, 0x46, 0x43,
+	0x20, 0x75, 0x9e, 0xa3, 0xec, 0x40, 0x6e, 0xc0, 0xb0, 0x4d, 0x9d, 0x61, 0xf2, 0x40, 0xc9, 0x0f,
+	0xf1, 0xad, 0x41, 0x3e, 0x9c, 0x4f, 0x08, 0xfc, 0x7e, 0x6b, 0x4f, 0x21, 0xbd, 0x87, 0x4d, 0x67,
+	0x50, 0x76, 0x1b, 0xe0, 0x7a, 0x66, 0x84, 0xcd, 0x45, 0x55, 0x14, 0xf6, 0x07, 0x4c, 0xe5, 0x23,
+	0x29, 0x06, 0x4c, 0xdd, 0xc3, 0x06, 0x11, 0x58, 0x7d, 0x08, 0xa9, 0xbc, 0x95, 0x60, 0x52, 0x10,
+	0x8b, 0xfa, 0xf7, 0x20, 0x61, 0xfb, 0x07, 0x19, 0x29, 0x3f, 0x5e, 0x4a, 0x55, 0x94, 0xd0, 0xbb,
+	0x7b, 0xe2, 0xb7, 0xe4, 0x63, 0xc5, 0xf5, 0x71, 0x18, 0x7a, 0x78, 0x43, 0xd9, 0x18, 0x53, 0xb6,
+	0x30, 0x52, 0x19, 0x2f, 0x7e, 0x43, 0xda, 0x2a, 0xa4, 0x7c, 0xf6, 0xa0, 0xe3, 0x79, 0x98, 0x62,
+	0x26, 0xd6, 0xa8, 0x53, 0x1b, 0x36, 0x28, 0xcd, 0x4e, 0x77, 0x1d, 0x66, 0xaa, 0xf2, 0x88, 0xfb,
+	0x34, 0xe8, 0xe6, 0x3f, 0x88, 0xfb, 0xb2, 0x84, 0x43, 0xb7, 0x6f, 0x86, 0xa1, 0x94, 0x12, 0xfc,
+	0xca, 0x3e, 0x54, 0xad, 0x16, 0x1d, 0x1a, 0xbd, 0xa1, 0x0b, 0x0f, 0x6e, 0xf6, 0x9d, 0x04, 0xd3,
+	0x43, 0xa9, 0xa2, 0x3a, 0x82, 0xb8, 0x85, 0x3b, 0x44, 0xa4, 0xb2, 0xdf, 0xe8, 0x37, 0x48, 0xba,
+	0xfd, 0x4e, 0x9d, 0xb6, 0x99, 0x37, 0x13, 0xba, 0x88, 0x90, 0x0c, 0x3f, 0x37, 0x49, 0xc3, 0xec,
+	0xe0, 0xb6, 0x9b, 0x19, 0xcf, 0x4b, 0xa5, 0x49, 0x7d, 0x10, 0xa3, 0x1d, 0x48, 0x7b, 0xd4, 0xc3,
+	0xed, 0x9a, 0xdb, 0xb5, 0xed, 0x76, 0x3f, 0x13, 0x67, 0xb3, 0xb6, 0xe4, 0x2b, 0xfd, 0x7c, 0x91,
+	0x9b, 0xe5, 0xe6, 0xba, 0xcd, 0x43, 0xd5, 0xa4, 0x5a, 0x07, 0x7b, 0x07, 0x6a, 0xd5, 0xf2, 0xce,
+	0x4f, 0x96, 0x41, 0xb8, 0x5e, 0xb5, 0x3c, 0x3d, 0xc5, 0x08, 0xf6, 0x19, 0xbe, 0xf2, 0xfe, 0x27,
+	0x48, 0x3f, 0xf6, 0x6f, 0x61, 0x9f, 0x38, 0x3d, 0xb3, 0x41, 0xd0, 0xb1, 0x04, 0x49, 0xfe, 0x16,
+	0x51, 0x71, 0xc4, 0x63, 0x15, 0x3e, 0xc8, 0x0b, 0x23, 0xf3, 0xb8, 0x09, 0xca, 0xc2, 0xf1, 0xc7,
+	0xaf, 0x6f, 0xc6, 0xfe, 0x46, 0x39, 0x2d, 0x7a, 0xbf, 0xa0, 0x0f, 0x12, 0x64, 0xc2, 0xde, 0x2f,
+	0x5a, 0x0b, 0x2d, 0x37, 0x62, 0x57, 0xc8, 0xeb, 0x77, 0x40, 0x0a, 0xe9, 0x6b, 0x4c, 0x7a, 0x05,
+	0xad, 0x68, 0x91, 0xfb, 0xb3, 0x86, 0x39, 0x47, 0xad, 0x15, 0xcc, 0x26, 0xeb, 0x25, 0xec, 0xa9,
+	0x47, 0xf4, 0x32, 0x62, 0xdb, 0xc8, 0xeb, 0x77, 0x40, 0xde, 0xba, 0x17, 0xa6, 0x9c, 0xf5, 0x70,
+	0xa3, 0x2b, 0xf4, 0x12, 0x12, 0x6c, 0x45, 0xa0, 0x42, 0xc4, 0x95, 0x5f, 0xef, 0x26, 0xb9, 0x38,
+	0x2a, 0x4d, 0x28, 0x2a, 0x32, 0x45, 0x79, 0x94, 0x8d, 0x18, 0x0c, 0xbf, 0xec, 0x0b, 0x88, 0xfb,
+	0x40, 0x34, 0x1f, 0xc9, 0x1b, 0x54, 0x2f, 0x8c, 0xc8, 0x12, 0xc5, 0x0b, 0xac, 0x78, 0x0e, 0xfd,
+	0x15, 0x59, 0x1c, 0xbd, 0x92, 0x60, 0x62, 0xf0, 0xae, 0xd1, 0x3f, 0xd1, 0xfb, 0x63, 0x68, 0x4d,
+	0xc8, 0x8b, 0xb7, 0x49, 0x15, 0x5a, 0x96, 0x98, 0x96, 0x02, 0x9a, 0x0b, 0xd5, 0xc2, 0x37, 0x9f,
+	0x69, 0xb5, 0xe8, 0x66, 0xf5, 0xf4, 0x32, 0x2b, 0x9d, 0x5d, 0x66, 0xa5, 0x2f, 0x97, 0x59, 0xe9,
+	0xf5, 0x55, 0x36, 0x76, 0x76, 0x95, 0x8d, 0x7d, 0xba, 0xca, 0xc6, 0x9e, 0x69, 0xf6, 0xa1, 0xa1,
+	0xb6, 0xba, 0x4e, 0xbf, 0x71, 0x80, 0x4d, 0x4b, 0x6d, 0x92, 0xde, 0x35, 0x9f, 0xf8, 0xd7, 0xe3,
+	0xb9, 0x20, 0xf6, 0xfa, 0x36, 0x71, 0xeb, 0x49, 0xf6, 0x17, 0x7d, 0xf5, 0xdb, 0x00, 0x4e, 0xa9,
+	0x4c, 0x70, 0xd7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -264,10 +690,19 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
+	// Params queries the parameters of the x/erc20 module.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 	// ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 	ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(ctx context.Context, in *CoinDenomForERC20AddressRequest, opts ...grpc.CallOption) (*CoinDenomForERC20AddressResponse, error)
+	// Pairs queries all registered SDK coin <> ERC20 token pairs.
+	Pairs(ctx context.Context, in *PairsRequest, opts ...grpc.CallOption) (*PairsResponse, error)
+	// Pair queries the SDK coin <> ERC20 token pair of a given ERC20 token address or SDK coin
+	// denomination.
+	Pair(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*PairResponse, error)
+	// TokenInfo queries the ERC20 metadata of a given ERC20 token, read from the EVM.
+	TokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error)
 }
 
 type queryServiceClient struct {
@@ -278,6 +713,15 @@ func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
 	return &queryServiceClient{cc}
 }
 
+func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.erc20.v1alpha1.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error) {
 	out := new(ERC20AddressForCoinDenomResponse)
 	err := c.cc.Invoke(ctx, "/gridiron.erc20.v1alpha1.QueryService/ERC20AddressForCoinDenom", in, out, opts...)
@@ -296,49 +740,115 @@ func (c *queryServiceClient) CoinDenomForERC20Address(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *queryServiceClient) Pairs(ctx context.Context, in *PairsRequest, opts ...grpc.CallOption) (*PairsResponse, error) {
+	out := new(PairsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.erc20.v1alpha1.QueryService/Pairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Pair(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*PairResponse, error) {
+	out := new(PairResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.erc20.v1alpha1.QueryService/Pair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error) {
+	out := new(TokenInfoResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.erc20.v1alpha1.QueryService/TokenInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
+	// Params queries the parameters of the x/erc20 module.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	// ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 	ERC20AddressForCoinDenom(context.Context, *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error)
+	// Pairs queries all registered SDK coin <> ERC20 token pairs.
+	Pairs(context.Context, *PairsRequest) (*PairsResponse, error)
+	// Pair queries the SDK coin <> ERC20 token pair of a given ERC20 token address or SDK coin
+	// denomination.
+	Pair(context.Context, *PairRequest) (*PairResponse, error)
+	// TokenInfo queries the ERC20 metadata of a given ERC20 token, read from the EVM.
+	TokenInfo(context.Context, *TokenInfoRequest) (*TokenInfoResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServiceServer struct {
 }
 
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServiceServer) ERC20AddressForCoinDenom(ctx context.Context, req *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ERC20AddressForCoinDenom not implemented")
 }
 func (*UnimplementedQueryServiceServer) CoinDenomForERC20Address(ctx context.Context, req *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinDenomForERC20Address not implemented")
 }
+func (*UnimplementedQueryServiceServer) Pairs(ctx context.Context, req *PairsRequest) (*PairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pairs not implemented")
+}
+func (*UnimplementedQueryServiceServer) Pair(ctx context.Context, req *PairRequest) (*PairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pair not implemented")
+}
+func (*UnimplementedQueryServiceServer) TokenInfo(ctx context.Context, req *TokenInfoRequest) (*TokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
 }
 
-func _QueryService_ERC20AddressForCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ERC20AddressForCoinDenomRequest)
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).ERC20AddressForCoinDenom(ctx, in)
+		return srv.(QueryServiceServer).Params(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/ERC20AddressForCoinDenom",
+		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).ERC20AddressForCoinDenom(ctx, req.(*ERC20AddressForCoinDenomRequest))
+		return srv.(QueryServiceServer).Params(ctx, req.(*ParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CoinDenomForERC20AddressRequest)
+func _QueryService_ERC20AddressForCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20AddressForCoinDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ERC20AddressForCoinDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/ERC20AddressForCoinDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ERC20AddressForCoinDenom(ctx, req.(*ERC20AddressForCoinDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinDenomForERC20AddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -355,10 +865,68 @@ func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Pairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/Pairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pairs(ctx, req.(*PairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Pair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/Pair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pair(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.erc20.v1alpha1.QueryService/TokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TokenInfo(ctx, req.(*TokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.erc20.v1alpha1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
 		{
 			MethodName: "ERC20AddressForCoinDenom",
 			Handler:    _QueryService_ERC20AddressForCoinDenom_Handler,
@@ -367,11 +935,79 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CoinDenomForERC20Address",
 			Handler:    _QueryService_CoinDenomForERC20Address_Handler,
 		},
+		{
+			MethodName: "Pairs",
+			Handler:    _QueryService_Pairs_Handler,
+		},
+		{
+			MethodName: "Pair",
+			Handler:    _QueryService_Pair_Handler,
+		},
+		{
+			MethodName: "TokenInfo",
+			Handler:    _QueryService_TokenInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/erc20/v1alpha1/query.proto",
 }
 
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20AddressForCoinDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -492,76 +1128,1083 @@ func (m *CoinDenomForERC20AddressResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ERC20AddressForCoinDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOrDenom) > 0 {
+		i -= len(m.TokenOrDenom)
+		copy(dAtA[i:], m.TokenOrDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOrDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ERC20AddressForCoinDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20AddressForCoinDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CoinDenomForERC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CoinDenomForERC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenOrDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TokenInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ERC20AddressForCoinDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *CoinDenomForERC20AddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *PairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, TokenPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CoinDenomForERC20AddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *PairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -584,15 +2227,15 @@ func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOrDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -620,7 +2263,7 @@ func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TokenOrDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -643,7 +2286,7 @@ func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *PairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -666,17 +2309,17 @@ func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -686,23 +2329,24 @@ func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -725,7 +2369,7 @@ func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
+func (m *TokenInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,10 +2392,10 @@ func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -807,7 +2451,7 @@ func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,15 +2474,15 @@ func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,7 +2510,92 @@ func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30,10 +30,18 @@ import (
 	"pkg.furychain.dev/gridiron/lib/errors"
 )
 
+// HandlerOptions are the options required for constructing the Gridiron AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// EVMKeeper provides the EVM denom that the gas of EthTransactions is paid in.
+	EVMKeeper EVMKeeper
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// signer, or from the fee granter.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -46,6 +54,10 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.EVMKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "evm keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -63,6 +75,9 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper,
 				options.FeegrantKeeper, options.TxFeeChecker),
 		),
+		// EthTransactions whose Cosmos transaction names a fee granter have their gas paid for
+		// with the granter's fee allowance.
+		NewEthFeeGrantDecorator(options.FeegrantKeeper, options.EVMKeeper),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		// In order to match ethereum gas consumption, we do not consume any gas when
@@ -86,6 +101,9 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		antelib.NewIgnoreDecorator[ante.IncrementSequenceDecorator, *types.EthTransactionRequest](
 			ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		),
+		// CallContract and DeployContract messages use the sequence incremented above as the EVM
+		// nonce of their signer, rather than incrementing it a second time.
+		NewContractSequenceDecorator(),
 	}
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
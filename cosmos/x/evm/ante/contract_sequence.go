@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/lib/errors"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// ContractSequenceDecorator records the signers of transactions that call or deploy EVM contracts
+// with Cosmos messages. It must run after the IncrementSequenceDecorator.
+//
+// The EVM increments the nonce, which is the account sequence, of the sender of every
+// CallContract and DeployContract message. For the first of these messages of each signer, x/evm
+// uses the nonce already consumed by the IncrementSequenceDecorator instead, so that signing a
+// transaction increments the sequence of its signers only once.
+type ContractSequenceDecorator struct{}
+
+// NewContractSequenceDecorator returns a new ContractSequenceDecorator.
+func NewContractSequenceDecorator() ContractSequenceDecorator {
+	return ContractSequenceDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (ContractSequenceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if !hasContractMsg(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	return next(types.WithSignedSequences(ctx, sigTx.GetSigners()), tx, simulate)
+}
+
+// hasContractMsg returns true if the transaction contains a CallContract or DeployContract
+// message.
+func hasContractMsg(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := utils.GetAs[*types.CallContractRequest](msg); ok {
+			return true
+		}
+		if _, ok := utils.GetAs[*types.DeployContractRequest](msg); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/lib/errors"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// EthFeeGrantDecorator pays for the gas of EthTransactions with the fee allowance of the fee
+// granter named by the outer Cosmos transaction. EthTransactions otherwise skip fee deduction, as
+// the sender buys their gas in the StateTransition.
+//
+// The gas limit of each EthTransaction at its effective gas price is deducted from the granter's
+// allowance, like the fees of a Cosmos transaction. The granter is then recorded in the context,
+// and x/evm has the granter pay for the gas bought by the sender and refunds the gas that was not
+// used to the granter, so that the balance of the sender does not change by the gas payment.
+type EthFeeGrantDecorator struct {
+	fk ante.FeegrantKeeper
+	ek EVMKeeper
+}
+
+// NewEthFeeGrantDecorator returns a new EthFeeGrantDecorator.
+func NewEthFeeGrantDecorator(fk ante.FeegrantKeeper, ek EVMKeeper) EthFeeGrantDecorator {
+	return EthFeeGrantDecorator{
+		fk: fk,
+		ek: ek,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (d EthFeeGrantDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	granter := sdk.AccAddress(feeTx.FeeGranter())
+	for _, msg := range tx.GetMsgs() {
+		if etr, isEthTx := utils.GetAs[*types.EthTransactionRequest](msg); isEthTx {
+			if err := d.useFeeGrant(ctx, granter, etr); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(types.WithFeeGranter(ctx, granter), tx, simulate)
+}
+
+// useFeeGrant deducts the gas cost of the given EthTransaction from the granter's fee allowance.
+func (d EthFeeGrantDecorator) useFeeGrant(
+	ctx sdk.Context, granter sdk.AccAddress, etr *types.EthTransactionRequest,
+) error {
+	if d.fk == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	sender, err := etr.GetSender()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	grantee := sdk.AccAddress(sender.Bytes())
+
+	res, err := d.ek.Params(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	tx := etr.AsTransaction()
+	gasCost := new(big.Int).Mul(d.ek.EffectiveGasPrice(tx), new(big.Int).SetUint64(tx.Gas()))
+	fees := sdk.NewCoins(sdk.NewCoin(res.Params.EvmDenom, sdkmath.NewIntFromBigInt(gasCost)))
+	if fees.IsZero() {
+		return nil
+	}
+
+	if err = d.fk.UseGrantedFees(ctx, granter, grantee, fees, []sdk.Msg{etr}); err != nil {
+		return errors.Wrapf(err, "%s does not allow to pay fees for %s", granter, grantee)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, granter.String()),
+		),
+	)
+	return nil
+}
@@ -52,8 +52,10 @@ type DepInjectInput struct {
 	Mempool           sdkmempool.Mempool
 	CustomPrecompiles func() *ethprecompile.Injector `optional:"true"`
 
-	AccountKeeper AccountKeeper
-	BankKeeper    BankKeeper
+	AccountKeeper      AccountKeeper
+	BankKeeper         BankKeeper
+	StakingKeeper      StakingKeeper      `optional:"true"`
+	DistributionKeeper DistributionKeeper `optional:"true"`
 }
 
 // DepInjectOutput is the output for the dep inject framework.
@@ -81,6 +83,8 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.Key,
 		in.AccountKeeper,
 		in.BankKeeper,
+		in.StakingKeeper,
+		in.DistributionKeeper,
 		authority.String(),
 		in.AppOpts,
 		in.Mempool,
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// staticCallGasLimit is the gas limit of the read-only calls made on behalf of Cosmos queries.
+const staticCallGasLimit = 10_000_000
+
+// StaticCall executes a read-only message call of the contract `to` on behalf of `from`, against
+// the EVM state at the height of the given context, and returns the data returned by the call.
+func (k *Keeper) StaticCall(
+	ctx context.Context, from, to common.Address, input []byte,
+) ([]byte, error) {
+	sdb, err := k.gridiron.GetStateByNumber(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	block, err := k.gridiron.FinalizedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	evm := k.gridiron.GetEVM(
+		ctx,
+		vm.TxContext{Origin: from, GasPrice: new(big.Int)},
+		utils.MustGetAs[vm.GridironStateDB](sdb),
+		block.Header(),
+		&vm.Config{},
+	)
+	ret, _, err := evm.StaticCall(vm.AccountRef(from), to, input, staticCallGasLimit)
+	return ret, err
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// EffectiveGasPrice returns the gas price that the given transaction pays in the block that is
+// being processed. If no block is being processed, the gas fee cap of the transaction is returned.
+func (k *Keeper) EffectiveGasPrice(tx *coretypes.Transaction) *big.Int {
+	baseFee := k.gridiron.CurrentBaseFee()
+	if baseFee == nil {
+		return tx.GasFeeCap()
+	}
+	if price := new(big.Int).Add(tx.GasTipCap(), baseFee); price.Cmp(tx.GasFeeCap()) < 0 {
+		return price
+	}
+	return tx.GasFeeCap()
+}
+
+// buyGasFromGranter sends the gas cost of the given transaction at its effective gas price, which
+// is the amount deducted from the fee allowance in the ante handler, from the fee granter to the
+// sender, which then buys the gas in the StateTransition. It returns the coins sent.
+//
+// Exactly the gas bought by the sender is sent, so that the sender can never spend the coins of
+// the granter. The sender must still hold the difference to the gas fee cap and the value of the
+// transaction, as the StateTransition checks the balance of the sender against both.
+func (k *Keeper) buyGasFromGranter(
+	ctx sdk.Context, granter, sender sdk.AccAddress, tx *coretypes.Transaction,
+) (sdk.Coins, error) {
+	cost := k.gasCost(new(big.Int).Mul(k.EffectiveGasPrice(tx), new(big.Int).SetUint64(tx.Gas())))
+	if err := k.bk.SendCoins(ctx, granter, sender, cost); err != nil {
+		return nil, err
+	}
+	return cost, nil
+}
+
+// refundGasToGranter sends the coins sent by buyGasFromGranter that were not spent on the gas used
+// by the given transaction back to the fee granter, so that the balance of the sender does not
+// change by the gas payment.
+func (k *Keeper) refundGasToGranter(
+	ctx sdk.Context, granter, sender sdk.AccAddress, sent sdk.Coins,
+	tx *coretypes.Transaction, gasUsed uint64,
+) error {
+	spent := k.gasCost(new(big.Int).Mul(k.EffectiveGasPrice(tx), new(big.Int).SetUint64(gasUsed)))
+	refund, hasNeg := sent.SafeSub(spent...)
+	if hasNeg || refund.IsZero() {
+		return nil
+	}
+	return k.bk.SendCoins(ctx, sender, granter, refund)
+}
+
+// gasCost returns the given amount of the EVM denom.
+func (k *Keeper) gasCost(amount *big.Int) sdk.Coins {
+	evmDenom := utils.MustGetAs[configuration.Plugin](k.host.GetConfigurationPlugin()).GetParams().EvmDenom
+	return sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(amount)))
+}
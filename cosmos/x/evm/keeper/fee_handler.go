@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// FeeHandler distributes the base fee paid by EVM transactions. The EVM state transition burns the
+// base fee, so the FeeHandler re-mints the shares that are sent to the community pool or the fee
+// recipient. The remaining share of the base fee stays burned.
+type FeeHandler struct {
+	ak state.AccountKeeper
+	bk state.BankKeeper
+	dk DistributionKeeper
+}
+
+// NewFeeHandler creates a new FeeHandler. The distribution keeper is optional, if nil the community
+// pool share of the base fee is burned.
+func NewFeeHandler(ak state.AccountKeeper, bk state.BankKeeper, dk DistributionKeeper) *FeeHandler {
+	return &FeeHandler{
+		ak: ak,
+		bk: bk,
+		dk: dk,
+	}
+}
+
+// DistributeBaseFee distributes the given, already burned, base fee according to the fee
+// distribution params.
+func (fh *FeeHandler) DistributeBaseFee(
+	ctx sdk.Context, fdp *types.FeeDistributionParams, baseFee sdk.Coin,
+) error {
+	if !baseFee.IsPositive() {
+		return nil
+	}
+
+	// Compute the shares of the base fee, truncating in favor of the burn.
+	amount := sdkmath.LegacyNewDecFromInt(baseFee.Amount)
+	communityPoolFee := sdk.NewCoin(baseFee.Denom, amount.Mul(fdp.CommunityPoolShare()).TruncateInt())
+	recipientFee := sdk.NewCoin(baseFee.Denom, amount.Mul(fdp.RecipientShare()).TruncateInt())
+	if fh.dk == nil {
+		communityPoolFee.Amount = sdkmath.ZeroInt()
+	}
+
+	toMint := communityPoolFee.Add(recipientFee)
+	if !toMint.IsPositive() {
+		return nil
+	}
+	if err := fh.bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(toMint)); err != nil {
+		return err
+	}
+
+	if communityPoolFee.IsPositive() {
+		if err := fh.dk.FundCommunityPool(
+			ctx, sdk.NewCoins(communityPoolFee), fh.ak.GetModuleAddress(types.ModuleName),
+		); err != nil {
+			return err
+		}
+	}
+
+	if recipientFee.IsPositive() {
+		recipient, err := sdk.AccAddressFromBech32(fdp.Recipient)
+		if err != nil {
+			return err
+		}
+		if err = fh.bk.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, recipient, sdk.NewCoins(recipientFee),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// distributeBaseFee distributes the base fee paid for the given amount of gas in the current block.
+func (k *Keeper) distributeBaseFee(ctx sdk.Context, gasUsed uint64) error {
+	baseFee := k.gridiron.CurrentBaseFee()
+	if baseFee == nil || baseFee.Sign() <= 0 || gasUsed == 0 {
+		return nil
+	}
+
+	params := utils.MustGetAs[configuration.Plugin](k.host.GetConfigurationPlugin()).GetParams()
+	fee := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+
+	// Fee distribution is not metered, as the gas of the transaction is already accounted for by
+	// the EVM.
+	return k.feeHandler.DistributeBaseFee(
+		ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+		&params.FeeDistribution,
+		sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(fee)),
+	)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockDistributionKeeper funds the community pool by sending to the distribution module account.
+type mockDistributionKeeper struct {
+	bk bankkeeper.BaseKeeper
+}
+
+func (m *mockDistributionKeeper) FundCommunityPool(
+	ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress,
+) error {
+	return m.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
+
+var _ = Describe("Fee Handler", func() {
+	var (
+		ctx   sdk.Context
+		ak    authkeeper.AccountKeeper
+		bk    bankkeeper.BaseKeeper
+		fh    *keeper.FeeHandler
+		fdp   types.FeeDistributionParams
+		denom = types.DefaultEvmDenom
+	)
+
+	BeforeEach(func() {
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		fh = keeper.NewFeeHandler(ak, bk, &mockDistributionKeeper{bk: bk})
+		fdp = types.DefaultFeeDistributionParams()
+
+		// Simulate the EVM state transition burning a base fee of 100 from Alice.
+		Expect(cosmlib.MintCoinsToAddress(
+			ctx, bk, types.ModuleName, testutil.Alice, denom, big.NewInt(1000),
+		)).To(Succeed())
+		Expect(cosmlib.BurnCoinsFromAddress(
+			ctx, bk, types.ModuleName, testutil.Alice, denom, big.NewInt(100),
+		)).To(Succeed())
+		Expect(bk.GetSupply(ctx, denom).Amount.Int64()).To(Equal(int64(900)))
+	})
+
+	It("should keep the base fee burned by default", func() {
+		Expect(fh.DistributeBaseFee(ctx, &fdp, sdk.NewInt64Coin(denom, 100))).To(Succeed())
+		Expect(bk.GetSupply(ctx, denom).Amount.Int64()).To(Equal(int64(900)))
+	})
+
+	It("should split the base fee between burn, community pool and recipient", func() {
+		fdp.CommunityPoolRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+		fdp.RecipientRatio = sdkmath.LegacyNewDecWithPrec(2, 1)
+		fdp.Recipient = cosmlib.AddressToAccAddress(testutil.Bob).String()
+		Expect(fdp.ValidateBasic()).To(Succeed())
+
+		Expect(fh.DistributeBaseFee(ctx, &fdp, sdk.NewInt64Coin(denom, 100))).To(Succeed())
+
+		// 30% of the base fee stays burned.
+		Expect(bk.GetSupply(ctx, denom).Amount.Int64()).To(Equal(int64(970)))
+		Expect(bk.GetBalance(
+			ctx, ak.GetModuleAddress(distrtypes.ModuleName), denom,
+		).Amount.Int64()).To(Equal(int64(50)))
+		Expect(bk.GetBalance(ctx, testutil.Bob.Bytes(), denom).Amount.Int64()).To(Equal(int64(20)))
+		Expect(bk.GetBalance(
+			ctx, ak.GetModuleAddress(types.ModuleName), denom,
+		).Amount.Int64()).To(BeZero())
+	})
+
+	It("should burn the community pool share without a distribution keeper", func() {
+		fh = keeper.NewFeeHandler(ak, bk, nil)
+		fdp.CommunityPoolRatio = sdkmath.LegacyOneDec()
+
+		Expect(fh.DistributeBaseFee(ctx, &fdp, sdk.NewInt64Coin(denom, 100))).To(Succeed())
+		Expect(bk.GetSupply(ctx, denom).Amount.Int64()).To(Equal(int64(900)))
+	})
+
+	It("should reject invalid fee distribution params", func() {
+		fdp.CommunityPoolRatio = sdkmath.LegacyNewDecWithPrec(8, 1)
+		fdp.RecipientRatio = sdkmath.LegacyNewDecWithPrec(3, 1)
+		Expect(fdp.ValidateBasic()).To(MatchError(types.ErrInvalidFeeDistributionRatio))
+
+		fdp.CommunityPoolRatio = sdkmath.LegacyZeroDec()
+		Expect(fdp.ValidateBasic()).To(MatchError(types.ErrInvalidFeeRecipient))
+	})
+})
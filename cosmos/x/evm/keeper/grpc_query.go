@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+)
+
+// Compile-time interface assertion.
+var _ types.QueryServiceServer = (*Keeper)(nil)
+
+// Params queries the x/evm module parameters.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return &types.ParamsResponse{}, nil
+	}
+
+	var params types.Params
+	if err := params.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+// BaseFee queries the base fee of the latest finalized gridiron block.
+func (k *Keeper) BaseFee(
+	ctx context.Context, _ *types.BaseFeeRequest,
+) (*types.BaseFeeResponse, error) {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get([]byte{types.BaseFeeKey})
+	if bz == nil {
+		return &types.BaseFeeResponse{}, nil
+	}
+	return &types.BaseFeeResponse{BaseFee: new(big.Int).SetBytes(bz).String()}, nil
+}
@@ -57,6 +57,7 @@ type Host interface {
 		state.BankKeeper,
 		func(height int64, prove bool) (sdk.Context, error),
 	)
+	SetDenomPrecompileFactory(precompile.DenomPrecompileFactory)
 }
 
 type host struct {
@@ -70,6 +71,7 @@ type host struct {
 	txp txpool.Plugin
 
 	pcs func() *ethprecompile.Injector
+	dpf precompile.DenomPrecompileFactory
 }
 
 // Newhost creates new instances of the plugin host.
@@ -77,6 +79,7 @@ func NewHost(
 	storeKey storetypes.StoreKey,
 	ak state.AccountKeeper,
 	bk state.BankKeeper,
+	sk StakingKeeper,
 	authority string,
 	appOpts servertypes.AppOptions,
 	ethTxMempool sdkmempool.Mempool,
@@ -86,8 +89,8 @@ func NewHost(
 	h := &host{}
 
 	// Build the Plugins
-	h.bp = block.NewPlugin(storeKey)
 	h.cp = configuration.NewPlugin(storeKey)
+	h.bp = block.NewPlugin(storeKey, h.cp, sk)
 	h.gp = gas.NewPlugin()
 	h.txp = txpool.NewPlugin(h.cp, utils.MustGetAs[*mempool.EthTxPool](ethTxMempool))
 	h.pcs = precompiles
@@ -106,7 +109,7 @@ func (h *host) Setup(
 ) {
 	// Setup the state, precompile, historical, and txpool plugins
 	h.sp = state.NewPlugin(ak, bk, storeKey, h.cp, log.NewFactory(h.pcs().GetPrecompiles()))
-	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp)
+	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp, h.cp, h.dpf)
 	h.hp = historical.NewPlugin(h.bp, offchainStoreKey, storeKey)
 	h.txp.SetNonceRetriever(h.sp)
 
@@ -115,6 +118,12 @@ func (h *host) Setup(
 	h.bp.SetQueryContextFn(qc)
 }
 
+// SetDenomPrecompileFactory sets the factory of the bank ERC20 token precompiles of the
+// denominations listed in the x/evm params. It must be called before Setup.
+func (h *host) SetDenomPrecompileFactory(dpf precompile.DenomPrecompileFactory) {
+	h.dpf = dpf
+}
+
 // GetBlockPlugin returns the header plugin.
 func (h *host) GetBlockPlugin() core.BlockPlugin {
 	return h.bp
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/crypto"
+)
+
+// emptyCodeHash is the code hash of an account without code.
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// RegisterInvariants registers all x/evm invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper, bk BankViewKeeper) {
+	ir.RegisterRoute(types.ModuleName, "storage-code-hash", StorageCodeHashInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "code-hash-code", CodeHashCodeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "evm-denom-supply", EvmDenomSupplyInvariant(k, bk))
+}
+
+// AllInvariants runs all invariants of the x/evm module.
+func AllInvariants(k *Keeper, bk BankViewKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			StorageCodeHashInvariant(k),
+			CodeHashCodeInvariant(k),
+			EvmDenomSupplyInvariant(k, bk),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// StorageCodeHashInvariant checks that every address with storage slots has a code hash.
+func StorageCodeHashInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+			store = ctx.KVStore(k.storeKey)
+		)
+
+		it := storetypes.KVStorePrefixIterator(store, []byte{types.StorageKeyPrefix})
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			addr := state.AddressFromSlotKey(it.Key())
+			if !store.Has(state.CodeHashKeyFor(addr)) {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s has storage slot %s but no code hash\n",
+					addr.Hex(), state.SlotFromSlotKey(it.Key()).Hex(),
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "storage code hash", fmt.Sprintf(
+			"found %d storage slots of addresses without a code hash\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// CodeHashCodeInvariant checks that every (non-empty) code hash points at existing code.
+func CodeHashCodeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+			store = ctx.KVStore(k.storeKey)
+		)
+
+		it := storetypes.KVStorePrefixIterator(store, []byte{types.CodeHashKeyPrefix})
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			codeHash := common.BytesToHash(it.Value())
+			if codeHash != emptyCodeHash && !store.Has(state.CodeKeyFor(codeHash)) {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s has code hash %s but no code\n",
+					state.AddressFromCodeHashKey(it.Key()).Hex(), codeHash.Hex(),
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "code hash code", fmt.Sprintf(
+			"found %d code hashes without code\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// EvmDenomSupplyInvariant checks that the sum of all EVM denom balances equals the bank supply of
+// the EVM denom.
+func EvmDenomSupplyInvariant(k *Keeper, bk BankViewKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, err := k.Params(ctx, &types.ParamsRequest{})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "evm denom supply", err.Error()), true
+		}
+		denom := res.Params.EvmDenom
+
+		sum := sdkmath.ZeroInt()
+		bk.IterateAllBalances(ctx, func(_ sdk.AccAddress, coin sdk.Coin) bool {
+			if coin.Denom == denom {
+				sum = sum.Add(coin.Amount)
+			}
+			return false
+		})
+		supply := bk.GetSupply(ctx, denom)
+
+		return sdk.FormatInvariant(types.ModuleName, "evm denom supply", fmt.Sprintf(
+			"\tsum of %s balances: %s\n\t%s supply: %s\n", denom, sum, denom, supply.Amount,
+		)), !sum.Equal(supply.Amount)
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Invariants", func() {
+	var (
+		k        *keeper.Keeper
+		bk       bankkeeper.BaseKeeper
+		ctx      sdk.Context
+		storeKey = storetypes.NewKVStoreKey("evm")
+		contract = common.BytesToAddress([]byte("contract"))
+		code     = []byte("code")
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			storeKey,
+			ak, bk, nil, nil,
+			"authority",
+			simtestutil.NewAppOptionsWithFlagHome(GinkgoT().TempDir()),
+			evmmempool.NewEthTxPoolFrom(evmmempool.DefaultPriorityMempool()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+
+		params := types.DefaultParams()
+		bz, err := params.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		ctx.KVStore(storeKey).Set([]byte{types.ParamsKey}, bz)
+	})
+
+	It("should not be broken for a consistent state", func() {
+		store := ctx.KVStore(storeKey)
+		codeHash := crypto.Keccak256Hash(code)
+		store.Set(state.CodeHashKeyFor(contract), codeHash.Bytes())
+		store.Set(state.CodeKeyFor(codeHash), code)
+		store.Set(state.SlotKeyFor(contract, common.Hash{0x01}), common.Hash{0x02}.Bytes())
+		Expect(cosmlib.MintCoinsToAddress(
+			ctx, bk, types.ModuleName, testutil.Alice, types.DefaultEvmDenom, big.NewInt(100),
+		)).To(Succeed())
+
+		_, broken := keeper.AllInvariants(k, bk)(ctx)
+		Expect(broken).To(BeFalse())
+	})
+
+	It("should be broken for storage without a code hash", func() {
+		ctx.KVStore(storeKey).Set(
+			state.SlotKeyFor(contract, common.Hash{0x01}), common.Hash{0x02}.Bytes(),
+		)
+		_, broken := keeper.StorageCodeHashInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+	})
+
+	It("should be broken for a code hash without code", func() {
+		ctx.KVStore(storeKey).Set(state.CodeHashKeyFor(contract), crypto.Keccak256(code))
+		_, broken := keeper.CodeHashCodeInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+	})
+
+	It("should not be broken for an empty code hash", func() {
+		ctx.KVStore(storeKey).Set(state.CodeHashKeyFor(contract), crypto.Keccak256(nil))
+		_, broken := keeper.CodeHashCodeInvariant(k)(ctx)
+		Expect(broken).To(BeFalse())
+	})
+})
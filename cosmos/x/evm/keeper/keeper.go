@@ -29,6 +29,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/precompile"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/txpool"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
@@ -50,6 +51,8 @@ type Keeper struct {
 	authority string
 	// The host contains various plugins that are are used to implement `core.GridironHostChain`.
 	host Host
+	// feeHandler distributes the base fee of EVM transactions.
+	feeHandler *FeeHandler
 }
 
 // NewKeeper creates new instances of the gridiron Keeper.
@@ -57,6 +60,8 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	ak state.AccountKeeper,
 	bk state.BankKeeper,
+	sk StakingKeeper,
+	dk DistributionKeeper,
 	authority string,
 	appOpts servertypes.AppOptions,
 	ethTxMempool sdkmempool.Mempool,
@@ -64,16 +69,18 @@ func NewKeeper(
 ) *Keeper {
 	// We setup the keeper with some Cosmos standard sauce.
 	k := &Keeper{
-		ak:        ak,
-		bk:        bk,
-		authority: authority,
-		storeKey:  storeKey,
+		ak:         ak,
+		bk:         bk,
+		authority:  authority,
+		storeKey:   storeKey,
+		feeHandler: NewFeeHandler(ak, bk, dk),
 	}
 
 	k.host = NewHost(
 		storeKey,
 		ak,
 		bk,
+		sk,
 		authority,
 		appOpts,
 		ethTxMempool,
@@ -82,6 +89,12 @@ func NewKeeper(
 	return k
 }
 
+// SetDenomPrecompileFactory sets the factory of the bank ERC20 token precompiles of the
+// denominations listed in the x/evm params. It must be called before Setup.
+func (k *Keeper) SetDenomPrecompileFactory(dpf precompile.DenomPrecompileFactory) {
+	k.host.SetDenomPrecompileFactory(dpf)
+}
+
 // Setup sets up the plugins in the Host. It also build the Gridiron EVM Provider.
 func (k *Keeper) Setup(
 	offchainStoreKey *storetypes.KVStoreKey,
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/lib/utils"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/evm params from consensus version 1 to 2. Version 1 params only
+// contain the EVM denom, extra EIPs and chain config, so the fee market, fee distribution,
+// dispatch allowlist, precompile KV gas and precompile params are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	cp := utils.MustGetAs[configuration.Plugin](m.keeper.host.GetConfigurationPlugin())
+	cp.Prepare(ctx)
+
+	params := cp.GetParams()
+	defaults := types.DefaultParams()
+	params.FeeMarket = defaults.FeeMarket
+	params.FeeDistribution = defaults.FeeDistribution
+	params.DispatchAllowlist = defaults.DispatchAllowlist
+	params.PrecompileKVGas = defaults.PrecompileKVGas
+	params.PrecompileTransientKVGas = defaults.PrecompileTransientKVGas
+	params.Precompiles = defaults.Precompiles
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cp.SetParams(params)
+	return nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
+	evmmempool "pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrator", func() {
+	var (
+		ctx sdk.Context
+		k   *keeper.Keeper
+		cp  configuration.Plugin
+	)
+
+	BeforeEach(func() {
+		var (
+			ak authkeeper.AccountKeeper
+			bk bankkeeper.BaseKeeper
+			sk stakingkeeper.Keeper
+		)
+		ctx, ak, bk, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			storetypes.NewKVStoreKey("evm"),
+			ak, bk, &sk, nil,
+			"authority",
+			simtestutil.NewAppOptionsWithFlagHome(GinkgoT().TempDir()),
+			evmmempool.NewEthTxPoolFrom(evmmempool.DefaultPriorityMempool()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir())
+		cp = utils.MustGetAs[configuration.Plugin](k.GetHost().GetConfigurationPlugin())
+		cp.Prepare(ctx)
+	})
+
+	It("should set the params added in version 2 to their defaults", func() {
+		defaults := types.DefaultParams()
+		cp.SetParams(&types.Params{
+			EvmDenom:    "eth",
+			ExtraEIPs:   []int64{123},
+			ChainConfig: defaults.ChainConfig,
+		})
+		Expect(cp.GetParams().ValidateBasic()).ToNot(Succeed())
+
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+
+		params := cp.GetParams()
+		Expect(params.ValidateBasic()).To(Succeed())
+		Expect(params.EvmDenom).To(Equal("eth"))
+		Expect(params.ExtraEIPs).To(Equal([]int64{123}))
+		Expect(params.ChainConfig).To(Equal(defaults.ChainConfig))
+		Expect(params.FeeMarket).To(Equal(defaults.FeeMarket))
+		Expect(params.FeeDistribution).To(Equal(defaults.FeeDistribution))
+		Expect(params.DispatchAllowlist).To(BeEmpty())
+		Expect(params.PrecompileKVGas).To(Equal(defaults.PrecompileKVGas))
+		Expect(params.PrecompileTransientKVGas).To(Equal(defaults.PrecompileTransientKVGas))
+		Expect(params.Precompiles).To(BeEmpty())
+	})
+})
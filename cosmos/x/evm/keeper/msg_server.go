@@ -25,10 +25,14 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/core"
+	"pkg.furychain.dev/gridiron/eth/crypto"
 	"pkg.furychain.dev/gridiron/lib/utils"
 )
 
@@ -36,29 +40,99 @@ import (
 var _ types.MsgServiceServer = &Keeper{}
 
 // EthTransaction implements the  MsgServiceServer interface. It processes an incoming request
-// and applies it to the Gridiron Chain.
+// and applies it to the Gridiron Chain. If the gas of the transaction is paid by a fee granter,
+// the granter pays for the gas used at the effective gas price.
 func (k *Keeper) EthTransaction(
 	ctx context.Context, msg *types.EthTransactionRequest,
 ) (*types.EthTransactionResponse, error) {
+	sCtx, tx := sdk.UnwrapSDKContext(ctx), msg.AsTransaction()
+
+	// Have the fee granter, if any, pay for the gas bought by the sender.
+	var (
+		grantee sdk.AccAddress
+		paid    sdk.Coins
+	)
+	granter := types.FeeGranter(sCtx)
+	if granter != nil {
+		sender, err := msg.GetSender()
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid sender")
+		}
+		grantee = sdk.AccAddress(sender.Bytes())
+		if paid, err = k.buyGasFromGranter(sCtx, granter, grantee, tx); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to pay gas with fee grant")
+		}
+	}
+
 	// Process the transaction and return the result.
-	result, err := k.ProcessTransaction(ctx, msg.AsTransaction())
+	result, err := k.ProcessTransaction(ctx, tx)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to process transaction")
 	}
 
-	// Build the response.
-	vmErr := ""
-	if result.Err != nil {
-		vmErr = result.Err.Error()
+	// Return the gas that was not used to the fee granter.
+	if granter != nil {
+		if err = k.refundGasToGranter(sCtx, granter, grantee, paid, tx, result.UsedGas); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to refund gas to fee granter")
+		}
 	}
 
+	// Build the response.
 	return &types.EthTransactionResponse{
 		GasUsed:    result.UsedGas,
-		VmError:    vmErr,
+		VmError:    vmErrorString(result),
+		ReturnData: result.ReturnData,
+	}, nil
+}
+
+// CallContract implements the MsgServiceServer interface. It calls an EVM contract on behalf of
+// the Ethereum address of the Cosmos sender.
+func (k *Keeper) CallContract(
+	ctx context.Context, msg *types.CallContractRequest,
+) (*types.CallContractResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid sender address")
+	}
+
+	result, err := k.ProcessUnsignedTransaction(
+		ctx, msg.AsTransaction(k.contractNonce(ctx, sender)), cosmlib.AccAddressToEthAddress(sender),
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to process contract call")
+	}
+
+	return &types.CallContractResponse{
+		GasUsed:    result.UsedGas,
+		VmError:    vmErrorString(result),
 		ReturnData: result.ReturnData,
 	}, nil
 }
 
+// DeployContract implements the MsgServiceServer interface. It deploys an EVM contract on behalf
+// of the Ethereum address of the Cosmos sender.
+func (k *Keeper) DeployContract(
+	ctx context.Context, msg *types.DeployContractRequest,
+) (*types.DeployContractResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid sender address")
+	}
+
+	from, nonce := cosmlib.AccAddressToEthAddress(sender), k.contractNonce(ctx, sender)
+	result, err := k.ProcessUnsignedTransaction(ctx, msg.AsTransaction(nonce), from)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to process contract deployment")
+	}
+
+	return &types.DeployContractResponse{
+		ContractAddress: crypto.CreateAddress(from, nonce).Hex(),
+		GasUsed:         result.UsedGas,
+		VmError:         vmErrorString(result),
+		ReturnData:      result.ReturnData,
+	}, nil
+}
+
 // UpdateParams  processes an incoming request and applies it to the Configuration plugin to
 // update things about both the Gridiron Chain as well as the EVM Module.
 func (k *Keeper) UpdateParams(
@@ -78,3 +152,34 @@ func (k *Keeper) UpdateParams(
 	cp.SetParams(&req.Params)
 	return &types.UpdateParamsResponse{}, nil
 }
+
+// contractNonce returns the EVM nonce, which is the account sequence, of the sender of a
+// CallContract or DeployContract message. The EVM increments the nonce of the sender, so if the
+// ante handler already incremented the sequence of the sender as a signer of the transaction, the
+// sequence is reset to the nonce it consumed, in order to only increment it once.
+func (k *Keeper) contractNonce(ctx context.Context, sender sdk.AccAddress) uint64 {
+	// The EVM account of the sender is the account of its Ethereum address.
+	addr := sdk.AccAddress(cosmlib.AccAddressToEthAddress(sender).Bytes())
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0
+	}
+
+	nonce := acc.GetSequence()
+	if nonce > 0 && types.ConsumeSignedSequence(sdk.UnwrapSDKContext(ctx), addr) {
+		nonce--
+		if err := acc.SetSequence(nonce); err != nil {
+			panic(err)
+		}
+		k.ak.SetAccount(ctx, acc)
+	}
+	return nonce
+}
+
+// vmErrorString returns the virtual machine error of the execution result as a string.
+func vmErrorString(result *core.ExecutionResult) string {
+	if result.Err != nil {
+		return result.Err.Error()
+	}
+	return ""
+}
@@ -22,9 +22,13 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/common/hexutil"
 	"pkg.furychain.dev/gridiron/eth/core"
 	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
 )
@@ -44,22 +48,76 @@ func (k *Keeper) ProcessTransaction(ctx context.Context, tx *coretypes.Transacti
 		return nil, err
 	}
 
+	// Distribute the base fee burned by the EVM according to the fee distribution params.
+	if err = k.distributeBaseFee(sCtx, execResult.UsedGas); err != nil {
+		return nil, err
+	}
+
 	// We don't want the cosmos transaction to be marked as failed if the EVM reverts. But
 	// its not the worst idea to log the error.
+	k.logExecution(sCtx, tx, execResult)
+
+	// Return the execution result.
+	return execResult, err
+}
+
+// ProcessUnsignedTransaction is called during the DeliverTx processing of Cosmos messages that
+// execute in the EVM on behalf of `from`, whose authorization has already been verified through
+// Cosmos signatures.
+func (k *Keeper) ProcessUnsignedTransaction(
+	ctx context.Context, tx *coretypes.Transaction, from common.Address,
+) (*core.ExecutionResult, error) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Unlike for Ethereum transactions, the gas meter is not reset, since the Cosmos transaction
+	// fees have been paid for the gas consumed prior to the evm execution as well. The evm
+	// execution is priced at zero, so there is no base fee to distribute.
+	execResult, logs, err := k.gridiron.ProcessUnsignedTransaction(ctx, tx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	// As the transaction is not included in the block, its logs are not queryable through the
+	// Ethereum JSON-RPC, so they are emitted as Cosmos events instead.
+	k.emitLogs(sCtx, logs)
+
+	k.logExecution(sCtx, tx, execResult)
+	return execResult, nil
+}
+
+// emitLogs emits an `ethereum_log` event for each of the given logs.
+func (k *Keeper) emitLogs(ctx sdk.Context, logs []*coretypes.Log) {
+	events := make(sdk.Events, len(logs))
+	for i, log := range logs {
+		topics := make([]string, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = topic.Hex()
+		}
+		events[i] = sdk.NewEvent(
+			types.EventTypeEthereumLog,
+			sdk.NewAttribute(types.AttributeKeyAddress, log.Address.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTopics, strings.Join(topics, ",")),
+			sdk.NewAttribute(types.AttributeKeyData, hexutil.Bytes(log.Data).String()),
+		)
+	}
+	ctx.EventManager().EmitEvents(events)
+}
+
+// logExecution logs the result of the evm execution of the given transaction.
+func (k *Keeper) logExecution(
+	ctx sdk.Context, tx *coretypes.Transaction, execResult *core.ExecutionResult,
+) {
 	if execResult.Err != nil {
-		k.Logger(sdk.UnwrapSDKContext(ctx)).Error(
+		k.Logger(ctx).Error(
 			"evm execution",
 			"tx_hash", tx.Hash(),
 			"error", execResult.Err,
-			"gas_consumed", sCtx.GasMeter().GasConsumed())
+			"gas_consumed", ctx.GasMeter().GasConsumed())
 	} else {
-		k.Logger(sdk.UnwrapSDKContext(ctx)).Debug(
+		k.Logger(ctx).Debug(
 			"evm execution",
 			"tx_hash", tx.Hash(),
-			"gas_consumed", sCtx.GasMeter().GasConsumed(),
+			"gas_consumed", ctx.GasMeter().GasConsumed(),
 		)
 	}
-
-	// Return the execution result.
-	return execResult, err
 }
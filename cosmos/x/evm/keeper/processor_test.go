@@ -23,25 +23,33 @@ package keeper_test
 import (
 	"math/big"
 	"os"
+	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	bindings "pkg.furychain.dev/gridiron/contracts/bindings/testing"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
 	"pkg.furychain.dev/gridiron/cosmos/precompile/staking"
 	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	evmante "pkg.furychain.dev/gridiron/cosmos/x/evm/ante"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/block"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/txpool/mempool"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
 	"pkg.furychain.dev/gridiron/eth/accounts/abi"
 	"pkg.furychain.dev/gridiron/eth/common"
+	"pkg.furychain.dev/gridiron/eth/common/hexutil"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
 	"pkg.furychain.dev/gridiron/eth/crypto"
@@ -64,7 +72,7 @@ var _ = Describe("Processor", func() {
 	var (
 		k            *keeper.Keeper
 		ak           state.AccountKeeper
-		bk           state.BankKeeper
+		bk           bankkeeper.BaseKeeper
 		sk           stakingkeeper.Keeper
 		ctx          sdk.Context
 		sc           ethprecompile.StatefulImpl
@@ -89,7 +97,7 @@ var _ = Describe("Processor", func() {
 		ctx, ak, bk, sk = testutil.SetupMinimalKeepers()
 		k = keeper.NewKeeper(
 			storetypes.NewKVStoreKey("evm"),
-			ak, bk,
+			ak, bk, &sk, nil,
 			"authority",
 			simtestutil.NewAppOptionsWithFlagHome("tmp/furychain"),
 			evmmempool.NewEthTxPoolFrom(evmmempool.DefaultPriorityMempool()),
@@ -179,5 +187,274 @@ var _ = Describe("Processor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Err).ToNot(HaveOccurred())
 		})
+
+		It("should distribute the base fee when the fee market is disabled", func() {
+			recipient := sdk.AccAddress([]byte("fee-recipient"))
+			params := types.DefaultParams()
+			params.FeeMarket.Enabled = false
+			params.FeeDistribution.RecipientRatio = sdkmath.LegacyNewDecWithPrec(2, 1)
+			params.FeeDistribution.Recipient = recipient.String()
+			Expect(params.ValidateBasic()).To(Succeed())
+
+			// start a new block with the fee market disabled
+			k.Precommit(ctx)
+			_, err := k.UpdateParams(ctx, &types.UpdateParamsRequest{
+				Authority: "authority", Params: *params,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			utils.MustGetAs[block.Plugin](k.GetHost().GetBlockPlugin()).SetQueryContextFn(
+				func(int64, bool) (sdk.Context, error) { return ctx, nil },
+			)
+			ctx = ctx.WithBlockHeight(2)
+			k.BeginBlocker(ctx)
+
+			legacyTxData.Data = common.FromHex(bindings.SolmateERC20Bin)
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			addr, err := signer.Sender(tx)
+			Expect(err).ToNot(HaveOccurred())
+			k.GetHost().GetStatePlugin().CreateAccount(addr)
+			k.GetHost().GetStatePlugin().AddBalance(addr, (&big.Int{}).Mul(big.NewInt(9000000000000000000), big.NewInt(999)))
+			k.GetHost().GetStatePlugin().Finalize()
+			supply := bk.GetSupply(ctx, params.EvmDenom).Amount
+
+			result, err := k.ProcessTransaction(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Err).ToNot(HaveOccurred())
+
+			// the EVM burns the base fee, of which 20% is minted back to the recipient
+			recipientFee := bk.GetBalance(ctx, recipient, params.EvmDenom).Amount
+			Expect(recipientFee.IsPositive()).To(BeTrue())
+			baseFee := supply.Sub(bk.GetSupply(ctx, params.EvmDenom).Amount).Add(recipientFee)
+			Expect(recipientFee).To(Equal(baseFee.MulRaw(2).QuoRaw(10)))
+		})
+
+		It("should pay the gas of a transaction from the fee granter", func() {
+			granter := sdk.AccAddress(common.BytesToAddress([]byte("fee-granter")).Bytes())
+			Expect(cosmlib.MintCoinsToAddress(
+				ctx, bk, types.ModuleName, cosmlib.AccAddressToEthAddress(granter),
+				types.DefaultEvmDenom, big.NewInt(1000000000000000000),
+			)).To(Succeed())
+
+			legacyTxData.Data = common.FromHex(bindings.SolmateERC20Bin)
+			legacyTxData.Gas = 10000000
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			grantee := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+
+			res, err := k.EthTransaction(types.WithFeeGranter(ctx, granter), types.NewFromTransaction(tx))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.VmError).To(BeEmpty())
+
+			// the granter pays for the gas used, the balance of the grantee does not grow
+			spent := new(big.Int).Mul(legacyTxData.GasPrice, new(big.Int).SetUint64(res.GasUsed))
+			Expect(bk.GetBalance(ctx, granter, types.DefaultEvmDenom).Amount.BigInt()).To(Equal(
+				new(big.Int).Sub(big.NewInt(1000000000000000000), spent),
+			))
+			Expect(bk.GetBalance(ctx, grantee, types.DefaultEvmDenom).Amount.IsZero()).To(BeTrue())
+		})
+
+		It("should not let the sender spend the coins of the fee granter", func() {
+			granter := sdk.AccAddress(common.BytesToAddress([]byte("fee-granter")).Bytes())
+			Expect(cosmlib.MintCoinsToAddress(
+				ctx, bk, types.ModuleName, cosmlib.AccAddressToEthAddress(granter),
+				types.DefaultEvmDenom, big.NewInt(1000000000000000000),
+			)).To(Succeed())
+			sender := crypto.PubkeyToAddress(key.PublicKey)
+			recipient := common.BytesToAddress([]byte("recipient"))
+
+			// the gas fee cap is far above the effective gas price
+			txData := &coretypes.DynamicFeeTx{
+				ChainID:   params.DefaultChainConfig.ChainID,
+				To:        &recipient,
+				Gas:       100000,
+				GasTipCap: big.NewInt(1000000000),
+				GasFeeCap: big.NewInt(100000000000),
+				Value:     big.NewInt(1000),
+			}
+			tx := coretypes.MustSignNewTx(key, signer, txData)
+			price := k.EffectiveGasPrice(tx)
+			Expect(price.Cmp(txData.GasFeeCap)).To(Equal(-1))
+
+			// the sender holds the difference to the gas fee cap, but not the value
+			headroom := new(big.Int).Mul(
+				new(big.Int).Sub(txData.GasFeeCap, price), new(big.Int).SetUint64(txData.Gas),
+			)
+			Expect(cosmlib.MintCoinsToAddress(
+				ctx, bk, types.ModuleName, sender, types.DefaultEvmDenom, headroom,
+			)).To(Succeed())
+			_, err := k.EthTransaction(types.WithFeeGranter(ctx, granter), types.NewFromTransaction(tx))
+			Expect(err).To(MatchError(ContainSubstring("insufficient funds")))
+			Expect(bk.GetBalance(ctx, sdk.AccAddress(recipient.Bytes()), types.DefaultEvmDenom).
+				Amount.IsZero()).To(BeTrue())
+		})
+
+		It("should pay the value of a transaction with a fee grant from the sender", func() {
+			granter := sdk.AccAddress(common.BytesToAddress([]byte("fee-granter")).Bytes())
+			Expect(cosmlib.MintCoinsToAddress(
+				ctx, bk, types.ModuleName, cosmlib.AccAddressToEthAddress(granter),
+				types.DefaultEvmDenom, big.NewInt(1000000000000000000),
+			)).To(Succeed())
+			sender := crypto.PubkeyToAddress(key.PublicKey)
+			recipient := common.BytesToAddress([]byte("recipient"))
+
+			txData := &coretypes.DynamicFeeTx{
+				ChainID:   params.DefaultChainConfig.ChainID,
+				To:        &recipient,
+				Gas:       100000,
+				GasTipCap: big.NewInt(1000000000),
+				GasFeeCap: big.NewInt(100000000000),
+				Value:     big.NewInt(1000),
+			}
+			tx := coretypes.MustSignNewTx(key, signer, txData)
+			price := k.EffectiveGasPrice(tx)
+			headroom := new(big.Int).Mul(
+				new(big.Int).Sub(txData.GasFeeCap, price), new(big.Int).SetUint64(txData.Gas),
+			)
+			Expect(cosmlib.MintCoinsToAddress(
+				ctx, bk, types.ModuleName, sender, types.DefaultEvmDenom,
+				new(big.Int).Add(headroom, txData.Value),
+			)).To(Succeed())
+
+			res, err := k.EthTransaction(types.WithFeeGranter(ctx, granter), types.NewFromTransaction(tx))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.VmError).To(BeEmpty())
+
+			// the granter pays for the gas used and the sender for the value
+			spent := new(big.Int).Mul(price, new(big.Int).SetUint64(res.GasUsed))
+			Expect(bk.GetBalance(ctx, granter, types.DefaultEvmDenom).Amount.BigInt()).To(Equal(
+				new(big.Int).Sub(big.NewInt(1000000000000000000), spent),
+			))
+			Expect(bk.GetBalance(ctx, sdk.AccAddress(sender.Bytes()), types.DefaultEvmDenom).
+				Amount.BigInt()).To(Equal(headroom))
+			Expect(bk.GetBalance(ctx, sdk.AccAddress(recipient.Bytes()), types.DefaultEvmDenom).
+				Amount.BigInt()).To(Equal(txData.Value))
+		})
+
+		It("should deploy and call a contract with cosmos messages", func() {
+			sender := sdk.AccAddress([]byte("cosmos-sender"))
+			ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, sender))
+			from := cosmlib.AccAddressToEthAddress(sender)
+
+			// deploy the contract without an ethereum signature
+			deployRes, err := k.DeployContract(ctx, types.NewDeployContractRequest(
+				sender, common.FromHex(bindings.SolmateERC20Bin), sdkmath.ZeroInt(), 10000000,
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deployRes.VmError).To(BeEmpty())
+			Expect(deployRes.ContractAddress).To(Equal(crypto.CreateAddress(from, 0).Hex()))
+
+			// call the contract non-view function
+			var solmateABI abi.ABI
+			Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+			input, err := solmateABI.Pack("mint", from, big.NewInt(8888888))
+			Expect(err).ToNot(HaveOccurred())
+			contract := common.HexToAddress(deployRes.ContractAddress)
+			callRes, err := k.CallContract(ctx, types.NewCallContractRequest(
+				sender, contract, input, sdkmath.ZeroInt(), 1000000,
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(callRes.VmError).To(BeEmpty())
+
+			// call the contract view function
+			input, err = solmateABI.Pack("balanceOf", from)
+			Expect(err).ToNot(HaveOccurred())
+			callRes, err = k.CallContract(ctx, types.NewCallContractRequest(
+				sender, contract, input, sdkmath.ZeroInt(), 1000000,
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(callRes.VmError).To(BeEmpty())
+			Expect(new(big.Int).SetBytes(callRes.ReturnData)).To(Equal(big.NewInt(8888888)))
+		})
+
+		It("should emit the logs of a contract call with cosmos messages as events", func() {
+			sender := sdk.AccAddress([]byte("cosmos-sender"))
+			ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, sender))
+			from := cosmlib.AccAddressToEthAddress(sender)
+
+			deployRes, err := k.DeployContract(ctx, types.NewDeployContractRequest(
+				sender, common.FromHex(bindings.SolmateERC20Bin), sdkmath.ZeroInt(), 10000000,
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deployRes.VmError).To(BeEmpty())
+			contract := common.HexToAddress(deployRes.ContractAddress)
+
+			// mint through the contract, which logs a `Transfer` from the zero address and a `Mint`
+			var solmateABI abi.ABI
+			Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+			input, err := solmateABI.Pack("mint", from, big.NewInt(8888888))
+			Expect(err).ToNot(HaveOccurred())
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			callRes, err := k.CallContract(ctx, types.NewCallContractRequest(
+				sender, contract, input, sdkmath.ZeroInt(), 1000000,
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(callRes.VmError).To(BeEmpty())
+
+			var logs []map[string]string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeEthereumLog {
+					continue
+				}
+				attrs := make(map[string]string)
+				for _, attr := range event.Attributes {
+					attrs[attr.Key] = attr.Value
+				}
+				logs = append(logs, attrs)
+			}
+			amount := hexutil.Bytes(common.BigToHash(big.NewInt(8888888)).Bytes()).String()
+			Expect(logs).To(Equal([]map[string]string{
+				{
+					types.AttributeKeyAddress: contract.Hex(),
+					types.AttributeKeyTopics: strings.Join([]string{
+						solmateABI.Events["Transfer"].ID.Hex(),
+						common.Hash{}.Hex(),
+						common.BytesToHash(from.Bytes()).Hex(),
+					}, ","),
+					types.AttributeKeyData: amount,
+				},
+				{
+					types.AttributeKeyAddress: contract.Hex(),
+					types.AttributeKeyTopics: strings.Join([]string{
+						solmateABI.Events["Mint"].ID.Hex(),
+						common.BytesToHash(from.Bytes()).Hex(),
+					}, ","),
+					types.AttributeKeyData: amount,
+				},
+			}))
+		})
+
+		It("should increment the sequence once per signed transaction with cosmos messages", func() {
+			sender := sdk.AccAddress(common.BytesToAddress([]byte("cosmos-sender")).Bytes())
+			ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, sender))
+			from := cosmlib.AccAddressToEthAddress(sender)
+			anteHandler := sdk.ChainAnteDecorators(
+				ante.NewIncrementSequenceDecorator(ak.(ante.AccountKeeper)),
+				evmante.NewContractSequenceDecorator(),
+			)
+
+			// deliver a transaction that deploys two contracts through the ante handler
+			msgs := []sdk.Msg{
+				types.NewDeployContractRequest(
+					sender, common.FromHex(bindings.SolmateERC20Bin), sdkmath.ZeroInt(), 10000000,
+				),
+				types.NewDeployContractRequest(
+					sender, common.FromHex(bindings.SolmateERC20Bin), sdkmath.ZeroInt(), 10000000,
+				),
+			}
+			txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+			Expect(txBuilder.SetMsgs(msgs...)).To(Succeed())
+			msgCtx, err := anteHandler(ctx, txBuilder.GetTx(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ak.GetAccount(msgCtx, sender).GetSequence()).To(Equal(uint64(1)))
+
+			for i, msg := range msgs {
+				deployRes, err := k.DeployContract(msgCtx, msg.(*types.DeployContractRequest))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deployRes.VmError).To(BeEmpty())
+				Expect(deployRes.ContractAddress).To(Equal(crypto.CreateAddress(from, uint64(i)).Hex()))
+			}
+			Expect(ak.GetAccount(msgCtx, sender).GetSequence()).To(Equal(uint64(2)))
+		})
 	})
 })
@@ -21,6 +21,8 @@
 package evm
 
 import (
+	"context"
+
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -31,19 +33,22 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/simulation"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
 )
 
 // ConsensusVersion defines the current x/evm module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasServices         = AppModule{}
 	_ module.BeginBlockAppModule = AppModule{}
 	_ module.PrecommitAppModule  = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
 )
 
 // ==============================================================================
@@ -70,10 +75,11 @@ func (b AppModuleBasic) RegisterInterfaces(r cdctypes.InterfaceRegistry) {
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the evm module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
-	// if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux,
-	// types.NewQueryClient(clientCtx)); err != nil {
-	// 	panic(err)
-	// }
+	if err := types.RegisterQueryServiceHandlerClient(
+		context.Background(), mux, types.NewQueryServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns no root tx command for the evm module.
@@ -119,13 +125,20 @@ func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) IsAppModule() {}
 
 // RegisterInvariants registers the evm module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper, am.bankKeeper)
+}
 
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServiceServer(cfg.MsgServer(), am.keeper)
-	// types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServiceServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -139,3 +152,25 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 func (am AppModule) Precommit(ctx sdk.Context) {
 	am.keeper.Precommit(ctx)
 }
+
+// ==============================================================================
+// AppModuleSimulation
+// ==============================================================================
+
+// GenerateGenesisState creates a randomized GenState of the evm module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for evm module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.ModuleName] = simulation.NewDecodeStore()
+}
+
+// WeightedOperations returns the all the evm module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig,
+		am.accKeeper, am.bankKeeper, am.keeper,
+	)
+}
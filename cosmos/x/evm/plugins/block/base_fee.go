@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"math/big"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+)
+
+// ===========================================================================
+// Gridiron Fee Market
+// ===========================================================================.
+
+// BaseFee returns the base fee of the block currently being built. The base fee is derived from
+// the parent header persisted in the store, so it is continuous across node restarts. If the fee
+// market is disabled, -1 is returned and the gridiron built-in EIP-1559 math is used instead.
+//
+// BaseFee implements core.BlockPlugin.
+func (p *plugin) BaseFee() *big.Int {
+	fmp := p.cp.GetParams().FeeMarket
+	if !fmp.Enabled {
+		return big.NewInt(-1)
+	}
+
+	// The header stored in the kvstore at this point belongs to the previous block.
+	bz := p.ctx.KVStore(p.storekey).Get([]byte{types.HeaderKey})
+	if bz == nil {
+		return bigMax(fmp.InitialBaseFeeBig(), fmp.MinBaseFeeBig())
+	}
+	parent, err := coretypes.UnmarshalHeader(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return CalcBaseFee(parent, &fmp)
+}
+
+// GetBaseFee returns the base fee of the latest finalized block, or nil if no block has been
+// finalized yet.
+func (p *plugin) GetBaseFee() *big.Int {
+	bz := p.ctx.KVStore(p.storekey).Get([]byte{types.BaseFeeKey})
+	if bz == nil {
+		return nil
+	}
+	return new(big.Int).SetBytes(bz)
+}
+
+// setBaseFee stores the base fee of the given header.
+func (p *plugin) setBaseFee(header *coretypes.Header) {
+	if header.BaseFee == nil {
+		return
+	}
+	p.ctx.KVStore(p.storekey).Set([]byte{types.BaseFeeKey}, header.BaseFee.Bytes())
+}
+
+// CalcBaseFee calculates the base fee of the child of the given parent header, following the
+// EIP-1559 specification with the given fee market params. The result is never lower than the
+// minimum base fee.
+func CalcBaseFee(parent *coretypes.Header, fmp *types.FeeMarketParams) *big.Int {
+	minBaseFee := fmp.MinBaseFeeBig()
+	if parent.BaseFee == nil {
+		return bigMax(fmp.InitialBaseFeeBig(), minBaseFee)
+	}
+
+	parentGasTarget := parent.GasLimit / fmp.ElasticityMultiplier
+	if parentGasTarget == 0 || parent.GasUsed == parentGasTarget {
+		return bigMax(new(big.Int).Set(parent.BaseFee), minBaseFee)
+	}
+
+	var (
+		num   = new(big.Int)
+		denom = new(big.Int)
+	)
+
+	if parent.GasUsed > parentGasTarget {
+		// If the parent block used more gas than its target, the base fee should increase.
+		// max(1, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
+		num.SetUint64(parent.GasUsed - parentGasTarget)
+		num.Mul(num, parent.BaseFee)
+		num.Div(num, denom.SetUint64(parentGasTarget))
+		num.Div(num, denom.SetUint64(fmp.BaseFeeChangeDenominator))
+		baseFeeDelta := bigMax(num, big1)
+		return bigMax(num.Add(parent.BaseFee, baseFeeDelta), minBaseFee)
+	}
+
+	// Otherwise if the parent block used less gas than its target, the base fee should decrease.
+	// max(minBaseFee, parentBaseFee - parentBaseFee * gasUsedDelta / parentGasTarget /
+	// baseFeeChangeDenominator)
+	num.SetUint64(parentGasTarget - parent.GasUsed)
+	num.Mul(num, parent.BaseFee)
+	num.Div(num, denom.SetUint64(parentGasTarget))
+	num.Div(num, denom.SetUint64(fmp.BaseFeeChangeDenominator))
+	return bigMax(num.Sub(parent.BaseFee, num), minBaseFee)
+}
+
+// big1 is the big.Int representation of 1.
+var big1 = big.NewInt(1)
+
+// bigMax returns the larger of x or y.
+func bigMax(x, y *big.Int) *big.Int {
+	if x.Cmp(y) < 0 {
+		return y
+	}
+	return x
+}
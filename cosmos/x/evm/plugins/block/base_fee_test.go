@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"math/big"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Base Fee", func() {
+	var fmp types.FeeMarketParams
+
+	BeforeEach(func() {
+		fmp = types.DefaultFeeMarketParams()
+	})
+
+	It("should return the initial base fee without a parent base fee", func() {
+		Expect(CalcBaseFee(&coretypes.Header{}, &fmp)).To(Equal(fmp.InitialBaseFeeBig()))
+	})
+
+	It("should keep the base fee when the gas target is met", func() {
+		parent := &coretypes.Header{GasLimit: 20000000, GasUsed: 10000000, BaseFee: big.NewInt(1000)}
+		Expect(CalcBaseFee(parent, &fmp)).To(Equal(big.NewInt(1000)))
+	})
+
+	It("should increase the base fee when the gas target is exceeded", func() {
+		parent := &coretypes.Header{GasLimit: 20000000, GasUsed: 20000000, BaseFee: big.NewInt(1000)}
+		Expect(CalcBaseFee(parent, &fmp)).To(Equal(big.NewInt(1125)))
+	})
+
+	It("should decrease the base fee when the gas target is not met", func() {
+		parent := &coretypes.Header{GasLimit: 20000000, GasUsed: 0, BaseFee: big.NewInt(1000)}
+		Expect(CalcBaseFee(parent, &fmp)).To(Equal(big.NewInt(875)))
+	})
+
+	It("should respect the elasticity multiplier and change denominator", func() {
+		fmp.ElasticityMultiplier = 4
+		fmp.BaseFeeChangeDenominator = 2
+		parent := &coretypes.Header{GasLimit: 20000000, GasUsed: 10000000, BaseFee: big.NewInt(1000)}
+		Expect(CalcBaseFee(parent, &fmp)).To(Equal(big.NewInt(1500)))
+	})
+
+	It("should never go below the minimum base fee", func() {
+		fmp.MinBaseFee = 950
+		parent := &coretypes.Header{GasLimit: 20000000, GasUsed: 0, BaseFee: big.NewInt(1000)}
+		Expect(CalcBaseFee(parent, &fmp)).To(Equal(big.NewInt(950)))
+	})
+})
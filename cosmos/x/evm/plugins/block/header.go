@@ -83,6 +83,7 @@ func (p *plugin) SetHeaderByNumber(_ int64, header *coretypes.Header) error {
 		return errorslib.Wrap(err, "SetHeader: failed to marshal header")
 	}
 	p.ctx.KVStore(p.storekey).Set([]byte{types.HeaderKey}, bz)
+	p.setBaseFee(header)
 	return nil
 }
 
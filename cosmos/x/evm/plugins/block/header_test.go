@@ -26,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
 	"pkg.furychain.dev/gridiron/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -37,7 +38,7 @@ var _ = Describe("Header", func() {
 
 	BeforeEach(func() {
 		ctx = testutil.NewContext().WithBlockGasMeter(storetypes.NewGasMeter(uint64(10000)))
-		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey))
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, configuration.NewPlugin(testutil.EvmKey), nil))
 		p.Prepare(ctx)
 	})
 
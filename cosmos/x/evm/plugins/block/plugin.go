@@ -29,6 +29,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/configuration"
 	"pkg.furychain.dev/gridiron/eth/common"
 	"pkg.furychain.dev/gridiron/eth/core"
 )
@@ -39,6 +40,8 @@ type Plugin interface {
 
 	// SetQueryContextFn sets the function used for querying historical block headers.
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
+	// GetBaseFee returns the base fee of the latest finalized block.
+	GetBaseFee() *big.Int
 }
 
 type plugin struct {
@@ -48,11 +51,17 @@ type plugin struct {
 	storekey storetypes.StoreKey
 	// getQueryContext allows for querying block headers.
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
+	// cp is the configuration plugin, used for reading the fee market params.
+	cp configuration.Plugin
+	// sk is the (optional) staking keeper, used for resolving the block proposer.
+	sk StakingKeeper
 }
 
-func NewPlugin(storekey storetypes.StoreKey) Plugin {
+func NewPlugin(storekey storetypes.StoreKey, cp configuration.Plugin, sk StakingKeeper) Plugin {
 	return &plugin{
 		storekey: storekey,
+		cp:       cp,
+		sk:       sk,
 	}
 }
 
@@ -61,20 +70,27 @@ func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
 }
 
-// BaseFee implements core.BlockPlugin.
-func (p *plugin) BaseFee() *big.Int {
-	return big.NewInt(-1) // we defer to gridiron' built in eip-1559 for the base fee.
-}
-
 // GetNewBlockMetadata returns the host chain block metadata for the given block height. It returns
-// the coinbase address, the timestamp of the block.
+// the coinbase address, the timestamp of the block. The coinbase is the operator address of the
+// proposer if it can be resolved, otherwise the consensus address of the proposer.
 func (p *plugin) GetNewBlockMetadata(number int64) (common.Address, uint64) {
 	cometHeader := p.ctx.BlockHeader()
 	if cometHeader.Height != number {
 		panic("block height mismatch")
 	}
 
-	return common.BytesToAddress(cometHeader.ProposerAddress), uint64(cometHeader.Time.UTC().Unix())
+	return p.getCoinbase(cometHeader.ProposerAddress), uint64(cometHeader.Time.UTC().Unix())
+}
+
+// getCoinbase resolves the account of the operator of the validator with the given consensus
+// address.
+func (p *plugin) getCoinbase(proposerAddress []byte) common.Address {
+	if p.sk != nil {
+		if val, found := p.sk.GetValidatorByConsAddr(p.ctx, proposerAddress); found {
+			return common.BytesToAddress(val.GetOperator())
+		}
+	}
+	return common.BytesToAddress(proposerAddress)
 }
 
 func (p *plugin) IsPlugin() {}
@@ -42,6 +42,7 @@ type Plugin interface {
 	core.ConfigurationPlugin
 	SetParams(params *types.Params)
 	GetParams() *types.Params
+	GetParamsAt(ctx context.Context) *types.Params
 	GetEvmDenom() string
 }
 
@@ -86,10 +87,14 @@ func (p *plugin) ExtraEips() []int {
 	return eips
 }
 
-// FeeCollector implements the core.ConfigurationPlugin interface.
+// FeeCollector implements the core.ConfigurationPlugin interface. If the priority tips are paid
+// to the block proposer, nil is returned so that the coinbase of the block header is used.
 func (p *plugin) FeeCollector() *common.Address {
+	if p.GetParams().FeeDistribution.TipsToProposer {
+		return nil
+	}
 	// TODO: parameterize fee collector name.
-	addr := common.BytesToAddress([]byte(authtypes.FeeCollectorName))
+	addr := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
 	return &addr
 }
 
@@ -21,12 +21,29 @@
 package configuration
 
 import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
 )
 
 // GetParams is used to get the params for the evm module.
 func (p *plugin) GetParams() *types.Params {
-	bz := p.paramsStore.Get([]byte{types.ParamsKey})
+	return getParams(p.paramsStore)
+}
+
+// GetParamsAt is used to get the params for the evm module in the state of the given context,
+// which may be the context of a historical block.
+func (p *plugin) GetParamsAt(ctx context.Context) *types.Params {
+	return getParams(sdk.UnwrapSDKContext(ctx).KVStore(p.storeKey))
+}
+
+// getParams reads the params for the evm module from the given store.
+func getParams(store storetypes.KVStore) *types.Params {
+	bz := store.Get([]byte{types.ParamsKey})
 	if bz == nil {
 		return &types.Params{}
 	}
@@ -60,9 +60,10 @@ var _ = Describe("Plugin", func() {
 		Context("when the params store contains valid params", func() {
 			It("should return the stored params", func() {
 				storedParams := types.Params{
-					EvmDenom:    "eth",
-					ExtraEIPs:   []int64{123},
-					ChainConfig: string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+					EvmDenom:        "eth",
+					ExtraEIPs:       []int64{123},
+					ChainConfig:     string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+					FeeDistribution: types.DefaultFeeDistributionParams(),
 				}
 				bz, err := storedParams.Marshal()
 				Expect(err).ToNot(HaveOccurred())
@@ -70,6 +71,7 @@ var _ = Describe("Plugin", func() {
 
 				params := p.GetParams()
 				Expect(params).To(Equal(&storedParams))
+				Expect(p.GetParamsAt(ctx)).To(Equal(&storedParams))
 			})
 		})
 
@@ -84,9 +86,10 @@ var _ = Describe("Plugin", func() {
 	Describe("SetParams", func() {
 		It("should store the params in the params store", func() {
 			params := types.Params{
-				EvmDenom:    "eth",
-				ExtraEIPs:   []int64{123},
-				ChainConfig: string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+				EvmDenom:        "eth",
+				ExtraEIPs:       []int64{123},
+				ChainConfig:     string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+				FeeDistribution: types.DefaultFeeDistributionParams(),
 			}
 			p.SetParams(&params)
 
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+	"pkg.furychain.dev/gridiron/eth/core/vm"
+	"pkg.furychain.dev/gridiron/eth/params"
+)
+
+// activePlugin is a view of the plugin which only exposes the precompiles that are active at a
+// block height, at the addresses they are deployed at, as configured in the x/evm params.
+type activePlugin struct {
+	*plugin
+	// configured is the set of default addresses of all configured precompiles.
+	configured map[common.Address]struct{}
+	// deployed maps the deployed address of each configured, active precompile to its default
+	// address.
+	deployed map[common.Address]common.Address
+	// deployedAddrs is the deployed addresses of the configured, active precompiles, in the order
+	// of their configs.
+	deployedAddrs []common.Address
+	// tokens maps the default address of the token precompile of each denomination listed in the
+	// x/evm params to the token precompile.
+	tokens map[common.Address]*tokenPrecompile
+	// tokenList is the token precompiles, in the order of their denominations in the x/evm params.
+	tokenList []*tokenPrecompile
+}
+
+// WithContext returns a view of the plugin limited to the precompiles which are active in the
+// x/evm params stored in ctx, at the block height of ctx. Precompiles without a config are active
+// at their default address. The token precompiles of the denominations listed in the x/evm params
+// are added to the precompiles, and can be configured like them. As ctx may be the context of a
+// historical block, calls at old heights use the precompiles that were active then.
+//
+// WithContext implements ethprecompile.ContextualPlugin.
+func (p *plugin) WithContext(ctx context.Context) ethprecompile.Plugin {
+	params := p.cp.GetParamsAt(ctx)
+	configs, tokens := params.Precompiles, p.tokenPrecompiles(params.BankERC20Denoms)
+	if len(configs) == 0 && len(tokens) == 0 {
+		return p
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	ap := &activePlugin{
+		plugin:     p,
+		configured: make(map[common.Address]struct{}, len(configs)),
+		deployed:   make(map[common.Address]common.Address, len(configs)),
+		tokens:     make(map[common.Address]*tokenPrecompile, len(tokens)),
+		tokenList:  tokens,
+	}
+	for _, tp := range tokens {
+		ap.tokens[tp.pc.RegistryKey()] = tp
+	}
+	for i := range configs {
+		pc := &configs[i]
+		addr := common.HexToAddress(pc.Address)
+		ap.configured[addr] = struct{}{}
+		if pc.IsActive(height) {
+			ap.deployed[pc.DeployedAt()] = addr
+			ap.deployedAddrs = append(ap.deployedAddrs, pc.DeployedAt())
+		}
+	}
+	return ap
+}
+
+// resolve returns the default address of the precompile called at addr and whether it is active.
+// A configured precompile deployed at addr takes precedence over the precompile whose default
+// address is addr.
+func (ap *activePlugin) resolve(addr common.Address) (common.Address, bool) {
+	if defaultAddr, ok := ap.deployed[addr]; ok {
+		return defaultAddr, true
+	}
+	if _, ok := ap.configured[addr]; ok {
+		return addr, false
+	}
+	return addr, true
+}
+
+// Has returns true if an active precompile is deployed at addr.
+//
+// Has implements core.PrecompilePlugin.
+func (ap *activePlugin) Has(addr common.Address) bool {
+	defaultAddr, ok := ap.resolve(addr)
+	if _, isToken := ap.tokens[defaultAddr]; ok && isToken {
+		return true
+	}
+	return ok && ap.plugin.Has(defaultAddr)
+}
+
+// Get returns the active precompile deployed at addr, or nil if there is none.
+//
+// Get implements core.PrecompilePlugin.
+func (ap *activePlugin) Get(addr common.Address) vm.PrecompileContainer {
+	defaultAddr, ok := ap.resolve(addr)
+	if !ok {
+		return nil
+	}
+	if tp, isToken := ap.tokens[defaultAddr]; isToken {
+		return tp.container
+	}
+	return ap.plugin.Get(defaultAddr)
+}
+
+// GetPrecompiles returns the precompiles which are active, including the ones deployed away from
+// their default address.
+//
+// GetPrecompiles implements core.PrecompilePlugin.
+func (ap *activePlugin) GetPrecompiles(rules *params.Rules) []ethprecompile.Registrable {
+	active := make(map[common.Address]struct{}, len(ap.deployed))
+	for _, defaultAddr := range ap.deployed {
+		active[defaultAddr] = struct{}{}
+	}
+
+	all := ap.plugin.GetPrecompiles(rules)
+	all = append(all[:len(all):len(all)], ap.tokenRegistrables()...)
+	precompiles := make([]ethprecompile.Registrable, 0, len(all))
+	for _, pc := range all {
+		_, isConfigured := ap.configured[pc.RegistryKey()]
+		if _, isActive := active[pc.RegistryKey()]; !isConfigured || isActive {
+			precompiles = append(precompiles, pc)
+		}
+	}
+	return precompiles
+}
+
+// GetActive returns the deployed addresses of the active precompiles.
+//
+// GetActive implements core.PrecompilePlugin.
+func (ap *activePlugin) GetActive(rules *params.Rules) []common.Address {
+	all := ap.plugin.GetActive(rules)
+	for _, tp := range ap.tokenList {
+		all = append(all, tp.pc.RegistryKey())
+	}
+	known := make(map[common.Address]struct{}, len(all))
+	active := make([]common.Address, 0, len(all))
+	for _, addr := range all {
+		known[addr] = struct{}{}
+		_, isConfigured := ap.configured[addr]
+		if _, isShadowed := ap.deployed[addr]; !isConfigured && !isShadowed {
+			active = append(active, addr)
+		}
+	}
+	for _, deployedAddr := range ap.deployedAddrs {
+		if _, ok := known[ap.deployed[deployedAddr]]; ok {
+			active = append(active, deployedAddr)
+		}
+	}
+	return active
+}
+
+// tokenRegistrables returns the token precompiles of the denominations listed in the x/evm params.
+func (ap *activePlugin) tokenRegistrables() []ethprecompile.Registrable {
+	pcs := make([]ethprecompile.Registrable, len(ap.tokenList))
+	for i, tp := range ap.tokenList {
+		pcs[i] = tp.pc
+	}
+	return pcs
+}
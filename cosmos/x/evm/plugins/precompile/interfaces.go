@@ -20,10 +20,27 @@
 
 package precompile
 
-import storetypes "cosmossdk.io/store/types"
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+)
 
 type (
 	StatePlugin interface {
 		SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 	}
+
+	// ConfigurationPlugin provides the x/evm params.
+	ConfigurationPlugin interface {
+		GetParams() *types.Params
+		GetParamsAt(ctx context.Context) *types.Params
+	}
+
+	// DenomPrecompileFactory builds the bank ERC20 token precompile of an SDK coin denomination
+	// listed in the `bank_erc20_denoms` of the x/evm params.
+	DenomPrecompileFactory func(denom string) ethprecompile.Registrable
 )
@@ -21,12 +21,15 @@
 package log
 
 import (
+	"math/big"
 	"strconv"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	libgenerated "pkg.furychain.dev/gridiron/contracts/bindings/cosmos/lib"
@@ -50,20 +53,28 @@ const (
 
 // defaultCosmosValueDecoders is a map of default Cosmos event attribute value decoder functions
 // for the default Cosmos SDK event `attributeKey`s. NOTE: only the event attributes of default
-// Cosmos SDK modules (bank, staking) are supported by this function.
+// Cosmos SDK modules (bank, staking, distribution, slashing) are supported by this function. The
+// distribution module's validator, delegator and amount attributes share their keys with the
+// staking module's.
 var defaultCosmosValueDecoders = precompile.ValueDecoders{
-	sdk.AttributeKeyAmount:                  ConvertSdkCoins,
-	stakingtypes.AttributeKeyValidator:      ConvertValAddressFromBech32,
-	stakingtypes.AttributeKeySrcValidator:   ConvertValAddressFromBech32,
-	stakingtypes.AttributeKeyDstValidator:   ConvertValAddressFromBech32,
-	stakingtypes.AttributeKeyCreationHeight: ConvertInt64,
-	stakingtypes.AttributeKeyDelegator:      ConvertAccAddressFromBech32,
-	banktypes.AttributeKeySender:            ConvertAccAddressFromBech32,
-	banktypes.AttributeKeyRecipient:         ConvertAccAddressFromBech32,
-	banktypes.AttributeKeySpender:           ConvertAccAddressFromBech32,
-	banktypes.AttributeKeyReceiver:          ConvertAccAddressFromBech32,
-	banktypes.AttributeKeyMinter:            ConvertAccAddressFromBech32,
-	banktypes.AttributeKeyBurner:            ConvertAccAddressFromBech32,
+	sdk.AttributeKeyAmount:                        ConvertSdkCoins,
+	stakingtypes.AttributeKeyValidator:            ConvertValAddressFromBech32,
+	stakingtypes.AttributeKeySrcValidator:         ConvertValAddressFromBech32,
+	stakingtypes.AttributeKeyDstValidator:         ConvertValAddressFromBech32,
+	stakingtypes.AttributeKeyCreationHeight:       ConvertInt64,
+	stakingtypes.AttributeKeyDelegator:            ConvertAccAddressFromBech32,
+	banktypes.AttributeKeySender:                  ConvertAccAddressFromBech32,
+	banktypes.AttributeKeyRecipient:               ConvertAccAddressFromBech32,
+	banktypes.AttributeKeySpender:                 ConvertAccAddressFromBech32,
+	banktypes.AttributeKeyReceiver:                ConvertAccAddressFromBech32,
+	banktypes.AttributeKeyMinter:                  ConvertAccAddressFromBech32,
+	banktypes.AttributeKeyBurner:                  ConvertAccAddressFromBech32,
+	distributiontypes.AttributeKeyWithdrawAddress: ConvertAccAddressFromBech32,
+	slashingtypes.AttributeKeyPower:               ConvertInt64,
+	slashingtypes.AttributeKeyReason:              ReturnStringAsIs,
+	slashingtypes.AttributeKeyBurnedCoins:         ConvertBigInt,
+	slashingtypes.AttributeKeyMissedBlocks:        ConvertInt64,
+	slashingtypes.AttributeKeyHeight:              ConvertInt64,
 }
 
 // ==============================================================================
@@ -77,6 +88,7 @@ var (
 	_ precompile.ValueDecoder = ConvertValAddressFromBech32
 	_ precompile.ValueDecoder = ConvertAccAddressFromBech32
 	_ precompile.ValueDecoder = ConvertInt64
+	_ precompile.ValueDecoder = ConvertBigInt
 	_ precompile.ValueDecoder = ReturnStringAsIs
 )
 
@@ -142,6 +154,18 @@ func ConvertUint64(attributeValue string) (any, error) {
 	return strconv.ParseUint(attributeValue, intBase, int64Bits)
 }
 
+// ConvertBigInt converts a `string` representing an integer, such as the amount of tokens burned
+// by a slash (from the Cosmos SDK slashing module), to a `*big.Int`.
+//
+// ConvertBigInt is a `precompile.ValueDecoder`.
+func ConvertBigInt(attributeValue string) (any, error) {
+	value, ok := new(big.Int).SetString(attributeValue, intBase)
+	if !ok {
+		return nil, ErrInvalidInteger
+	}
+	return value, nil
+}
+
 // ReturnStringAsIs converts a given attribute of type string and returns the same string (as type
 // any).
 //
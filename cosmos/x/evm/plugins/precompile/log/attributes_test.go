@@ -83,6 +83,16 @@ var _ = Describe("Attributes", func() {
 			uint64Val := libutils.MustGetAs[uint64](gethValue)
 			Expect(uint64Val).To(Equal(uint64(1)))
 		})
+
+		It("should correctly convert string to big int", func() {
+			gethValue, err := ConvertBigInt("100000000000000000000")
+			Expect(err).ToNot(HaveOccurred())
+			bigIntVal := libutils.MustGetAs[*big.Int](gethValue)
+			Expect(bigIntVal.String()).To(Equal("100000000000000000000"))
+
+			_, err = ConvertBigInt("1stake")
+			Expect(err).To(MatchError(ErrInvalidInteger))
+		})
 	})
 
 	Describe("Test Search Attributes for Argument", func() {
@@ -23,6 +23,7 @@ package precompile
 import (
 	"context"
 	"math/big"
+	"sync"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -31,7 +32,6 @@ import (
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state"
 	"pkg.furychain.dev/gridiron/eth/common"
-	"pkg.furychain.dev/gridiron/eth/core"
 	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/eth/core/vm"
 	"pkg.furychain.dev/gridiron/eth/params"
@@ -43,37 +43,50 @@ import (
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	plugins.Base
-	core.PrecompilePlugin
+	ethprecompile.ContextualPlugin
 
 	KVGasConfig() storetypes.GasConfig
-	SetKVGasConfig(storetypes.GasConfig)
 	TransientKVGasConfig() storetypes.GasConfig
-	SetTransientKVGasConfig(storetypes.GasConfig)
 }
 
-// plugin runs precompile containers in the Cosmos environment with the context gas configs.
+// plugin runs precompile containers in the Cosmos environment with the KV store gas configs set
+// in the x/evm params.
 type plugin struct {
 	libtypes.Registry[common.Address, vm.PrecompileContainer]
 	// precompiles is all supported precompile contracts.
 	precompiles []ethprecompile.Registrable
-	// kvGasConfig is the gas config for the KV store.
-	kvGasConfig storetypes.GasConfig
-	// transientKVGasConfig is the gas config for the transient KV store.
-	transientKVGasConfig storetypes.GasConfig
 	// sp allows resetting the context for the reentrancy into the EVM.
 	sp StatePlugin
+	// cp provides the x/evm params, which hold the KV store gas configs.
+	cp ConfigurationPlugin
+	// dpf builds the token precompiles of the denominations listed in the x/evm params. It may be
+	// nil, in which case no token precompiles are active.
+	dpf DenomPrecompileFactory
+	// tokens caches the token precompiles built by dpf, by denomination.
+	tokens   map[string]*tokenPrecompile
+	tokensMu sync.Mutex
 }
 
-// NewPlugin creates and returns a plugin with the default KV store gas configs.
-func NewPlugin(precompiles []ethprecompile.Registrable, sp StatePlugin) Plugin {
+// tokenPrecompile is a token precompile built for a denomination and its container.
+type tokenPrecompile struct {
+	pc        ethprecompile.Registrable
+	container vm.PrecompileContainer
+}
+
+// NewPlugin creates and returns a plugin which reads the KV store gas configs from the x/evm
+// params. The token precompiles of the denominations listed in the x/evm params are built with
+// dpf, if it is not nil.
+func NewPlugin(
+	precompiles []ethprecompile.Registrable, sp StatePlugin, cp ConfigurationPlugin,
+	dpf DenomPrecompileFactory,
+) Plugin {
 	return &plugin{
 		Registry:    registry.NewMap[common.Address, vm.PrecompileContainer](),
 		precompiles: precompiles,
-		// TODO: Re-enable gas config for precompiles.
-		// https://github.com/furychain/gridiron/issues/393
-		kvGasConfig:          storetypes.GasConfig{},
-		transientKVGasConfig: storetypes.GasConfig{},
-		sp:                   sp,
+		sp:          sp,
+		cp:          cp,
+		dpf:         dpf,
+		tokens:      make(map[string]*tokenPrecompile),
 	}
 }
 
@@ -95,37 +108,69 @@ func (p *plugin) GetActive(rules *params.Rules) []common.Address {
 	return active
 }
 
-// KVGasConfig implements Plugin.
-func (p *plugin) KVGasConfig() storetypes.GasConfig {
-	return p.kvGasConfig
+// tokenPrecompiles returns the token precompiles of the given denominations, building and caching
+// the ones that have not been built yet.
+func (p *plugin) tokenPrecompiles(denoms []string) []*tokenPrecompile {
+	if p.dpf == nil || len(denoms) == 0 {
+		return nil
+	}
+
+	p.tokensMu.Lock()
+	defer p.tokensMu.Unlock()
+	tokens := make([]*tokenPrecompile, len(denoms))
+	for i, denom := range denoms {
+		tp, ok := p.tokens[denom]
+		if !ok {
+			pc := p.dpf(denom)
+			container, err := ethprecompile.NewStatefulFactory().Build(pc, p)
+			if err != nil {
+				panic(err)
+			}
+			tp = &tokenPrecompile{pc: pc, container: container}
+			p.tokens[denom] = tp
+		}
+		tokens[i] = tp
+	}
+	return tokens
 }
 
-// SetKVGasConfig implements Plugin.
-func (p *plugin) SetKVGasConfig(kvGasConfig storetypes.GasConfig) {
-	p.kvGasConfig = kvGasConfig
+// KVGasConfig returns the KV store gas config charged during precompile execution.
+//
+// KVGasConfig implements Plugin.
+func (p *plugin) KVGasConfig() storetypes.GasConfig {
+	return p.cp.GetParams().PrecompileKVGas.GasConfig()
 }
 
+// TransientKVGasConfig returns the transient KV store gas config charged during precompile
+// execution.
+//
 // TransientKVGasConfig implements Plugin.
 func (p *plugin) TransientKVGasConfig() storetypes.GasConfig {
-	return p.transientKVGasConfig
-}
-
-// SetTransientKVGasConfig implements Plugin.
-func (p *plugin) SetTransientKVGasConfig(transientKVGasConfig storetypes.GasConfig) {
-	p.transientKVGasConfig = transientKVGasConfig
+	return p.cp.GetParams().PrecompileTransientKVGas.GasConfig()
 }
 
 // Run runs the a precompile container and returns the remaining gas after execution by injecting
-// a Cosmos SDK `GasMeter`. This function returns an error if the precompile execution returns an
-// error or insufficient gas is provided.
+// a Cosmos SDK `GasMeter`, limited by the supplied gas. This function returns an error if the
+// precompile execution returns an error or insufficient gas is provided.
 //
 // Run implements core.PrecompilePlugin.
 func (p *plugin) Run(
 	evm ethprecompile.EVM, pc vm.PrecompileContainer, input []byte,
 	caller common.Address, value *big.Int, suppliedGas uint64, readonly bool,
-) ([]byte, uint64, error) {
-	// use a precompile-specific gas meter for dynamic consumption
-	gm := storetypes.NewInfiniteGasMeter()
+) (ret []byte, remainingGas uint64, err error) {
+	// use a precompile-specific gas meter for dynamic consumption, which panics once the supplied
+	// gas is exhausted
+	gm := storetypes.NewGasMeter(suppliedGas)
+	defer func() {
+		if r := recover(); r != nil {
+			// handle overconsumption of gas
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ret, remainingGas, err = nil, 0, vm.ErrOutOfGas
+		}
+	}()
+
 	// consume static gas from RequiredGas
 	gm.ConsumeGas(pc.RequiredGas(input), "RequiredGas")
 
@@ -136,12 +181,15 @@ func (p *plugin) Run(
 	// begin precompile execution => begin emitting Cosmos event as Eth logs
 	cem := utils.MustGetAs[state.ControllableEventManager](ctx.EventManager())
 	cem.BeginPrecompileExecution(sdb)
+	// end precompile execution => stop emitting Cosmos event as Eth logs
+	defer cem.EndPrecompileExecution()
 
-	// run precompile container
-	ret, err := pc.Run(
+	// run precompile container, charging its store accesses to the precompile gas meter
+	params := p.cp.GetParams()
+	ret, err = pc.Run(
 		ctx.WithGasMeter(gm).
-			WithKVGasConfig(p.kvGasConfig).
-			WithTransientKVGasConfig(p.transientKVGasConfig),
+			WithKVGasConfig(params.PrecompileKVGas.GasConfig()).
+			WithTransientKVGasConfig(params.PrecompileTransientKVGas.GasConfig()),
 		evm,
 		input,
 		caller,
@@ -149,16 +197,8 @@ func (p *plugin) Run(
 		readonly,
 	)
 
-	// end precompile execution => stop emitting Cosmos event as Eth logs
-	cem.EndPrecompileExecution()
-
-	// handle overconsumption of gas
-	if gm.GasConsumed() > suppliedGas {
-		return nil, 0, vm.ErrOutOfGas
-	}
-
-	// valid precompile gas consumption => return supplied gas
-	return ret, suppliedGas - gm.GasConsumed(), err
+	// valid precompile gas consumption => return remaining gas
+	return ret, gm.GasRemaining(), err
 }
 
 // EnableReentrancy sets the state so that execution can enter the EVM again.
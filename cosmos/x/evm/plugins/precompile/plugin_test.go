@@ -24,14 +24,18 @@ import (
 	"context"
 	"math/big"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	testutil "pkg.furychain.dev/gridiron/cosmos/testing/utils"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state/events"
 	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/state/events/mock"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
 	"pkg.furychain.dev/gridiron/eth/common"
 	"pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/eth/core/vm"
+	"pkg.furychain.dev/gridiron/eth/params"
 	"pkg.furychain.dev/gridiron/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -43,13 +47,15 @@ var ctx sdk.Context
 var _ = Describe("plugin", func() {
 	var p *plugin
 	var e precompile.EVM
+	var cp *mockConfigurationPlugin
 
 	BeforeEach(func() {
 		ctx = testutil.NewContext()
 		ctx = ctx.WithEventManager(
 			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
 		)
-		p = utils.MustGetAs[*plugin](NewPlugin(nil, nil))
+		cp = &mockConfigurationPlugin{params: types.DefaultParams()}
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, nil, cp, nil))
 		e = &mockEVM{}
 	})
 
@@ -64,16 +70,127 @@ var _ = Describe("plugin", func() {
 		Expect(err.Error()).To(Equal("out of gas"))
 	})
 
-	// TODO: re-enable once dynamic gas config is implemented.
-	// It("should plug in custom gas configs", func() {
-	// 	Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(0)))
-	// 	Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(0)))
+	It("should plug in the gas configs from the params", func() {
+		Expect(p.KVGasConfig()).To(Equal(storetypes.KVGasConfig()))
+		Expect(p.TransientKVGasConfig()).To(Equal(storetypes.TransientGasConfig()))
+
+		cp.params.PrecompileKVGas.DeleteCost = 2000
+		Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(2000)))
+	})
+
+	It("should charge store accesses to the precompile gas meter", func() {
+		_, remainingGas, err := p.Run(e, &mockKVStateless{}, []byte{}, addr, new(big.Int), 5000, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(5000 - 10 - storetypes.KVGasConfig().HasCost))
+
+		cp.params.PrecompileKVGas.HasCost = 100
+		_, remainingGas, err = p.Run(e, &mockKVStateless{}, []byte{}, addr, new(big.Int), 5000, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(5000 - 10 - 100)))
+	})
 
-	// 	p.SetKVGasConfig(storetypes.KVGasConfig())
-	// 	Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(1000)))
-	// 	p.SetTransientKVGasConfig(storetypes.TransientGasConfig())
-	// 	Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(100)))
-	// })
+	It("should run the receive method of a stateful precompile on a value transfer", func() {
+		pc, err := precompile.NewStatefulFactory().Build(newMockReceiver(), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		ret, remainingGas, err := p.Run(e, pc, []byte{}, addr, big.NewInt(69), 5000, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(BeNil())
+		Expect(remainingGas).To(Equal(5000 - 10 - storetypes.KVGasConfig().WriteCostFlat -
+			storetypes.KVGasConfig().WriteCostPerByte*uint64(len(addr.Bytes())+1)))
+		Expect(ctx.KVStore(testutil.EvmKey).Get(addr.Bytes())).To(Equal([]byte{69}))
+
+		// calls with calldata do not reach the receive method
+		_, _, err = p.Run(e, pc, []byte{1}, addr, big.NewInt(69), 5000, false)
+		Expect(err).To(MatchError(precompile.ErrInvalidInputToPrecompile))
+	})
+
+	Describe("active precompiles", func() {
+		var (
+			rules        = &params.Rules{}
+			addr1, addr2 = common.BytesToAddress([]byte{0x69}), common.BytesToAddress([]byte{0x70})
+			moved        = common.BytesToAddress([]byte{0x71})
+			pc1, pc2     = &mockRegistrable{key: addr1}, &mockRegistrable{key: addr2}
+		)
+
+		BeforeEach(func() {
+			p = utils.MustGetAs[*plugin](NewPlugin(
+				[]precompile.Registrable{pc1, pc2}, nil, cp, newMockToken,
+			))
+			Expect(p.Register(pc1)).To(Succeed())
+			Expect(p.Register(pc2)).To(Succeed())
+		})
+
+		It("should expose every precompile without configs", func() {
+			Expect(p.WithContext(ctx)).To(Equal(p))
+		})
+
+		It("should deactivate precompiles from their deactivation height", func() {
+			cp.params.Precompiles = []types.PrecompileConfig{
+				{Address: addr1.Hex(), DeactivationHeight: 10},
+			}
+
+			active := p.WithContext(ctx.WithBlockHeight(10))
+			Expect(active.Has(addr1)).To(BeFalse())
+			Expect(active.Get(addr1)).To(BeNil())
+			Expect(active.Has(addr2)).To(BeTrue())
+			Expect(active.GetActive(rules)).ToNot(ContainElement(addr1))
+			Expect(active.GetActive(rules)).To(ContainElement(addr2))
+			Expect(active.GetPrecompiles(rules)).To(Equal([]precompile.Registrable{pc2}))
+
+			// calls at a historical height use the precompiles active then
+			active = p.WithContext(ctx.WithBlockHeight(9))
+			Expect(active.Has(addr1)).To(BeTrue())
+			Expect(active.GetActive(rules)).To(ContainElement(addr1))
+		})
+
+		It("should activate precompiles from their activation height", func() {
+			cp.params.Precompiles = []types.PrecompileConfig{
+				{Address: addr2.Hex(), ActivationHeight: 10},
+			}
+			Expect(p.WithContext(ctx.WithBlockHeight(9)).Has(addr2)).To(BeFalse())
+			Expect(p.WithContext(ctx.WithBlockHeight(10)).Has(addr2)).To(BeTrue())
+		})
+
+		It("should move precompiles to their deployed address", func() {
+			cp.params.Precompiles = []types.PrecompileConfig{
+				{Address: addr2.Hex(), DeployedAddress: moved.Hex()},
+			}
+
+			active := p.WithContext(ctx)
+			Expect(active.Has(addr2)).To(BeFalse())
+			Expect(active.Has(moved)).To(BeTrue())
+			Expect(active.Get(moved)).To(Equal(pc2))
+			Expect(active.GetActive(rules)).To(ContainElement(moved))
+			Expect(active.GetActive(rules)).ToNot(ContainElement(addr2))
+			Expect(active.GetPrecompiles(rules)).To(Equal([]precompile.Registrable{pc1, pc2}))
+		})
+
+		It("should activate the token precompiles of the denoms in the params", func() {
+			token := common.BytesToAddress([]byte("afury"))
+			Expect(p.WithContext(ctx).Has(token)).To(BeFalse())
+
+			cp.params.BankERC20Denoms = []string{"afury"}
+			active := p.WithContext(ctx)
+			Expect(active.Has(token)).To(BeTrue())
+			Expect(active.Get(token)).ToNot(BeNil())
+			Expect(active.Has(addr1)).To(BeTrue())
+			Expect(active.GetActive(rules)).To(Equal([]common.Address{addr1, addr2, token}))
+			Expect(active.GetPrecompiles(rules)).To(HaveLen(3))
+
+			// the token precompile is built once and reused at later heights
+			Expect(p.WithContext(ctx.WithBlockHeight(10)).Get(token)).To(Equal(active.Get(token)))
+
+			// the token precompiles are configured like the other precompiles
+			cp.params.Precompiles = []types.PrecompileConfig{
+				{Address: token.Hex(), DeactivationHeight: 10},
+			}
+			active = p.WithContext(ctx.WithBlockHeight(10))
+			Expect(active.Has(token)).To(BeFalse())
+			Expect(active.GetActive(rules)).To(Equal([]common.Address{addr1, addr2}))
+			Expect(active.GetPrecompiles(rules)).To(Equal([]precompile.Registrable{pc1, pc2}))
+		})
+	})
 })
 
 // MOCKS BELOW.
@@ -94,6 +211,27 @@ func (ms *mockSDB) GetContext() context.Context {
 	return ctx
 }
 
+type mockConfigurationPlugin struct {
+	params *types.Params
+}
+
+func (mcp *mockConfigurationPlugin) GetParams() *types.Params {
+	return mcp.params
+}
+
+func (mcp *mockConfigurationPlugin) GetParamsAt(context.Context) *types.Params {
+	return mcp.params
+}
+
+type mockRegistrable struct {
+	mockStateless
+	key common.Address
+}
+
+func (mr *mockRegistrable) RegistryKey() common.Address {
+	return mr.key
+}
+
 type mockStateless struct{}
 
 var addr = common.BytesToAddress([]byte{1})
@@ -117,3 +255,50 @@ func (ms *mockStateless) RequiredGas(input []byte) uint64 {
 func (ms *mockStateless) WithStateDB(vm.GethStateDB) vm.PrecompileContainer {
 	return ms
 }
+
+// mockKVStateless reads a key from the x/evm store, which is charged the KV store has cost.
+type mockKVStateless struct {
+	mockStateless
+}
+
+func (ms *mockKVStateless) Run(
+	ctx context.Context, evm precompile.EVM, input []byte,
+	caller common.Address, value *big.Int, readonly bool,
+) ([]byte, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(testutil.EvmKey).Has([]byte{1})
+	return nil, nil
+}
+
+// mockReceiver is a stateful precompile whose `receive()` function stores the received value,
+// keyed by the sender, in the x/evm store.
+type mockReceiver struct {
+	precompile.BaseContract
+}
+
+func newMockReceiver() *mockReceiver {
+	return &mockReceiver{
+		BaseContract: precompile.NewBaseContract(
+			`[{"stateMutability":"payable","type":"receive"}]`, addr,
+		),
+	}
+}
+
+// newMockToken returns a token precompile of denom, at the address of the bytes of denom.
+func newMockToken(denom string) precompile.Registrable {
+	return &mockReceiver{
+		BaseContract: precompile.NewBaseContract(
+			`[{"stateMutability":"payable","type":"receive"}]`, common.BytesToAddress([]byte(denom)),
+		),
+	}
+}
+
+func (mr *mockReceiver) Receive() *precompile.Method {
+	return &precompile.Method{AbiSig: "receive()", Execute: mr.receive, RequiredGas: 10}
+}
+
+func (mr *mockReceiver) receive(
+	ctx context.Context, _ precompile.EVM, caller common.Address, value *big.Int, _ bool, _ ...any,
+) ([]any, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(testutil.EvmKey).Set(caller.Bytes(), value.Bytes())
+	return nil, nil
+}
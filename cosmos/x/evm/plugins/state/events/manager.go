@@ -92,6 +92,13 @@ func (m *manager) EmitEvents(events sdk.Events) {
 	}
 }
 
+// EmitEventsWithoutLogs emits the given events on the underlying Cosmos SDK event manager only,
+// without building Eth logs from them, even during precompile execution. It is used for events
+// that do not belong to a precompile, such as the events of a dispatched Cosmos message.
+func (m *manager) EmitEventsWithoutLogs(events sdk.Events) {
+	m.EventManager.EmitEvents(events)
+}
+
 // Registry implements `libtypes.Registrable`.
 func (m *manager) RegistryKey() string {
 	return managerRegistryKey
@@ -101,4 +101,16 @@ var _ = Describe("Manager", func() {
 
 		Expect(func() { cem.Finalize() }).ToNot(Panic())
 	})
+
+	It("should not build eth logs from events emitted without logs during precompile", func() {
+		cem.BeginPrecompileExecution(ldb)
+
+		Expect(func() {
+			cem.EmitEventsWithoutLogs(sdk.Events{sdk.NewEvent("non-eth-event")})
+		}).ToNot(Panic())
+		Expect(ctx.EventManager().Events()).To(HaveLen(2))
+		Expect(ldb.AddLogCalls()).To(HaveLen(0))
+
+		cem.EndPrecompileExecution()
+	})
 })
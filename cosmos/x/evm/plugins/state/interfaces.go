@@ -42,6 +42,8 @@ type ControllableEventManager interface {
 	BeginPrecompileExecution(events.LogsDB)
 	// EndPrecompileExecution ends a precompile execution by resetting the logs DB to nil.
 	EndPrecompileExecution()
+	// EmitEventsWithoutLogs emits the given events without building Eth logs from them.
+	EmitEventsWithoutLogs(sdk.Events)
 }
 
 // ControllableMultiStore defines a cache MultiStore that is controllable (snapshottable and
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's value to the
+// corresponding x/evm type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.CodeKeyPrefix:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case types.StorageKeyPrefix, types.CodeHashKeyPrefix:
+			return fmt.Sprintf(
+				"%s\n%s", common.BytesToHash(kvA.Value).Hex(), common.BytesToHash(kvB.Value).Hex(),
+			)
+
+		case types.HeaderKey:
+			headerA, errA := coretypes.UnmarshalHeader(kvA.Value)
+			headerB, errB := coretypes.UnmarshalHeader(kvB.Value)
+			if errA != nil || errB != nil {
+				panic(fmt.Sprintf("invalid evm header: %v %v", errA, errB))
+			}
+			return fmt.Sprintf("%v\n%v", headerA, headerB)
+
+		case types.ParamsKey:
+			var paramsA, paramsB types.Params
+			if err := paramsA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := paramsB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case types.BaseFeeKey:
+			return fmt.Sprintf(
+				"%s\n%s", new(big.Int).SetBytes(kvA.Value), new(big.Int).SetBytes(kvB.Value),
+			)
+
+		default:
+			if bytes.Equal(kvA.Value, kvB.Value) {
+				return ""
+			}
+			panic(fmt.Sprintf("invalid evm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
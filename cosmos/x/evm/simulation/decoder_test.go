@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package simulation_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/simulation"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DecodeStore", func() {
+	var dec func(kvA, kvB kv.Pair) string
+
+	BeforeEach(func() {
+		dec = simulation.NewDecodeStore()
+	})
+
+	It("should decode storage slots", func() {
+		a, b := common.HexToHash("0x1"), common.HexToHash("0x2")
+		Expect(dec(
+			kv.Pair{Key: []byte{types.StorageKeyPrefix}, Value: a.Bytes()},
+			kv.Pair{Key: []byte{types.StorageKeyPrefix}, Value: b.Bytes()},
+		)).To(Equal(fmt.Sprintf("%s\n%s", a.Hex(), b.Hex())))
+	})
+
+	It("should decode params", func() {
+		params := types.DefaultParams()
+		bz, err := params.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dec(
+			kv.Pair{Key: []byte{types.ParamsKey}, Value: bz},
+			kv.Pair{Key: []byte{types.ParamsKey}, Value: bz},
+		)).To(Equal(fmt.Sprintf("%v\n%v", *params, *params)))
+	})
+
+	It("should decode the base fee", func() {
+		Expect(dec(
+			kv.Pair{Key: []byte{types.BaseFeeKey}, Value: big.NewInt(7).Bytes()},
+			kv.Pair{Key: []byte{types.BaseFeeKey}, Value: big.NewInt(8).Bytes()},
+		)).To(Equal("7\n8"))
+	})
+
+	It("should panic on unknown prefixes", func() {
+		Expect(func() {
+			dec(kv.Pair{Key: []byte{0xff}, Value: []byte{1}}, kv.Pair{Key: []byte{0xff}, Value: []byte{2}})
+		}).To(Panic())
+	})
+})
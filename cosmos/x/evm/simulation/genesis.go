@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+)
+
+// Simulation parameter constants.
+const (
+	baseFeeChangeDenominator = "base_fee_change_denominator"
+	elasticityMultiplier     = "elasticity_multiplier"
+	initialBaseFee           = "initial_base_fee"
+)
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator.
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint64 {
+	return uint64(r.Intn(16) + 1) //nolint:gomnd // between 1 and 16.
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier.
+func GenElasticityMultiplier(r *rand.Rand) uint64 {
+	return uint64(r.Intn(4) + 1) //nolint:gomnd // between 1 and 4.
+}
+
+// GenInitialBaseFee randomized InitialBaseFee. It is kept low so that the simulation accounts can
+// afford EVM transactions.
+func GenInitialBaseFee(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000) + 1) //nolint:gomnd // between 1 and 1000.
+}
+
+// RandomizedGenState generates a random GenesisState for the x/evm module. The EVM denom is the
+// bond denom, so that the simulation accounts are funded in the EVM.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		bfcd uint64
+		em   uint64
+		ibf  uint64
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, baseFeeChangeDenominator, &bfcd, simState.Rand,
+		func(r *rand.Rand) { bfcd = GenBaseFeeChangeDenominator(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, elasticityMultiplier, &em, simState.Rand,
+		func(r *rand.Rand) { em = GenElasticityMultiplier(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, initialBaseFee, &ibf, simState.Rand,
+		func(r *rand.Rand) { ibf = GenInitialBaseFee(r) },
+	)
+
+	params := types.DefaultParams()
+	params.EvmDenom = sdk.DefaultBondDenom
+	params.FeeMarket.BaseFeeChangeDenominator = bfcd
+	params.FeeMarket.ElasticityMultiplier = em
+	params.FeeMarket.InitialBaseFee = ibf
+
+	genesis := types.NewGenesisState(*params, make(map[string]*types.Contract), make(map[string]string))
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated evm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
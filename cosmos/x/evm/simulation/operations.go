@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package simulation
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	bindings "pkg.furychain.dev/gridiron/contracts/bindings/testing"
+	cosmlib "pkg.furychain.dev/gridiron/cosmos/lib"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/keeper"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/plugins/txpool"
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+	"pkg.furychain.dev/gridiron/eth/common"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	"pkg.furychain.dev/gridiron/eth/crypto"
+	"pkg.furychain.dev/gridiron/eth/params"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgFundEthAccount = "op_weight_msg_fund_eth_account" //nolint:gosec // not credentials.
+	OpWeightMsgEthTransfer    = "op_weight_msg_eth_transfer"     //nolint:gosec // not credentials.
+	OpWeightMsgEthDeploy      = "op_weight_msg_eth_deploy"       //nolint:gosec // not credentials.
+
+	DefaultWeightMsgFundEthAccount = 30
+	DefaultWeightMsgEthTransfer    = 50
+	DefaultWeightMsgEthDeploy      = 20
+)
+
+const (
+	// transferGas is the gas limit used for plain value transfers.
+	transferGas = params.TxGas
+	// deployGas is the gas limit used for contract deployments.
+	deployGas = 3_000_000
+	// callGas is the gas limit used for contract calls.
+	callGas = 1_000_000
+	// feeCapMultiplier bounds how far the base fee can rise before a simulated transaction is
+	// included.
+	feeCapMultiplier = 4
+)
+
+// AccountKeeper defines the account keeper used by the simulation operations.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetSequence(context.Context, sdk.AccAddress) (uint64, error)
+}
+
+// BankKeeper defines the bank keeper used by the simulation operations.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// WeightedOperations returns all the operations of the evm module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgFundEthAccount int
+		weightMsgEthTransfer    int
+		weightMsgEthDeploy      int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgFundEthAccount, &weightMsgFundEthAccount, nil,
+		func(_ *rand.Rand) { weightMsgFundEthAccount = DefaultWeightMsgFundEthAccount },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthTransfer, &weightMsgEthTransfer, nil,
+		func(_ *rand.Rand) { weightMsgEthTransfer = DefaultWeightMsgEthTransfer },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthDeploy, &weightMsgEthDeploy, nil,
+		func(_ *rand.Rand) { weightMsgEthDeploy = DefaultWeightMsgEthDeploy },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgFundEthAccount, SimulateMsgFundEthAccount(txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthTransfer, SimulateEthTransfer(txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthDeploy, SimulateEthDeploy(txCfg, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgFundEthAccount generates a bank MsgSend from a random simulation account to the
+// Ethereum address derived from its own private key, so that the account can pay for EVM
+// transactions.
+func SimulateMsgFundEthAccount(
+	txCfg client.TxConfig, ak AccountKeeper, bk BankKeeper, k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		_, ethAddr, err := ethKeyFor(simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to derive eth key"), nil, err
+		}
+
+		evmDenom := paramsOf(ctx, k).EvmDenom
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(evmDenom)
+		amount, err := simtypes.RandPositiveInt(r, spendable.QuoRaw(2)) //nolint:gomnd // keep half.
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(evmDenom, amount))
+		msg := banktypes.NewMsgSend(simAccount.Address, cosmlib.AddressToAccAddress(ethAddr), coins)
+		return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		})
+	}
+}
+
+// SimulateEthTransfer generates an EVM value transfer between the Ethereum addresses of two
+// random simulation accounts.
+func SimulateEthTransfer(
+	txCfg client.TxConfig, ak AccountKeeper, bk BankKeeper, k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		_, toAddr, err := ethKeyFor(to)
+		if err != nil {
+			return noOpMsg("unable to derive eth key"), nil, err
+		}
+
+		return deliverEthTx(r, app, ctx, txCfg, ak, bk, k, from, &toAddr, nil, transferGas, true)
+	}
+}
+
+// SimulateEthDeploy generates an EVM contract deployment from the Ethereum address of a random
+// simulation account. On success, a future operation calling the deployed contract is returned.
+func SimulateEthDeploy(
+	txCfg client.TxConfig, ak AccountKeeper, bk BankKeeper, k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		_, from, err := ethKeyFor(simAccount)
+		if err != nil {
+			return noOpMsg("unable to derive eth key"), nil, err
+		}
+		nonce, err := ak.GetSequence(ctx, cosmlib.AddressToAccAddress(from))
+		if err != nil {
+			return noOpMsg("eth account does not exist"), nil, nil
+		}
+
+		var (
+			bin      = bindings.ConsumeGasBin
+			contract = bindings.ConsumeGasABI
+		)
+		if r.Intn(2) == 0 { //nolint:gomnd // coin flip.
+			bin, contract = bindings.SolmateERC20Bin, bindings.SolmateERC20ABI
+		}
+
+		opMsg, _, err := deliverEthTx(
+			r, app, ctx, txCfg, ak, bk, k, simAccount, nil, common.FromHex(bin), deployGas, false,
+		)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		future := simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op: SimulateEthCall(
+				txCfg, ak, bk, k, simAccount, crypto.CreateAddress(from, nonce), contract,
+			),
+		}
+		return opMsg, []simtypes.FutureOperation{future}, nil
+	}
+}
+
+// SimulateEthCall generates a call to a contract deployed by SimulateEthDeploy.
+func SimulateEthCall(
+	txCfg client.TxConfig, ak AccountKeeper, bk BankKeeper, k *keeper.Keeper,
+	deployer simtypes.Account, contract common.Address, contractABI string,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var parsed abi.ABI
+		if err := parsed.UnmarshalJSON([]byte(contractABI)); err != nil {
+			return noOpMsg("unable to parse contract abi"), nil, err
+		}
+
+		var (
+			input []byte
+			err   error
+		)
+		if _, ok := parsed.Methods["mint"]; ok {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			_, to, keyErr := ethKeyFor(recipient)
+			if keyErr != nil {
+				return noOpMsg("unable to derive eth key"), nil, keyErr
+			}
+			input, err = parsed.Pack("mint", to, big.NewInt(r.Int63n(1e18))) //nolint:gomnd // random.
+		} else {
+			input, err = parsed.Pack("consumeGas", big.NewInt(int64(r.Intn(50_000)))) //nolint:gomnd // random.
+		}
+		if err != nil {
+			return noOpMsg("unable to pack contract call"), nil, err
+		}
+
+		return deliverEthTx(r, app, ctx, txCfg, ak, bk, k, deployer, &contract, input, callGas, false)
+	}
+}
+
+// deliverEthTx builds, signs, and delivers an EVM transaction from the Ethereum address of the
+// given simulation account. If withValue is set, a random value is transferred to `to`.
+func deliverEthTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txCfg client.TxConfig,
+	ak AccountKeeper, bk BankKeeper, k *keeper.Keeper, simAccount simtypes.Account,
+	to *common.Address, data []byte, gas uint64, withValue bool,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	key, from, err := ethKeyFor(simAccount)
+	if err != nil {
+		return noOpMsg("unable to derive eth key"), nil, err
+	}
+	accAddr := cosmlib.AddressToAccAddress(from)
+	if ak.GetAccount(ctx, accAddr) == nil {
+		return noOpMsg("eth account does not exist"), nil, nil
+	}
+	nonce, err := ak.GetSequence(ctx, accAddr)
+	if err != nil {
+		return noOpMsg("unable to get eth account nonce"), nil, err
+	}
+
+	evmParams := paramsOf(ctx, k)
+	gasTipCap := big.NewInt(r.Int63n(10)) //nolint:gomnd // small random tip.
+	gasFeeCap := new(big.Int).Mul(baseFee(ctx, k, evmParams), big.NewInt(feeCapMultiplier))
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+
+	// Ensure the account can pay for the worst case gas cost before picking a value.
+	balance := bk.GetBalance(ctx, accAddr, evmParams.EvmDenom).Amount
+	maxFee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gas)))
+	if !balance.GT(maxFee) {
+		return noOpMsg("insufficient funds"), nil, nil
+	}
+	value := new(big.Int)
+	if withValue {
+		amount, randErr := simtypes.RandPositiveInt(r, balance.Sub(maxFee))
+		if randErr != nil {
+			return noOpMsg("insufficient funds"), nil, nil
+		}
+		value = amount.BigInt()
+	}
+
+	signedTx, err := coretypes.SignNewTx(
+		key,
+		coretypes.LatestSignerForChainID(evmParams.EthereumChainConfig().ChainID),
+		&coretypes.DynamicFeeTx{
+			Nonce:     nonce,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gas,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		},
+	)
+	if err != nil {
+		return noOpMsg("unable to sign eth tx"), nil, err
+	}
+
+	sdkTx, err := txpool.SerializeToSdkTx(
+		evmParams.EvmDenom, client.Context{}.WithTxConfig(txCfg), signedTx,
+	)
+	if err != nil {
+		return noOpMsg("unable to serialize eth tx"), nil, err
+	}
+	if _, _, err = app.SimDeliver(txCfg.TxEncoder(), sdkTx); err != nil {
+		return noOpMsg("unable to deliver eth tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsgBasic(
+		types.ModuleName, sdk.MsgTypeURL(&types.EthTransactionRequest{}), "", true, nil,
+	), nil, nil
+}
+
+// paramsOf returns the current x/evm module parameters.
+func paramsOf(ctx sdk.Context, k *keeper.Keeper) types.Params {
+	res, err := k.Params(ctx, &types.ParamsRequest{})
+	if err != nil {
+		panic(err)
+	}
+	return res.Params
+}
+
+// baseFee returns the base fee of the latest block, falling back to the initial base fee of the
+// fee market before the first block has been finalized.
+func baseFee(ctx sdk.Context, k *keeper.Keeper, evmParams types.Params) *big.Int {
+	if res, err := k.BaseFee(ctx, &types.BaseFeeRequest{}); err == nil && res.BaseFee != "" {
+		if bf, ok := new(big.Int).SetString(res.BaseFee, 10); ok { //nolint:gomnd // base 10.
+			return bf
+		}
+	}
+	return evmParams.FeeMarket.InitialBaseFeeBig()
+}
+
+// ethKeyFor returns the Ethereum private key and address derived from the secp256k1 private key
+// of the given simulation account.
+func ethKeyFor(acc simtypes.Account) (*ecdsa.PrivateKey, common.Address, error) {
+	key, err := crypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// noOpMsg returns a no-op message for an EVM transaction.
+func noOpMsg(comment string) simtypes.OperationMsg {
+	return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.EthTransactionRequest{}), comment)
+}
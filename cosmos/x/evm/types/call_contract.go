@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	errorslib "pkg.furychain.dev/gridiron/lib/errors"
+)
+
+// Compile-time interface assertions.
+var _ sdk.Msg = (*CallContractRequest)(nil)
+
+// NewCallContractRequest creates a new `CallContractRequest`.
+func NewCallContractRequest(
+	sender sdk.AccAddress, to common.Address, data []byte, value sdkmath.Int, gasLimit uint64,
+) *CallContractRequest {
+	return &CallContractRequest{
+		Sender:   sender.String(),
+		To:       to.Hex(),
+		Data:     data,
+		Value:    value,
+		GasLimit: gasLimit,
+	}
+}
+
+// GetSigners returns the expected signers for the request.
+func (m CallContractRequest) GetSigners() []sdk.AccAddress {
+	//#nosec G703: // purposely leave error unhandled.
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validates the request.
+func (m *CallContractRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorslib.Wrap(err, "invalid sender address")
+	}
+
+	if !common.IsHexAddress(m.To) {
+		return errorslib.Wrapf(ErrInvalidContractAddress, "%s", m.To)
+	}
+
+	return validateExecution(m.Value, m.GasLimit)
+}
+
+// AsTransaction returns the request as an unsigned, zero gas price, `coretypes.Transaction` with
+// the given nonce.
+func (m *CallContractRequest) AsTransaction(nonce uint64) *coretypes.Transaction {
+	to := common.HexToAddress(m.To)
+	return coretypes.NewTx(&coretypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: new(big.Int),
+		Gas:      m.GasLimit,
+		To:       &to,
+		Value:    valueOrZero(m.Value),
+		Data:     m.Data,
+	})
+}
+
+// validateExecution validates the value and gas limit of a contract request.
+func validateExecution(value sdkmath.Int, gasLimit uint64) error {
+	if !value.IsNil() && value.IsNegative() {
+		return ErrInvalidValue
+	}
+
+	if gasLimit == 0 {
+		return ErrInvalidGasLimit
+	}
+
+	return nil
+}
+
+// valueOrZero returns the given value as a `*big.Int`, treating an unset value as zero.
+func valueOrZero(value sdkmath.Int) *big.Int {
+	if value.IsNil() {
+		return new(big.Int)
+	}
+	return value.BigInt()
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+	"pkg.furychain.dev/gridiron/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CallContractRequest", func() {
+	var (
+		sender   = sdk.AccAddress([]byte("sender"))
+		contract = common.HexToAddress("0x1234")
+		req      *types.CallContractRequest
+	)
+
+	BeforeEach(func() {
+		req = types.NewCallContractRequest(sender, contract, []byte{1}, sdkmath.NewInt(5), 21000)
+	})
+
+	It("should return the sender as the signer", func() {
+		Expect(req.GetSigners()).To(Equal([]sdk.AccAddress{sender}))
+	})
+
+	It("should validate", func() {
+		Expect(req.ValidateBasic()).To(Succeed())
+
+		req.To = "not an address"
+		Expect(req.ValidateBasic()).To(MatchError(ContainSubstring(types.ErrInvalidContractAddress.Error())))
+	})
+
+	It("should reject invalid execution parameters", func() {
+		req.GasLimit = 0
+		Expect(req.ValidateBasic()).To(MatchError(types.ErrInvalidGasLimit))
+
+		req.GasLimit = 21000
+		req.Value = sdkmath.NewInt(-1)
+		Expect(req.ValidateBasic()).To(MatchError(types.ErrInvalidValue))
+	})
+
+	It("should convert to an unsigned transaction", func() {
+		tx := req.AsTransaction(7)
+		Expect(tx.Nonce()).To(Equal(uint64(7)))
+		Expect(*tx.To()).To(Equal(contract))
+		Expect(tx.Value().Int64()).To(Equal(int64(5)))
+		Expect(tx.Gas()).To(Equal(uint64(21000)))
+		Expect(tx.GasPrice().Sign()).To(BeZero())
+		Expect(tx.Data()).To(Equal([]byte{1}))
+	})
+})
@@ -32,6 +32,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&EthTransactionRequest{},
 		&UpdateParamsRequest{},
+		&CallContractRequest{},
+		&DeployContractRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
@@ -24,3 +24,13 @@ const (
 	StoreKey   = "evm"
 	ModuleName = "evm"
 )
+
+var (
+	// EventTypeEthereumLog is emitted for each log of an evm execution that is not included in the
+	// block, such as the execution of a `MsgCallContract` or `MsgDeployContract`.
+	EventTypeEthereumLog = "ethereum_log"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyTopics  = "topics"
+	AttributeKeyData    = "data"
+)
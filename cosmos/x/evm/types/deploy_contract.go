@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+	errorslib "pkg.furychain.dev/gridiron/lib/errors"
+)
+
+// Compile-time interface assertions.
+var _ sdk.Msg = (*DeployContractRequest)(nil)
+
+// NewDeployContractRequest creates a new `DeployContractRequest`.
+func NewDeployContractRequest(
+	sender sdk.AccAddress, bytecode []byte, value sdkmath.Int, gasLimit uint64,
+) *DeployContractRequest {
+	return &DeployContractRequest{
+		Sender:   sender.String(),
+		Bytecode: bytecode,
+		Value:    value,
+		GasLimit: gasLimit,
+	}
+}
+
+// GetSigners returns the expected signers for the request.
+func (m DeployContractRequest) GetSigners() []sdk.AccAddress {
+	//#nosec G703: // purposely leave error unhandled.
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validates the request.
+func (m *DeployContractRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorslib.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Bytecode) == 0 {
+		return ErrEmptyBytecode
+	}
+
+	return validateExecution(m.Value, m.GasLimit)
+}
+
+// AsTransaction returns the request as an unsigned, zero gas price, contract creation
+// `coretypes.Transaction` with the given nonce.
+func (m *DeployContractRequest) AsTransaction(nonce uint64) *coretypes.Transaction {
+	return coretypes.NewTx(&coretypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: new(big.Int),
+		Gas:      m.GasLimit,
+		Value:    valueOrZero(m.Value),
+		Data:     m.Bytecode,
+	})
+}
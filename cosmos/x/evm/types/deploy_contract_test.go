@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/cosmos/x/evm/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeployContractRequest", func() {
+	var (
+		sender = sdk.AccAddress([]byte("sender"))
+		req    *types.DeployContractRequest
+	)
+
+	BeforeEach(func() {
+		req = types.NewDeployContractRequest(sender, []byte{0x60, 0x80}, sdkmath.Int{}, 100000)
+	})
+
+	It("should validate", func() {
+		Expect(req.GetSigners()).To(Equal([]sdk.AccAddress{sender}))
+		Expect(req.ValidateBasic()).To(Succeed())
+
+		req.Bytecode = nil
+		Expect(req.ValidateBasic()).To(MatchError(types.ErrEmptyBytecode))
+	})
+
+	It("should convert to an unsigned contract creation transaction", func() {
+		tx := req.AsTransaction(3)
+		Expect(tx.To()).To(BeNil())
+		Expect(tx.Nonce()).To(Equal(uint64(3)))
+		Expect(tx.Value().Sign()).To(BeZero())
+		Expect(tx.Data()).To(Equal([]byte{0x60, 0x80}))
+	})
+})
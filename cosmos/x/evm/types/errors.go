@@ -26,4 +26,33 @@ import sdkerrors "cosmossdk.io/errors"
 var (
 	ErrNoEvmDenom  = sdkerrors.Register(ModuleName, 1, "evm denom not set")
 	ErrNoExtraEIPs = sdkerrors.Register(ModuleName, 2, "extra eips not set")
+
+	ErrInvalidBaseFeeChangeDenominator = sdkerrors.Register(
+		ModuleName, 3, "base fee change denominator must be positive",
+	)
+	ErrInvalidElasticityMultiplier = sdkerrors.Register(
+		ModuleName, 4, "elasticity multiplier must be positive",
+	)
+	ErrInvalidInitialBaseFee = sdkerrors.Register(
+		ModuleName, 5, "initial base fee must not be less than the minimum base fee",
+	)
+	ErrInvalidFeeDistributionRatio = sdkerrors.Register(
+		ModuleName, 6, "fee distribution ratios must be non-negative and sum to at most one",
+	)
+	ErrInvalidFeeRecipient = sdkerrors.Register(ModuleName, 7, "invalid fee recipient address")
+
+	ErrInvalidContractAddress = sdkerrors.Register(ModuleName, 8, "invalid contract address")
+	ErrInvalidGasLimit        = sdkerrors.Register(ModuleName, 9, "gas limit must be positive")
+	ErrInvalidValue           = sdkerrors.Register(ModuleName, 10, "value must be non-negative")
+	ErrEmptyBytecode          = sdkerrors.Register(ModuleName, 11, "contract bytecode is empty")
+
+	ErrInvalidDispatchAllowlist = sdkerrors.Register(ModuleName, 12, "invalid dispatch allowlist")
+
+	ErrInvalidKVGasParams = sdkerrors.Register(
+		ModuleName, 13, "kv gas params must have positive flat costs",
+	)
+
+	ErrInvalidPrecompileConfig = sdkerrors.Register(ModuleName, 14, "invalid precompile config")
+
+	ErrInvalidBankERC20Denoms = sdkerrors.Register(ModuleName, 15, "invalid bank erc20 denoms")
 )
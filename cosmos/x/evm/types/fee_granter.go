@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// feeGranterKey is the context key of the fee granter that pays for the gas of the
+// EthTransactions of a transaction.
+type feeGranterKey struct{}
+
+// WithFeeGranter returns a copy of the context that records that the gas of the EthTransactions
+// of the transaction is paid by the given fee granter.
+func WithFeeGranter(ctx sdk.Context, granter sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(feeGranterKey{}, granter)
+}
+
+// FeeGranter returns the fee granter that pays for the gas of the EthTransactions of the
+// transaction, or nil if the gas is paid by their senders.
+func FeeGranter(ctx sdk.Context) sdk.AccAddress {
+	granter, _ := ctx.Value(feeGranterKey{}).(sdk.AccAddress)
+	return granter
+}
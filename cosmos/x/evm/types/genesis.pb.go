@@ -37,7 +37,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f2dd36de00e161b, []int{0}
+	return fileDescriptor_eb8ba78954ad97c0, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -99,7 +99,7 @@ func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f2dd36de00e161b, []int{1}
+	return fileDescriptor_eb8ba78954ad97c0, []int{1}
 }
 func (m *Contract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,38 +151,37 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("gridiron/evm/v1alpha1/genesis.proto", fileDescriptor_8f2dd36de00e161b)
+	proto.RegisterFile("gridiron/evm/v1alpha1/genesis.proto", fileDescriptor_eb8ba78954ad97c0)
 }
 
-var fileDescriptor_8f2dd36de00e161b = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6a, 0xdb, 0x40,
-	0x18, 0xc5, 0x25, 0xab, 0x35, 0xf6, 0xd8, 0xa5, 0xae, 0x6a, 0x8a, 0x50, 0x8b, 0xea, 0x7a, 0xe5,
-	0x45, 0x19, 0x61, 0xb7, 0x8b, 0xd6, 0x90, 0x40, 0x1c, 0xf2, 0x67, 0x19, 0x64, 0x93, 0x45, 0x36,
-	0x66, 0x2c, 0x0d, 0x92, 0xb0, 0xa4, 0x11, 0x9a, 0x89, 0x88, 0x6f, 0x91, 0xa3, 0xe4, 0x00, 0x39,
-	0x80, 0x97, 0x5e, 0x66, 0x15, 0x82, 0x7d, 0x91, 0x30, 0x1a, 0xc9, 0x31, 0x46, 0x84, 0x64, 0x37,
-	0x7c, 0xbc, 0xf7, 0x7b, 0xdf, 0x3c, 0x3e, 0xd0, 0x8d, 0x49, 0x80, 0x12, 0x9f, 0x9a, 0x38, 0x0d,
-	0xcd, 0xb4, 0x8f, 0x82, 0xd8, 0x43, 0x7d, 0xd3, 0xc5, 0x11, 0xa6, 0x3e, 0x85, 0x71, 0x42, 0x18,
-	0x51, 0xdb, 0xb9, 0x06, 0xe2, 0x34, 0x84, 0x85, 0x46, 0x6f, 0xbb, 0xc4, 0x25, 0x99, 0xc0, 0xe4,
-	0x2f, 0xa1, 0xd5, 0x7f, 0x95, 0xf2, 0x62, 0x94, 0xa0, 0x30, 0xc7, 0x75, 0xef, 0x14, 0xd0, 0x3c,
-	0x13, 0x01, 0x63, 0x86, 0x18, 0x56, 0x87, 0xa0, 0x2a, 0x04, 0x9a, 0xdc, 0x91, 0x7b, 0x8d, 0xc1,
-	0x0f, 0x58, 0x16, 0x08, 0x2f, 0x32, 0xcd, 0xe8, 0xc3, 0xf2, 0xf1, 0xa7, 0x64, 0xe5, 0x0e, 0xd5,
-	0x07, 0x5f, 0x91, 0xe3, 0x24, 0x98, 0xd2, 0x29, 0x23, 0x53, 0x9b, 0x44, 0x2c, 0x41, 0x36, 0xd3,
-	0x2a, 0x1d, 0xa5, 0xd7, 0x18, 0xfc, 0x2f, 0x07, 0xed, 0x86, 0xc3, 0x23, 0xe1, 0x9e, 0x90, 0xe3,
-	0xdc, 0x7b, 0x12, 0xb1, 0x64, 0x61, 0x7d, 0x41, 0xfb, 0x73, 0x75, 0x02, 0x9a, 0x1e, 0xa2, 0x9e,
-	0xc8, 0x71, 0xb0, 0xa6, 0x64, 0x19, 0x83, 0x37, 0x64, 0x9c, 0x23, 0xea, 0x71, 0x90, 0x83, 0x05,
-	0x1c, 0x78, 0xdb, 0x81, 0xee, 0x80, 0x6f, 0xe5, 0x2b, 0xa8, 0x2d, 0xa0, 0xcc, 0xf1, 0x22, 0xeb,
-	0xa4, 0x6e, 0xf1, 0xa7, 0xfa, 0x17, 0x7c, 0x4c, 0x51, 0x70, 0x8d, 0xb5, 0x4a, 0xd6, 0x93, 0x51,
-	0x1e, 0x5d, 0x50, 0x2c, 0x21, 0x1e, 0x56, 0xfe, 0xc9, 0xfa, 0x01, 0xf8, 0xbc, 0xb7, 0x44, 0x09,
-	0xbe, 0xbd, 0x8b, 0xaf, 0xef, 0xd8, 0xbb, 0xf7, 0x32, 0xa8, 0x6d, 0x7b, 0xf8, 0x0e, 0xea, 0xfc,
-	0xff, 0x53, 0xfe, 0x89, 0xdc, 0x5e, 0xe3, 0x03, 0x1e, 0xa0, 0x8e, 0xc1, 0x27, 0x1a, 0x10, 0xc6,
-	0x4b, 0x2a, 0x58, 0xbc, 0x25, 0xf3, 0xf5, 0x55, 0xe1, 0x38, 0x20, 0x6c, 0x42, 0x2e, 0xb9, 0x43,
-	0x54, 0xd4, 0xa0, 0x2f, 0x13, 0xfd, 0x10, 0xb4, 0xf6, 0x05, 0xef, 0x59, 0x7f, 0x74, 0xba, 0x5c,
-	0x1b, 0xf2, 0x6a, 0x6d, 0xc8, 0x4f, 0x6b, 0x43, 0xbe, 0xdd, 0x18, 0xd2, 0x6a, 0x63, 0x48, 0x0f,
-	0x1b, 0x43, 0xba, 0xfa, 0x1d, 0xcf, 0x5d, 0x38, 0xc3, 0x09, 0xb2, 0x3d, 0xe4, 0x47, 0xd0, 0xc1,
-	0xa9, 0x59, 0x1c, 0xb0, 0x4d, 0x68, 0x48, 0xa8, 0x79, 0x93, 0x5d, 0x32, 0x5b, 0xc4, 0x98, 0xce,
-	0xaa, 0xd9, 0x01, 0xff, 0x79, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x06, 0x61, 0x31, 0x42, 0x35, 0x03,
-	0x00, 0x00,
+var fileDescriptor_eb8ba78954ad97c0 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0xae, 0xd2, 0x40,
+	0x18, 0x85, 0x5b, 0xaa, 0x37, 0x97, 0xe1, 0x1a, 0xb1, 0xa2, 0x69, 0x6a, 0x2c, 0x04, 0x37, 0x6c,
+	0x9c, 0x0a, 0xc4, 0xc4, 0x60, 0x34, 0x11, 0x63, 0x70, 0x69, 0x0a, 0xba, 0x70, 0x43, 0xc6, 0x76,
+	0x6c, 0x2b, 0x6d, 0xa7, 0x99, 0x19, 0x1a, 0xfb, 0x16, 0x3e, 0x8c, 0x5b, 0xf7, 0x2c, 0x59, 0xba,
+	0x32, 0x06, 0x5e, 0xc4, 0x4c, 0xa7, 0x45, 0x42, 0x4a, 0xf4, 0xee, 0x26, 0x7f, 0xce, 0xf9, 0xce,
+	0x3f, 0x27, 0x3f, 0x78, 0xe4, 0xd3, 0xd0, 0x0b, 0x29, 0x49, 0x6c, 0x9c, 0xc5, 0x76, 0x36, 0x44,
+	0x51, 0x1a, 0xa0, 0xa1, 0xed, 0xe3, 0x04, 0xb3, 0x90, 0xc1, 0x94, 0x12, 0x4e, 0xf4, 0x7b, 0x95,
+	0x08, 0xe2, 0x2c, 0x86, 0x95, 0xc8, 0xec, 0xf8, 0xc4, 0x27, 0x85, 0xc2, 0x16, 0x2f, 0x29, 0x36,
+	0xfb, 0xf5, 0xc4, 0x14, 0x51, 0x14, 0x97, 0xc0, 0xfe, 0x77, 0x0d, 0x5c, 0xcd, 0x64, 0xc4, 0x9c,
+	0x23, 0x8e, 0xf5, 0xe7, 0xe0, 0x42, 0x0a, 0x0c, 0xb5, 0xa7, 0x0e, 0x5a, 0xa3, 0x87, 0xb0, 0x36,
+	0x12, 0xbe, 0x2b, 0x44, 0xd3, 0x1b, 0x9b, 0x5f, 0x5d, 0xc5, 0x29, 0x2d, 0xfa, 0x17, 0x70, 0x17,
+	0x79, 0x1e, 0xc5, 0x8c, 0x2d, 0x39, 0x59, 0xba, 0x24, 0xe1, 0x14, 0xb9, 0xdc, 0x68, 0xf4, 0xb4,
+	0x41, 0x6b, 0x34, 0x39, 0x43, 0x3a, 0x8e, 0x87, 0xaf, 0xa4, 0x7d, 0x41, 0x5e, 0x97, 0xe6, 0x37,
+	0x09, 0xa7, 0xb9, 0x73, 0x07, 0x9d, 0xce, 0xf5, 0xf7, 0xe0, 0x2a, 0x40, 0x2c, 0x90, 0x41, 0x1e,
+	0x36, 0xb4, 0x22, 0x64, 0xfc, 0x3f, 0x21, 0x6f, 0x11, 0x0b, 0x04, 0xc9, 0xc3, 0x92, 0x0e, 0x82,
+	0xc3, 0xc0, 0xc4, 0xe0, 0x7e, 0xfd, 0x0e, 0x7a, 0x1b, 0x68, 0x2b, 0x9c, 0x17, 0xb5, 0x34, 0x1d,
+	0xf1, 0xd4, 0x9f, 0x82, 0x9b, 0x19, 0x8a, 0xd6, 0xd8, 0x68, 0x14, 0x55, 0x75, 0xcf, 0x64, 0x57,
+	0x18, 0x47, 0xaa, 0x27, 0x8d, 0x67, 0xaa, 0xf9, 0x02, 0xdc, 0x3e, 0xd9, 0xa2, 0x86, 0xdf, 0x39,
+	0xe6, 0x37, 0x8f, 0xec, 0xfd, 0x1f, 0x2a, 0xb8, 0x3c, 0x34, 0xf1, 0x00, 0x34, 0x45, 0x03, 0x4b,
+	0xf1, 0x8b, 0xd2, 0x7e, 0x29, 0x06, 0x22, 0x40, 0x5f, 0x80, 0x5b, 0x2c, 0x22, 0x5c, 0xd4, 0x54,
+	0xb1, 0x44, 0x4f, 0x4f, 0xfe, 0xb1, 0x2b, 0x9c, 0x47, 0x84, 0x2f, 0xc8, 0x07, 0x61, 0x91, 0x25,
+	0xb5, 0xd8, 0xdf, 0x89, 0xf9, 0x12, 0xb4, 0x4f, 0x05, 0xd7, 0xd9, 0x7f, 0x3a, 0xdb, 0xec, 0x2c,
+	0x75, 0xbb, 0xb3, 0xd4, 0xdf, 0x3b, 0x4b, 0xfd, 0xb6, 0xb7, 0x94, 0xed, 0xde, 0x52, 0x7e, 0xee,
+	0x2d, 0xe5, 0xe3, 0xe3, 0x74, 0xe5, 0xc3, 0xcf, 0x6b, 0x9a, 0xbb, 0x01, 0x0a, 0x13, 0xe8, 0xe1,
+	0xcc, 0x3e, 0x9c, 0xb1, 0x4b, 0x58, 0x4c, 0x98, 0xfd, 0xb5, 0xb8, 0x67, 0x9e, 0xa7, 0x98, 0x7d,
+	0xba, 0x28, 0xce, 0x78, 0xfc, 0x67, 0x00, 0x5f, 0x25, 0xc4, 0xe0, 0x3e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
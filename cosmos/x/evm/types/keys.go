@@ -30,4 +30,5 @@ const (
 	VersionKey
 	HeaderKey
 	ParamsKey
+	BaseFeeKey
 )
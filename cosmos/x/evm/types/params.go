@@ -22,7 +22,16 @@ package types
 
 import (
 	"encoding/json"
+	"math/big"
+	"strings"
 
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.furychain.dev/gridiron/eth/common"
 	"pkg.furychain.dev/gridiron/eth/params"
 	enclib "pkg.furychain.dev/gridiron/lib/encoding"
 )
@@ -35,14 +44,81 @@ const (
 var (
 	// DefaultExtraEIPs is the default extra EIPs.
 	DefaultExtraEIPs = []int64{}
+	// DefaultDispatchAllowlist is the default dispatch allowlist, which allows no messages.
+	DefaultDispatchAllowlist = []string{}
+	// DefaultPrecompiles is the default precompile configs, which keep every precompile active at
+	// its default address.
+	DefaultPrecompiles = []PrecompileConfig{}
+	// DefaultBankERC20Denoms is the default bank ERC20 token denominations, which activate no
+	// token precompiles.
+	DefaultBankERC20Denoms = []string{}
 )
 
+// evmMsgTypeURLPrefix is the type URL prefix of the x/evm messages, which can never be dispatched
+// from within the EVM.
+const evmMsgTypeURLPrefix = "/gridiron.evm."
+
+// wrapperMsgTypeURLs are the type URLs of the messages which execute other messages when they are
+// handled. They can never be dispatched from within the EVM, as the messages they wrap (e.g. an
+// x/evm message) would bypass the dispatch allowlist.
+var wrapperMsgTypeURLs = map[string]struct{}{
+	"/cosmos.authz.v1beta1.MsgExec":       {},
+	"/cosmos.group.v1.MsgExec":            {},
+	"/cosmos.group.v1.MsgSubmitProposal":  {},
+	"/cosmos.gov.v1.MsgExecLegacyContent": {},
+}
+
 // DefaultParams contains the default values for all parameters.
 func DefaultParams() *Params {
 	return &Params{
-		EvmDenom:    DefaultEvmDenom,
-		ExtraEIPs:   DefaultExtraEIPs,
-		ChainConfig: string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+		EvmDenom:        DefaultEvmDenom,
+		ExtraEIPs:       DefaultExtraEIPs,
+		ChainConfig:     string(enclib.MustMarshalJSON(params.DefaultChainConfig)),
+		FeeMarket:       DefaultFeeMarketParams(),
+		FeeDistribution: DefaultFeeDistributionParams(),
+
+		DispatchAllowlist: DefaultDispatchAllowlist,
+
+		PrecompileKVGas:          NewKVGasParams(storetypes.KVGasConfig()),
+		PrecompileTransientKVGas: NewKVGasParams(storetypes.TransientGasConfig()),
+		Precompiles:              DefaultPrecompiles,
+		BankERC20Denoms:          DefaultBankERC20Denoms,
+	}
+}
+
+// NewKVGasParams returns the KV gas parameters matching the given store gas config.
+func NewKVGasParams(gc storetypes.GasConfig) KVGasParams {
+	return KVGasParams{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+// DefaultFeeMarketParams contains the default values for the fee market parameters, which match
+// the Ethereum mainnet EIP-1559 defaults.
+func DefaultFeeMarketParams() FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                  true,
+		BaseFeeChangeDenominator: uint64(params.DefaultBaseFeeChangeDenominator),
+		ElasticityMultiplier:     uint64(params.DefaultElasticityMultiplier),
+		MinBaseFee:               0,
+		InitialBaseFee:           uint64(params.InitialBaseFee),
+	}
+}
+
+// DefaultFeeDistributionParams contains the default values for the fee distribution parameters.
+// By default the base fee is burned in full and tips are paid to the fee collector.
+func DefaultFeeDistributionParams() FeeDistributionParams {
+	return FeeDistributionParams{
+		CommunityPoolRatio: sdkmath.LegacyZeroDec(),
+		RecipientRatio:     sdkmath.LegacyZeroDec(),
+		Recipient:          "",
+		TipsToProposer:     false,
 	}
 }
 
@@ -62,6 +138,212 @@ func (p *Params) ValidateBasic() error {
 	if p.ExtraEIPs == nil {
 		return ErrNoExtraEIPs
 	}
-	_, err := json.Marshal(p.ChainConfig)
-	return err
+	if _, err := json.Marshal(p.ChainConfig); err != nil {
+		return err
+	}
+	if err := p.FeeMarket.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := p.FeeDistribution.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := p.PrecompileKVGas.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := p.PrecompileTransientKVGas.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := validatePrecompiles(p.Precompiles); err != nil {
+		return err
+	}
+	if err := validateBankERC20Denoms(p.BankERC20Denoms); err != nil {
+		return err
+	}
+	return validateDispatchAllowlist(p.DispatchAllowlist)
+}
+
+// IsDispatchAllowed returns true if the message with the given type URL may be executed through
+// the dispatch precompile.
+func (p *Params) IsDispatchAllowed(typeURL string) bool {
+	for _, allowed := range p.DispatchAllowlist {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDispatchAllowlist ensures that every entry of the dispatch allowlist is a unique type
+// URL which is neither an x/evm message nor a message wrapping other messages.
+func validateDispatchAllowlist(allowlist []string) error {
+	seen := make(map[string]struct{}, len(allowlist))
+	for _, typeURL := range allowlist {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return sdkerrors.Wrapf(ErrInvalidDispatchAllowlist, "malformed type url %s", typeURL)
+		}
+		if _, ok := wrapperMsgTypeURLs[typeURL]; ok || strings.HasPrefix(typeURL, evmMsgTypeURLPrefix) {
+			return sdkerrors.Wrapf(ErrInvalidDispatchAllowlist, "cannot dispatch %s", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return sdkerrors.Wrapf(ErrInvalidDispatchAllowlist, "duplicate type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+	return nil
+}
+
+// validatePrecompiles ensures that every precompile config is valid and that no two configs
+// share a default or deployed address.
+func validatePrecompiles(configs []PrecompileConfig) error {
+	addresses := make(map[common.Address]struct{}, len(configs))
+	deployed := make(map[common.Address]struct{}, len(configs))
+	for i := range configs {
+		pc := &configs[i]
+		if err := pc.ValidateBasic(); err != nil {
+			return err
+		}
+		addr, deployedAddr := common.HexToAddress(pc.Address), pc.DeployedAt()
+		if _, ok := addresses[addr]; ok {
+			return sdkerrors.Wrapf(ErrInvalidPrecompileConfig, "duplicate address %s", pc.Address)
+		}
+		if _, ok := deployed[deployedAddr]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidPrecompileConfig, "duplicate deployed address %s", deployedAddr.Hex(),
+			)
+		}
+		addresses[addr] = struct{}{}
+		deployed[deployedAddr] = struct{}{}
+	}
+	return nil
+}
+
+// validateBankERC20Denoms ensures that every bank ERC20 token denomination is a unique, valid SDK
+// coin denomination.
+func validateBankERC20Denoms(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidBankERC20Denoms, err.Error())
+		}
+		if _, ok := seen[denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidBankERC20Denoms, "duplicate denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic is used to validate the fee market parameters.
+func (fmp *FeeMarketParams) ValidateBasic() error {
+	if !fmp.Enabled {
+		return nil
+	}
+	if fmp.BaseFeeChangeDenominator == 0 {
+		return ErrInvalidBaseFeeChangeDenominator
+	}
+	if fmp.ElasticityMultiplier == 0 {
+		return ErrInvalidElasticityMultiplier
+	}
+	if fmp.InitialBaseFee < fmp.MinBaseFee {
+		return ErrInvalidInitialBaseFee
+	}
+	return nil
+}
+
+// MinBaseFeeBig returns the minimum base fee as a big.Int.
+func (fmp *FeeMarketParams) MinBaseFeeBig() *big.Int {
+	return new(big.Int).SetUint64(fmp.MinBaseFee)
+}
+
+// InitialBaseFeeBig returns the initial base fee as a big.Int.
+func (fmp *FeeMarketParams) InitialBaseFeeBig() *big.Int {
+	return new(big.Int).SetUint64(fmp.InitialBaseFee)
+}
+
+// ValidateBasic is used to validate the fee distribution parameters.
+func (fdp *FeeDistributionParams) ValidateBasic() error {
+	cpr, rr := fdp.CommunityPoolShare(), fdp.RecipientShare()
+	if cpr.IsNegative() || rr.IsNegative() || cpr.Add(rr).GT(sdkmath.LegacyOneDec()) {
+		return ErrInvalidFeeDistributionRatio
+	}
+	if rr.IsPositive() {
+		if _, err := sdk.AccAddressFromBech32(fdp.Recipient); err != nil {
+			return ErrInvalidFeeRecipient
+		}
+	}
+	return nil
+}
+
+// CommunityPoolShare returns the community pool share of the base fee, defaulting to zero.
+func (fdp *FeeDistributionParams) CommunityPoolShare() sdkmath.LegacyDec {
+	if fdp.CommunityPoolRatio.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return fdp.CommunityPoolRatio
+}
+
+// RecipientShare returns the recipient share of the base fee, defaulting to zero.
+func (fdp *FeeDistributionParams) RecipientShare() sdkmath.LegacyDec {
+	if fdp.RecipientRatio.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return fdp.RecipientRatio
+}
+
+// GasConfig returns the KV gas parameters as a store gas config.
+func (kgp *KVGasParams) GasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          kgp.HasCost,
+		DeleteCost:       kgp.DeleteCost,
+		ReadCostFlat:     kgp.ReadCostFlat,
+		ReadCostPerByte:  kgp.ReadCostPerByte,
+		WriteCostFlat:    kgp.WriteCostFlat,
+		WriteCostPerByte: kgp.WriteCostPerByte,
+		IterNextCostFlat: kgp.IterNextCostFlat,
+	}
+}
+
+// ValidateBasic is used to validate the KV gas parameters. Every flat cost must be positive, as a
+// free store operation would let precompile calls touch the stores without paying for it.
+func (kgp *KVGasParams) ValidateBasic() error {
+	if kgp.HasCost == 0 || kgp.DeleteCost == 0 || kgp.ReadCostFlat == 0 ||
+		kgp.WriteCostFlat == 0 || kgp.IterNextCostFlat == 0 {
+		return ErrInvalidKVGasParams
+	}
+	return nil
+}
+
+// ValidateBasic is used to validate a precompile config.
+func (pc *PrecompileConfig) ValidateBasic() error {
+	if !common.IsHexAddress(pc.Address) {
+		return sdkerrors.Wrapf(ErrInvalidPrecompileConfig, "invalid address %s", pc.Address)
+	}
+	if pc.DeployedAddress != "" && !common.IsHexAddress(pc.DeployedAddress) {
+		return sdkerrors.Wrapf(
+			ErrInvalidPrecompileConfig, "invalid deployed address %s", pc.DeployedAddress,
+		)
+	}
+	if pc.ActivationHeight < 0 || pc.DeactivationHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidPrecompileConfig, "negative height for %s", pc.Address)
+	}
+	if pc.DeactivationHeight != 0 && pc.DeactivationHeight <= pc.ActivationHeight {
+		return sdkerrors.Wrapf(
+			ErrInvalidPrecompileConfig, "deactivation before activation for %s", pc.Address,
+		)
+	}
+	return nil
+}
+
+// DeployedAt returns the address the precompile is called at.
+func (pc *PrecompileConfig) DeployedAt() common.Address {
+	if pc.DeployedAddress == "" {
+		return common.HexToAddress(pc.Address)
+	}
+	return common.HexToAddress(pc.DeployedAddress)
+}
+
+// IsActive returns true if the precompile is active at the given block height.
+func (pc *PrecompileConfig) IsActive(height int64) bool {
+	return height >= pc.ActivationHeight &&
+		(pc.DeactivationHeight == 0 || height < pc.DeactivationHeight)
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,13 +35,37 @@ type Params struct {
 	// `chain_config` represents the ethereum chain config for the gridiron
 	// EVM
 	ChainConfig string `protobuf:"bytes,3,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty" yaml:"chain_config"`
+	// `fee_market` defines the EIP-1559 base fee parameters of the gridiron EVM.
+	FeeMarket FeeMarketParams `protobuf:"bytes,4,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market" yaml:"fee_market"`
+	// `fee_distribution` defines how the fees paid by EVM transactions are
+	// distributed.
+	FeeDistribution FeeDistributionParams `protobuf:"bytes,5,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution" yaml:"fee_distribution"`
+	// `dispatch_allowlist` is the list of Cosmos message type URLs (e.g.
+	// `/cosmos.bank.v1beta1.MsgSend`) that may be executed through the dispatch
+	// precompile.
+	DispatchAllowlist []string `protobuf:"bytes,6,rep,name=dispatch_allowlist,json=dispatchAllowlist,proto3" json:"dispatch_allowlist,omitempty" yaml:"dispatch_allowlist"`
+	// `precompile_kv_gas` is the gas schedule charged for the KV store accesses
+	// made during precompile execution.
+	PrecompileKVGas KVGasParams `protobuf:"bytes,7,opt,name=precompile_kv_gas,json=precompileKvGas,proto3" json:"precompile_kv_gas" yaml:"precompile_kv_gas"`
+	// `precompile_transient_kv_gas` is the gas schedule charged for the
+	// transient KV store accesses made during precompile execution.
+	PrecompileTransientKVGas KVGasParams `protobuf:"bytes,8,opt,name=precompile_transient_kv_gas,json=precompileTransientKvGas,proto3" json:"precompile_transient_kv_gas" yaml:"precompile_transient_kv_gas"`
+	// `precompiles` overrides the activation and address of the stateful
+	// precompiles built into the chain. Precompiles without an entry are active
+	// at their default address.
+	Precompiles []PrecompileConfig `protobuf:"bytes,9,rep,name=precompiles,proto3" json:"precompiles" yaml:"precompiles"`
+	// `bank_erc20_denoms` is the list of SDK coin denominations that a bank ERC20
+	// token precompile is active for. Like the stateful precompiles built into
+	// the chain, a token precompile can be configured in `precompiles` by its
+	// default address.
+	BankERC20Denoms []string `protobuf:"bytes,10,rep,name=bank_erc20_denoms,json=bankErc20Denoms,proto3" json:"bank_erc20_denoms,omitempty" yaml:"bank_erc20_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f6c2eac5100e18c, []int{0}
+	return fileDescriptor_b934f18b2977ba45, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,32 +115,474 @@ func (m *Params) GetChainConfig() string {
 	return ""
 }
 
+func (m *Params) GetFeeMarket() FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return FeeMarketParams{}
+}
+
+func (m *Params) GetFeeDistribution() FeeDistributionParams {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistributionParams{}
+}
+
+func (m *Params) GetDispatchAllowlist() []string {
+	if m != nil {
+		return m.DispatchAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetPrecompileKVGas() KVGasParams {
+	if m != nil {
+		return m.PrecompileKVGas
+	}
+	return KVGasParams{}
+}
+
+func (m *Params) GetPrecompileTransientKVGas() KVGasParams {
+	if m != nil {
+		return m.PrecompileTransientKVGas
+	}
+	return KVGasParams{}
+}
+
+func (m *Params) GetPrecompiles() []PrecompileConfig {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
+func (m *Params) GetBankERC20Denoms() []string {
+	if m != nil {
+		return m.BankERC20Denoms
+	}
+	return nil
+}
+
+// `PrecompileConfig` defines the governable activation of a stateful
+// precompile built into the chain.
+type PrecompileConfig struct {
+	// `address` is the default hex address of the precompile, which identifies
+	// it.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// `deployed_address` is the hex address the precompile is called at. If
+	// empty, the precompile is called at its default `address`.
+	DeployedAddress string `protobuf:"bytes,2,opt,name=deployed_address,json=deployedAddress,proto3" json:"deployed_address,omitempty" yaml:"deployed_address"`
+	// `activation_height` is the first block height at which the precompile is
+	// active. If zero, the precompile is active from genesis.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+	// `deactivation_height` is the first block height at which the precompile
+	// is no longer active. If zero, the precompile is never deactivated.
+	DeactivationHeight int64 `protobuf:"varint,4,opt,name=deactivation_height,json=deactivationHeight,proto3" json:"deactivation_height,omitempty" yaml:"deactivation_height"`
+}
+
+func (m *PrecompileConfig) Reset()         { *m = PrecompileConfig{} }
+func (m *PrecompileConfig) String() string { return proto.CompactTextString(m) }
+func (*PrecompileConfig) ProtoMessage()    {}
+func (*PrecompileConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b934f18b2977ba45, []int{1}
+}
+func (m *PrecompileConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileConfig.Merge(m, src)
+}
+func (m *PrecompileConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileConfig proto.InternalMessageInfo
+
+func (m *PrecompileConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileConfig) GetDeployedAddress() string {
+	if m != nil {
+		return m.DeployedAddress
+	}
+	return ""
+}
+
+func (m *PrecompileConfig) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PrecompileConfig) GetDeactivationHeight() int64 {
+	if m != nil {
+		return m.DeactivationHeight
+	}
+	return 0
+}
+
+// `FeeMarketParams` defines the governable parameters of the EIP-1559 base fee
+// calculation.
+type FeeMarketParams struct {
+	// `enabled` toggles the host chain base fee calculation. If disabled, the
+	// gridiron EVM falls back to the Ethereum defaults.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// `base_fee_change_denominator` bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// `elasticity_multiplier` bounds the maximum gas limit an EIP-1559 block may
+	// have relative to its gas target.
+	ElasticityMultiplier uint64 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty" yaml:"elasticity_multiplier"`
+	// `min_base_fee` is the lower bound of the base fee, in the EVM denom.
+	MinBaseFee uint64 `protobuf:"varint,4,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty" yaml:"min_base_fee"`
+	// `initial_base_fee` is the base fee used when no parent block exists.
+	InitialBaseFee uint64 `protobuf:"varint,5,opt,name=initial_base_fee,json=initialBaseFee,proto3" json:"initial_base_fee,omitempty" yaml:"initial_base_fee"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b934f18b2977ba45, []int{2}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetElasticityMultiplier() uint64 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetMinBaseFee() uint64 {
+	if m != nil {
+		return m.MinBaseFee
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetInitialBaseFee() uint64 {
+	if m != nil {
+		return m.InitialBaseFee
+	}
+	return 0
+}
+
+// `FeeDistributionParams` defines the governable parameters of the EVM fee
+// distribution. The base fee portion of EVM transaction fees is burned by
+// default, the shares set here are redirected away from the burn.
+type FeeDistributionParams struct {
+	// `community_pool_ratio` is the share of the base fee sent to the community
+	// pool.
+	CommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_ratio" yaml:"community_pool_ratio"`
+	// `recipient_ratio` is the share of the base fee sent to the `recipient`.
+	RecipientRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=recipient_ratio,json=recipientRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recipient_ratio" yaml:"recipient_ratio"`
+	// `recipient` is the bech32 address receiving the `recipient_ratio` share of
+	// the base fee.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// `tips_to_proposer` sends the priority tips directly to the account of the
+	// block proposer instead of the fee collector.
+	TipsToProposer bool `protobuf:"varint,4,opt,name=tips_to_proposer,json=tipsToProposer,proto3" json:"tips_to_proposer,omitempty" yaml:"tips_to_proposer"`
+}
+
+func (m *FeeDistributionParams) Reset()         { *m = FeeDistributionParams{} }
+func (m *FeeDistributionParams) String() string { return proto.CompactTextString(m) }
+func (*FeeDistributionParams) ProtoMessage()    {}
+func (*FeeDistributionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b934f18b2977ba45, []int{3}
+}
+func (m *FeeDistributionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistributionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistributionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistributionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistributionParams.Merge(m, src)
+}
+func (m *FeeDistributionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistributionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistributionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistributionParams proto.InternalMessageInfo
+
+func (m *FeeDistributionParams) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FeeDistributionParams) GetTipsToProposer() bool {
+	if m != nil {
+		return m.TipsToProposer
+	}
+	return false
+}
+
+// `KVGasParams` defines the gas costs of KV store operations. It mirrors the
+// Cosmos SDK store `GasConfig`.
+type KVGasParams struct {
+	// `has_cost` is the flat cost of checking whether a key exists.
+	HasCost uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty" yaml:"has_cost"`
+	// `delete_cost` is the flat cost of deleting a key.
+	DeleteCost uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty" yaml:"delete_cost"`
+	// `read_cost_flat` is the flat cost of reading a key.
+	ReadCostFlat uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty" yaml:"read_cost_flat"`
+	// `read_cost_per_byte` is the cost per byte of the value read.
+	ReadCostPerByte uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty" yaml:"read_cost_per_byte"`
+	// `write_cost_flat` is the flat cost of writing a key.
+	WriteCostFlat uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty" yaml:"write_cost_flat"`
+	// `write_cost_per_byte` is the cost per byte of the key and value written.
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty" yaml:"write_cost_per_byte"`
+	// `iter_next_cost_flat` is the flat cost of advancing an iterator.
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty" yaml:"iter_next_cost_flat"`
+}
+
+func (m *KVGasParams) Reset()         { *m = KVGasParams{} }
+func (m *KVGasParams) String() string { return proto.CompactTextString(m) }
+func (*KVGasParams) ProtoMessage()    {}
+func (*KVGasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b934f18b2977ba45, []int{4}
+}
+func (m *KVGasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVGasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVGasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KVGasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVGasParams.Merge(m, src)
+}
+func (m *KVGasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVGasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVGasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVGasParams proto.InternalMessageInfo
+
+func (m *KVGasParams) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *KVGasParams) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gridiron.evm.v1alpha1.Params")
+	proto.RegisterType((*PrecompileConfig)(nil), "gridiron.evm.v1alpha1.PrecompileConfig")
+	proto.RegisterType((*FeeMarketParams)(nil), "gridiron.evm.v1alpha1.FeeMarketParams")
+	proto.RegisterType((*FeeDistributionParams)(nil), "gridiron.evm.v1alpha1.FeeDistributionParams")
+	proto.RegisterType((*KVGasParams)(nil), "gridiron.evm.v1alpha1.KVGasParams")
+}
+
+func init() {
+	proto.RegisterFile("gridiron/evm/v1alpha1/params.proto", fileDescriptor_b934f18b2977ba45)
 }
 
-func init() { proto.RegisterFile("gridiron/evm/v1alpha1/params.proto", fileDescriptor_9f6c2eac5100e18c) }
-
-var fileDescriptor_9f6c2eac5100e18c = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0xc8, 0xcf, 0x49,
-	0x2c, 0xca, 0x2c, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34,
-	0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2a, 0xd1, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x29, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0x95, 0x76, 0x31, 0x72, 0xb1, 0x05, 0x80, 0x35, 0x0b, 0x19, 0x72,
-	0x71, 0xa6, 0x96, 0xe5, 0xc6, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x3a, 0x89, 0x7c, 0xba, 0x27, 0x2f, 0x50, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x04, 0x97, 0x52, 0x0a,
-	0xe2, 0x48, 0x2d, 0xcb, 0x75, 0x01, 0x31, 0x85, 0x1c, 0xb9, 0xb8, 0x52, 0x2b, 0x4a, 0x8a, 0x12,
-	0xe3, 0x53, 0x33, 0x0b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0x98, 0x9d, 0x94, 0x1e, 0xdd, 0x93,
-	0xe7, 0x74, 0x05, 0x89, 0xba, 0x7a, 0x06, 0x14, 0x7f, 0xba, 0x27, 0x2f, 0x08, 0x35, 0x00, 0xae,
-	0x50, 0x29, 0x88, 0x13, 0xcc, 0x71, 0xcd, 0x2c, 0x28, 0x16, 0xb2, 0xe2, 0xe2, 0x49, 0xce, 0x48,
-	0xcc, 0xcc, 0x8b, 0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x97, 0x60, 0x06, 0x5b, 0x2c, 0xfe, 0xe9,
-	0x9e, 0xbc, 0x30, 0x44, 0x1f, 0xb2, 0xac, 0x52, 0x10, 0x37, 0x98, 0xeb, 0x0c, 0xe6, 0x39, 0xb9,
-	0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x41, 0x76, 0xba, 0x5e,
-	0x52, 0x6a, 0x51, 0x22, 0x58, 0xb9, 0x5e, 0x4a, 0x6a, 0x99, 0x3e, 0x2c, 0xdc, 0x92, 0xf3, 0x8b,
-	0x73, 0xf3, 0x8b, 0xf5, 0x2b, 0xc0, 0x01, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e,
-	0x0b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0xd7, 0xc0, 0xb1, 0x5c, 0x01, 0x00, 0x00,
+var fileDescriptor_b934f18b2977ba45 = []byte{
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x72, 0xdb, 0x36,
+	0x10, 0xb6, 0x2c, 0xc7, 0xb6, 0xe0, 0xd4, 0x92, 0x61, 0x3b, 0xa1, 0xed, 0x54, 0xf4, 0xe0, 0x90,
+	0xfa, 0x90, 0x48, 0x89, 0x73, 0xe8, 0x34, 0x97, 0x4e, 0xe8, 0x9f, 0x34, 0x3f, 0x6e, 0x35, 0x68,
+	0xda, 0x43, 0x7b, 0x60, 0x61, 0x72, 0x2d, 0x61, 0x4c, 0x12, 0x1c, 0x02, 0x56, 0xac, 0x6b, 0xfb,
+	0x02, 0x7d, 0x88, 0xf6, 0xd0, 0x7b, 0x1f, 0x22, 0xc7, 0x4c, 0x4f, 0x9d, 0x1c, 0x38, 0x1d, 0x67,
+	0xfa, 0x02, 0x7a, 0x81, 0x76, 0x08, 0x92, 0x22, 0x4b, 0xc9, 0x9d, 0xdc, 0xc8, 0x6f, 0xbf, 0xfd,
+	0xf6, 0xe3, 0x62, 0x01, 0x10, 0x91, 0x7e, 0xc4, 0x5d, 0x1e, 0x89, 0xa0, 0x0b, 0x43, 0xbf, 0x3b,
+	0x7c, 0xc8, 0xbc, 0x70, 0xc0, 0x1e, 0x76, 0x43, 0x16, 0x31, 0x5f, 0x76, 0xc2, 0x48, 0x28, 0x81,
+	0x37, 0x73, 0x4e, 0x07, 0x86, 0x7e, 0x27, 0xe7, 0x6c, 0x6f, 0x39, 0x42, 0xfa, 0x42, 0xda, 0x9a,
+	0xd4, 0x4d, 0x5f, 0xd2, 0x8c, 0xed, 0x8d, 0xbe, 0xe8, 0x8b, 0x14, 0x4f, 0x9e, 0x52, 0x94, 0xbc,
+	0x5b, 0x42, 0x8b, 0x3d, 0x2d, 0x8c, 0x1f, 0xa2, 0x06, 0x0c, 0x7d, 0xdb, 0x85, 0x40, 0xf8, 0x46,
+	0x6d, 0xb7, 0xb6, 0xd7, 0xb0, 0x36, 0xc6, 0xb1, 0xd9, 0x1a, 0x31, 0xdf, 0x7b, 0x4c, 0x26, 0x21,
+	0x42, 0x97, 0x61, 0xe8, 0x1f, 0x26, 0x8f, 0xf8, 0x09, 0x42, 0x70, 0xa9, 0x22, 0x66, 0x03, 0x0f,
+	0xa5, 0x31, 0xbf, 0x5b, 0xdf, 0xab, 0x5b, 0xe4, 0x2a, 0x36, 0x1b, 0x47, 0x09, 0x7a, 0xf4, 0xac,
+	0x27, 0xc7, 0xb1, 0xb9, 0x96, 0x09, 0x4c, 0x88, 0x84, 0x36, 0xf4, 0xcb, 0x11, 0x0f, 0x25, 0x7e,
+	0x8c, 0x6e, 0x3a, 0x03, 0xc6, 0x03, 0xdb, 0x11, 0xc1, 0x19, 0xef, 0x1b, 0x75, 0x5d, 0xf8, 0xf6,
+	0x38, 0x36, 0xd7, 0xd3, 0xbc, 0x72, 0x94, 0xd0, 0x15, 0xfd, 0x7a, 0xa0, 0xdf, 0xf0, 0x0f, 0x08,
+	0x9d, 0x01, 0xd8, 0x3e, 0x8b, 0xce, 0x41, 0x19, 0x0b, 0xbb, 0xb5, 0xbd, 0x95, 0xfd, 0xbb, 0x9d,
+	0x99, 0x9d, 0xe9, 0x1c, 0x03, 0x9c, 0x68, 0x5e, 0xfa, 0xb5, 0xd6, 0xd6, 0x9b, 0xd8, 0x9c, 0x2b,
+	0xdc, 0x15, 0x3a, 0x84, 0x36, 0xce, 0x72, 0x2e, 0xbe, 0x44, 0xad, 0x24, 0xe2, 0x72, 0xa9, 0x22,
+	0x7e, 0x7a, 0xa1, 0xb8, 0x08, 0x8c, 0x1b, 0xba, 0xce, 0xbd, 0xeb, 0xeb, 0x1c, 0x96, 0xd8, 0x59,
+	0x35, 0x33, 0xab, 0x76, 0xbb, 0xa8, 0x56, 0xd6, 0x24, 0xb4, 0x79, 0xf6, 0xdf, 0x3c, 0xfc, 0x12,
+	0x61, 0x97, 0xcb, 0x90, 0x29, 0x67, 0x60, 0x33, 0xcf, 0x13, 0xaf, 0x3d, 0x2e, 0x95, 0xb1, 0xb8,
+	0x5b, 0xdf, 0x6b, 0x58, 0x1f, 0x8f, 0x63, 0x73, 0x2b, 0x55, 0x9a, 0xe6, 0x10, 0xba, 0x96, 0x83,
+	0x4f, 0x72, 0x0c, 0xff, 0x54, 0x43, 0x6b, 0x61, 0x04, 0x8e, 0xf0, 0x43, 0xee, 0x81, 0x7d, 0x3e,
+	0xb4, 0xfb, 0x4c, 0x1a, 0x4b, 0xfa, 0x4b, 0xc8, 0x35, 0x5f, 0xf2, 0xe2, 0xdb, 0xa7, 0x4c, 0x66,
+	0xfe, 0x1f, 0x25, 0xfe, 0xaf, 0x62, 0xb3, 0xd9, 0x9b, 0x88, 0xe8, 0xf0, 0x38, 0x36, 0x8d, 0xd4,
+	0xc8, 0x94, 0x3a, 0xa1, 0xcd, 0x02, 0x7b, 0x31, 0x7c, 0xca, 0x24, 0xfe, 0xad, 0x86, 0x76, 0x4a,
+	0x3c, 0x15, 0xb1, 0x40, 0x72, 0x08, 0x54, 0xee, 0x67, 0xf9, 0x83, 0xfd, 0x1c, 0x67, 0x7e, 0x8c,
+	0xc2, 0xcf, 0xab, 0x5c, 0x2d, 0x37, 0x46, 0xa6, 0x8c, 0x55, 0x0b, 0x12, 0x6a, 0x84, 0x33, 0xf2,
+	0xb5, 0x57, 0x40, 0x2b, 0x45, 0x4c, 0x1a, 0x8d, 0xdd, 0xfa, 0xde, 0xca, 0xfe, 0x27, 0xd7, 0x58,
+	0x2b, 0x5c, 0xa4, 0x93, 0x69, 0x6d, 0x67, 0xeb, 0x8d, 0xab, 0x1e, 0x24, 0xa1, 0x65, 0x5d, 0xfc,
+	0x3d, 0x5a, 0x3b, 0x65, 0xc1, 0xb9, 0x0d, 0x91, 0xb3, 0xff, 0x20, 0xdd, 0x60, 0xd2, 0x40, 0x7a,
+	0x95, 0xbb, 0x49, 0xbf, 0x2d, 0x16, 0x9c, 0x1f, 0xd1, 0x83, 0xfd, 0x07, 0x7a, 0xc3, 0x95, 0xfa,
+	0x3d, 0x95, 0x45, 0x68, 0x33, 0xc1, 0x8e, 0x22, 0x27, 0x27, 0x93, 0x5f, 0xe6, 0x51, 0xab, 0x6a,
+	0x0d, 0xdf, 0x43, 0x4b, 0xcc, 0x75, 0x23, 0x90, 0x32, 0xdb, 0xe4, 0x78, 0x1c, 0x9b, 0xab, 0xa9,
+	0x68, 0x16, 0x20, 0x34, 0xa7, 0xe0, 0x63, 0xd4, 0x72, 0x21, 0xf4, 0xc4, 0x08, 0x5c, 0x3b, 0x4f,
+	0x9b, 0xd7, 0x69, 0x3b, 0xc5, 0x38, 0x57, 0x19, 0x84, 0x36, 0x73, 0xe8, 0x49, 0xa6, 0xf3, 0x0c,
+	0xad, 0x31, 0x47, 0xf1, 0x21, 0x4b, 0x86, 0xdb, 0x1e, 0x00, 0xef, 0x0f, 0x94, 0xde, 0xeb, 0x75,
+	0xeb, 0x4e, 0xf1, 0x51, 0x53, 0x14, 0x42, 0x5b, 0x05, 0xf6, 0x85, 0x86, 0xf0, 0x57, 0x68, 0xdd,
+	0x85, 0x69, 0xb1, 0x05, 0x2d, 0xd6, 0x1e, 0xc7, 0xe6, 0x76, 0xee, 0x6a, 0x86, 0x1c, 0x76, 0xa1,
+	0x2a, 0x48, 0xfe, 0x99, 0x47, 0xcd, 0xca, 0xf1, 0x90, 0x74, 0x09, 0x02, 0x76, 0xea, 0x81, 0xab,
+	0xbb, 0xb4, 0x5c, 0xee, 0x52, 0x16, 0x20, 0x34, 0xa7, 0x60, 0x40, 0x3b, 0xa7, 0x4c, 0x82, 0x9d,
+	0xec, 0x6b, 0x67, 0xc0, 0x82, 0x3e, 0xa4, 0x8b, 0xc2, 0x03, 0xa6, 0x44, 0xa4, 0x1b, 0xb6, 0x60,
+	0xdd, 0x2d, 0x66, 0xf2, 0x7f, 0xc8, 0x84, 0x1a, 0x49, 0xf4, 0x18, 0xe0, 0x40, 0xc7, 0x0e, 0x8b,
+	0x10, 0xfe, 0x06, 0x6d, 0x82, 0xc7, 0xa4, 0xe2, 0x0e, 0x57, 0x23, 0xdb, 0xbf, 0xf0, 0x14, 0x0f,
+	0x3d, 0x0e, 0x91, 0x6e, 0xe4, 0x82, 0xb5, 0x3b, 0x8e, 0xcd, 0x3b, 0x99, 0xc5, 0x59, 0x34, 0x42,
+	0x37, 0x0a, 0xfc, 0x64, 0x02, 0xe3, 0xcf, 0xd0, 0x4d, 0x9f, 0x07, 0x76, 0x6e, 0x4a, 0x77, 0x72,
+	0xa1, 0x7c, 0x04, 0x97, 0xa3, 0x84, 0x22, 0x9f, 0x07, 0x56, 0x6a, 0x11, 0x1f, 0xa1, 0x16, 0x0f,
+	0xb8, 0xe2, 0xcc, 0x2b, 0xd2, 0x6f, 0xe8, 0xf4, 0xd2, 0x78, 0x54, 0x19, 0x84, 0xae, 0x66, 0x50,
+	0x26, 0x43, 0x7e, 0xad, 0xa3, 0xcd, 0x99, 0x07, 0x27, 0xfe, 0xb1, 0x86, 0x36, 0x1c, 0xe1, 0xfb,
+	0x17, 0x41, 0xf2, 0x2d, 0xa1, 0x10, 0x9e, 0x1d, 0x25, 0x6b, 0x97, 0xcd, 0x6e, 0x2f, 0xd9, 0x67,
+	0xef, 0x62, 0x73, 0x27, 0xbd, 0xea, 0xa4, 0x7b, 0xde, 0xe1, 0xa2, 0xeb, 0x33, 0x35, 0xe8, 0xbc,
+	0x84, 0x3e, 0x73, 0x46, 0x87, 0xe0, 0x8c, 0x63, 0x73, 0x27, 0xbb, 0x4a, 0x66, 0x08, 0x91, 0x3f,
+	0x7e, 0xbf, 0x8f, 0xb2, 0x8b, 0xf2, 0x10, 0x1c, 0x8a, 0x27, 0xa4, 0x9e, 0x10, 0x1e, 0x4d, 0x28,
+	0x58, 0xa1, 0x66, 0x04, 0x0e, 0x0f, 0xf5, 0xd1, 0x91, 0x96, 0x4f, 0xf7, 0xc0, 0x8b, 0x0f, 0x2b,
+	0x7f, 0x2b, 0x2d, 0x5f, 0xd1, 0xa8, 0x56, 0x5e, 0x9d, 0xc4, 0xd3, 0xaa, 0xcf, 0x51, 0x63, 0x82,
+	0x64, 0xd7, 0xe2, 0xbd, 0xe2, 0x3e, 0x9e, 0x84, 0x12, 0x99, 0x8d, 0x4c, 0x26, 0xdb, 0x6d, 0x5f,
+	0xab, 0x88, 0x07, 0x7d, 0x5a, 0xa4, 0x27, 0xeb, 0xa4, 0x78, 0x28, 0x6d, 0x25, 0x92, 0x5f, 0x83,
+	0x50, 0x48, 0x88, 0xf4, 0x32, 0x2f, 0x97, 0xd7, 0xa9, 0xca, 0x20, 0x74, 0x35, 0x81, 0x5e, 0x89,
+	0x5e, 0x0e, 0xfc, 0x5d, 0x47, 0x2b, 0xa5, 0x63, 0x18, 0x77, 0xd0, 0xf2, 0x80, 0x49, 0xdb, 0x11,
+	0x52, 0xe9, 0x05, 0x59, 0xb0, 0xd6, 0xc7, 0xb1, 0xd9, 0x4c, 0xe5, 0xf2, 0x08, 0xa1, 0x4b, 0x03,
+	0x26, 0x0f, 0x84, 0x54, 0xf8, 0x53, 0xb4, 0xe2, 0x82, 0x07, 0x0a, 0xd2, 0x94, 0x74, 0x5f, 0xdc,
+	0x2a, 0xce, 0xc9, 0x52, 0x90, 0x50, 0x94, 0xbe, 0xe9, 0xc4, 0xcf, 0xd1, 0x6a, 0x04, 0xcc, 0xd5,
+	0x11, 0xfb, 0xcc, 0x63, 0x2a, 0x1b, 0xf9, 0xad, 0x71, 0x6c, 0x6e, 0xe6, 0x0d, 0x29, 0xc7, 0x09,
+	0xbd, 0x99, 0x00, 0x49, 0xf2, 0xb1, 0xc7, 0x14, 0x7e, 0x8e, 0x70, 0x41, 0x08, 0x21, 0xb2, 0x4f,
+	0x47, 0x2a, 0x9f, 0xf4, 0xd2, 0x75, 0x3a, 0xcd, 0x21, 0xb4, 0x99, 0x0b, 0xf5, 0x20, 0xb2, 0x46,
+	0x0a, 0xb0, 0x85, 0x9a, 0xaf, 0x23, 0xae, 0xa0, 0xa8, 0x96, 0xcd, 0xfc, 0x76, 0xb1, 0xd6, 0x15,
+	0x02, 0xa1, 0x1f, 0x69, 0x64, 0xe2, 0xe7, 0x04, 0xad, 0x97, 0x28, 0x13, 0x43, 0x8b, 0x5a, 0xa7,
+	0x74, 0x88, 0xcd, 0x20, 0x11, 0xda, 0x9a, 0x68, 0xe5, 0x96, 0x4e, 0xd0, 0x3a, 0x57, 0x10, 0xd9,
+	0x01, 0x5c, 0xaa, 0x92, 0xad, 0xa5, 0xaa, 0xdc, 0x0c, 0x12, 0xa1, 0xad, 0x04, 0xfd, 0x12, 0x2e,
+	0x55, 0xee, 0xce, 0x7a, 0xfa, 0xe6, 0xaa, 0x5d, 0x7b, 0x7b, 0xd5, 0xae, 0xfd, 0x75, 0xd5, 0xae,
+	0xfd, 0xfc, 0xbe, 0x3d, 0xf7, 0xf6, 0x7d, 0x7b, 0xee, 0xcf, 0xf7, 0xed, 0xb9, 0xef, 0xee, 0x87,
+	0xe7, 0xfd, 0xce, 0xd9, 0x45, 0x34, 0xd2, 0xff, 0x61, 0x1d, 0x17, 0x86, 0xdd, 0xc9, 0xdf, 0x6a,
+	0x3a, 0x84, 0xdd, 0x4b, 0xfd, 0xdb, 0xaa, 0x46, 0x21, 0xc8, 0xd3, 0x45, 0xfd, 0x97, 0xf9, 0xe8,
+	0xdf, 0x01, 0x00, 0x37, 0x31, 0xd9, 0x42, 0xd3, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -137,6 +605,78 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BankERC20Denoms) > 0 {
+		for iNdEx := len(m.BankERC20Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BankERC20Denoms[iNdEx])
+			copy(dAtA[i:], m.BankERC20Denoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BankERC20Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.PrecompileTransientKVGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.PrecompileKVGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DispatchAllowlist) > 0 {
+		for iNdEx := len(m.DispatchAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DispatchAllowlist[iNdEx])
+			copy(dAtA[i:], m.DispatchAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DispatchAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.ChainConfig) > 0 {
 		i -= len(m.ChainConfig)
 		copy(dAtA[i:], m.ChainConfig)
@@ -145,76 +685,1305 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA2 := make([]byte, len(m.ExtraEIPs)*10)
-		var j1 int
+		dAtA6 := make([]byte, len(m.ExtraEIPs)*10)
+		var j5 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintParams(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmDenom) > 0 {
+		i -= len(m.EvmDenom)
+		copy(dAtA[i:], m.EvmDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EvmDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeactivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeactivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeployedAddress) > 0 {
+		i -= len(m.DeployedAddress)
+		copy(dAtA[i:], m.DeployedAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DeployedAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitialBaseFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InitialBaseFee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinBaseFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBaseFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDistributionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistributionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistributionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TipsToProposer {
+		i--
+		if m.TipsToProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.RecipientRatio.Size()
+		i -= size
+		if _, err := m.RecipientRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KVGasParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVGasParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVGasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	l = len(m.ChainConfig)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.FeeMarket.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DispatchAllowlist) > 0 {
+		for _, s := range m.DispatchAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.PrecompileKVGas.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PrecompileTransientKVGas.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BankERC20Denoms) > 0 {
+		for _, s := range m.BankERC20Denoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.DeployedAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	if m.DeactivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.DeactivationHeight))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovParams(uint64(m.ElasticityMultiplier))
+	}
+	if m.MinBaseFee != 0 {
+		n += 1 + sovParams(uint64(m.MinBaseFee))
+	}
+	if m.InitialBaseFee != 0 {
+		n += 1 + sovParams(uint64(m.InitialBaseFee))
+	}
+	return n
+}
+
+func (m *FeeDistributionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityPoolRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RecipientRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TipsToProposer {
+		n += 2
+	}
+	return n
+}
+
+func (m *KVGasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovParams(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovParams(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovParams(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovParams(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovParams(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovParams(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovParams(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DispatchAllowlist = append(m.DispatchAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileKVGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileKVGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileTransientKVGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileTransientKVGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, PrecompileConfig{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankERC20Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankERC20Denoms = append(m.BankERC20Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationHeight", wireType)
+			}
+			m.DeactivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			m.MinBaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBaseFee", wireType)
+			}
+			m.InitialBaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistributionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistributionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistributionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipsToProposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			m.TipsToProposer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EvmDenom) > 0 {
-		i -= len(m.EvmDenom)
-		copy(dAtA[i:], m.EvmDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.EvmDenom)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovParams(uint64(e))
-		}
-		n += 1 + sovParams(uint64(l)) + l
-	}
-	l = len(m.ChainConfig)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *KVGasParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -237,17 +2006,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: KVGasParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KVGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
 			}
-			var stringLen uint64
+			m.HasCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -257,105 +2026,92 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HasCost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowParams
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
 				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowParams
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthParams
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthParams
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowParams
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
 			}
-			var stringLen uint64
+			m.WriteCostPerByte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -365,24 +2121,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChainConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -23,8 +23,12 @@ package types
 import (
 	"math/big"
 
+	storetypes "cosmossdk.io/store/types"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"pkg.furychain.dev/gridiron/eth/common"
 )
 
 var _ = Describe("Test Params", func() {
@@ -33,4 +37,93 @@ var _ = Describe("Test Params", func() {
 		ethConfig := params.EthereumChainConfig()
 		Expect(ethConfig.ChainID).To(Equal(big.NewInt(69420)))
 	})
+
+	It("should validate the dispatch allowlist", func() {
+		params := DefaultParams()
+		Expect(params.ValidateBasic()).To(Succeed())
+		Expect(params.IsDispatchAllowed("/cosmos.bank.v1beta1.MsgSend")).To(BeFalse())
+
+		params.DispatchAllowlist = []string{"/cosmos.bank.v1beta1.MsgSend"}
+		Expect(params.ValidateBasic()).To(Succeed())
+		Expect(params.IsDispatchAllowed("/cosmos.bank.v1beta1.MsgSend")).To(BeTrue())
+
+		params.DispatchAllowlist = []string{"cosmos.bank.v1beta1.MsgSend"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidDispatchAllowlist))
+
+		params.DispatchAllowlist = []string{"/gridiron.evm.v1alpha1.CallContractRequest"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidDispatchAllowlist))
+
+		params.DispatchAllowlist = []string{"/cosmos.authz.v1beta1.MsgExec"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidDispatchAllowlist))
+
+		params.DispatchAllowlist = []string{"/cosmos.group.v1.MsgExec"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidDispatchAllowlist))
+
+		params.DispatchAllowlist = []string{
+			"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend",
+		}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidDispatchAllowlist))
+	})
+
+	It("should validate the precompile kv gas params", func() {
+		params := DefaultParams()
+		Expect(params.PrecompileKVGas.GasConfig()).To(Equal(storetypes.KVGasConfig()))
+		Expect(params.PrecompileTransientKVGas.GasConfig()).To(Equal(storetypes.TransientGasConfig()))
+
+		params.PrecompileKVGas.ReadCostFlat = 0
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidKVGasParams))
+
+		params = DefaultParams()
+		params.PrecompileTransientKVGas = KVGasParams{}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidKVGasParams))
+	})
+
+	It("should validate and resolve the precompile configs", func() {
+		addr1 := common.BytesToAddress([]byte{0x69})
+		addr2 := common.BytesToAddress([]byte{0x70})
+		addr3 := common.BytesToAddress([]byte{0x71})
+
+		params := DefaultParams()
+		params.Precompiles = []PrecompileConfig{
+			{Address: addr1.Hex(), ActivationHeight: 10, DeactivationHeight: 20},
+			{Address: addr2.Hex(), DeployedAddress: addr3.Hex()},
+		}
+		Expect(params.ValidateBasic()).To(Succeed())
+
+		pc := params.Precompiles[0]
+		Expect(pc.IsActive(9)).To(BeFalse())
+		Expect(pc.IsActive(10)).To(BeTrue())
+		Expect(pc.IsActive(20)).To(BeFalse())
+		Expect(pc.DeployedAt()).To(Equal(addr1))
+		Expect(params.Precompiles[1].IsActive(1)).To(BeTrue())
+		Expect(params.Precompiles[1].DeployedAt()).To(Equal(addr3))
+
+		params.Precompiles[0].DeactivationHeight = 10
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidPrecompileConfig))
+
+		params.Precompiles[0].DeactivationHeight = 0
+		params.Precompiles[0].Address = "not an address"
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidPrecompileConfig))
+
+		params.Precompiles[0].Address = addr2.Hex()
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidPrecompileConfig))
+
+		params.Precompiles[0].Address = addr1.Hex()
+		params.Precompiles[0].DeployedAddress = addr3.Hex()
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidPrecompileConfig))
+	})
+
+	It("should validate the bank ERC20 denoms", func() {
+		params := DefaultParams()
+		params.BankERC20Denoms = []string{
+			"abgt", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		}
+		Expect(params.ValidateBasic()).To(Succeed())
+
+		params.BankERC20Denoms = []string{"abgt", "abgt"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidBankERC20Denoms))
+
+		params.BankERC20Denoms = []string{"1abgt"}
+		Expect(params.ValidateBasic()).To(MatchError(ErrInvalidBankERC20Denoms))
+	})
 })
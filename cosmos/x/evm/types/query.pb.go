@@ -37,7 +37,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eabbdb83b909a591, []int{0}
+	return fileDescriptor_cf48337fdc2de705, []int{0}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +76,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eabbdb83b909a591, []int{1}
+	return fileDescriptor_cf48337fdc2de705, []int{1}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,34 +112,121 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type BaseFeeRequest struct {
+}
+
+func (m *BaseFeeRequest) Reset()         { *m = BaseFeeRequest{} }
+func (m *BaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRequest) ProtoMessage()    {}
+func (*BaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf48337fdc2de705, []int{2}
+}
+func (m *BaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRequest.Merge(m, src)
+}
+func (m *BaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRequest proto.InternalMessageInfo
+
+type BaseFeeResponse struct {
+	// `base_fee` is the base fee of the latest block, in the EVM denom.
+	BaseFee string `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+}
+
+func (m *BaseFeeResponse) Reset()         { *m = BaseFeeResponse{} }
+func (m *BaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeResponse) ProtoMessage()    {}
+func (*BaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf48337fdc2de705, []int{3}
+}
+func (m *BaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeResponse.Merge(m, src)
+}
+func (m *BaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeResponse proto.InternalMessageInfo
+
+func (m *BaseFeeResponse) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "gridiron.evm.v1alpha1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "gridiron.evm.v1alpha1.ParamsResponse")
-}
-
-func init() { proto.RegisterFile("gridiron/evm/v1alpha1/query.proto", fileDescriptor_eabbdb83b909a591) }
-
-var fileDescriptor_eabbdb83b909a591 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xc8, 0xcf, 0x49,
-	0x2c, 0xca, 0x2c, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xaa,
-	0xd0, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0xa9, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0,
-	0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32,
-	0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0x8a, 0x58,
-	0xed, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x2a, 0x51, 0xe2, 0xe7, 0xe2, 0x0d, 0x00, 0xf3, 0x83,
-	0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0x7c, 0xb8, 0xf8, 0x60, 0x02, 0xc5, 0x05, 0xf9, 0x79,
-	0xc5, 0xa9, 0x42, 0x56, 0x5c, 0x6c, 0x10, 0x2d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x32,
-	0x7a, 0xd8, 0x1c, 0xa8, 0x07, 0xd1, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x87,
-	0x51, 0x27, 0x23, 0x17, 0x4f, 0x20, 0xc8, 0x6f, 0xc1, 0xa9, 0x45, 0x65, 0x99, 0xc9, 0xa9, 0x42,
-	0x95, 0x5c, 0x6c, 0x10, 0x85, 0x42, 0xca, 0xf8, 0x8c, 0x81, 0xba, 0x46, 0x4a, 0x05, 0xbf, 0x22,
-	0x88, 0x0b, 0x95, 0x54, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0x24, 0x27, 0x24, 0xa3, 0x8f, 0xc7, 0xc3,
-	0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x53, 0x90, 0x9d,
-	0xae, 0x97, 0x94, 0x5a, 0x94, 0x98, 0x9c, 0x91, 0x98, 0x99, 0xa7, 0x97, 0x92, 0x5a, 0x06, 0x37,
-	0x28, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0xbf, 0x02, 0x6c, 0x62, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xe4, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x13, 0xfd, 0xe8, 0x4a, 0xca,
-	0x01, 0x00, 0x00,
+	proto.RegisterType((*BaseFeeRequest)(nil), "gridiron.evm.v1alpha1.BaseFeeRequest")
+	proto.RegisterType((*BaseFeeResponse)(nil), "gridiron.evm.v1alpha1.BaseFeeResponse")
+}
+
+func init() { proto.RegisterFile("gridiron/evm/v1alpha1/query.proto", fileDescriptor_cf48337fdc2de705) }
+
+var fileDescriptor_cf48337fdc2de705 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x4e, 0xc2, 0x40,
+	0x10, 0x87, 0x5b, 0x62, 0x40, 0x57, 0x05, 0xb3, 0xd1, 0x44, 0x1b, 0x29, 0xd2, 0x88, 0x7a, 0xd0,
+	0x6e, 0xc0, 0xa3, 0x37, 0x0e, 0x7a, 0x32, 0x51, 0xbc, 0x79, 0x31, 0x0b, 0x0c, 0xa5, 0x91, 0x76,
+	0x97, 0xdd, 0xd2, 0x48, 0xe2, 0x45, 0x9f, 0x80, 0xc4, 0x97, 0xe2, 0x48, 0xe2, 0xc5, 0x93, 0x31,
+	0xe0, 0x83, 0x18, 0xda, 0xad, 0xc6, 0x84, 0x3f, 0xb7, 0x76, 0xf6, 0x9b, 0xf9, 0x7d, 0x3b, 0x59,
+	0x54, 0x74, 0x84, 0xdb, 0x74, 0x05, 0xf3, 0x09, 0x84, 0x1e, 0x09, 0xcb, 0xb4, 0xc3, 0xdb, 0xb4,
+	0x4c, 0xba, 0x3d, 0x10, 0x7d, 0x9b, 0x0b, 0x16, 0x30, 0xbc, 0x93, 0x20, 0x36, 0x84, 0x9e, 0x9d,
+	0x20, 0xc6, 0xb6, 0xc3, 0x1c, 0x16, 0x11, 0x64, 0xfa, 0x15, 0xc3, 0xc6, 0xbe, 0xc3, 0x98, 0xd3,
+	0x01, 0x42, 0xb9, 0x4b, 0xa8, 0xef, 0xb3, 0x80, 0x06, 0x2e, 0xf3, 0xa5, 0x3a, 0xb5, 0x66, 0xa7,
+	0x71, 0x2a, 0xa8, 0xa7, 0x18, 0x2b, 0x87, 0x36, 0x6f, 0xa2, 0xff, 0x1a, 0x74, 0x7b, 0x20, 0x03,
+	0xeb, 0x1a, 0x65, 0x93, 0x82, 0xe4, 0xcc, 0x97, 0x80, 0x2f, 0x50, 0x3a, 0x6e, 0xd9, 0xd5, 0x0f,
+	0xf4, 0x93, 0xf5, 0x4a, 0xde, 0x9e, 0xa9, 0x68, 0xc7, 0x6d, 0xd5, 0x95, 0xe1, 0x67, 0x41, 0xab,
+	0xa9, 0x16, 0x6b, 0x0b, 0x65, 0xab, 0x54, 0xc2, 0x25, 0x40, 0x12, 0x70, 0x8a, 0x72, 0xbf, 0x15,
+	0x95, 0xb0, 0x87, 0x56, 0xeb, 0x54, 0xc2, 0x43, 0x0b, 0x20, 0xca, 0x58, 0xab, 0x65, 0xea, 0x31,
+	0x52, 0x19, 0xa4, 0xd0, 0xc6, 0xed, 0x74, 0x3d, 0x77, 0x20, 0x42, 0xb7, 0x01, 0xf8, 0x19, 0xa5,
+	0xe3, 0x20, 0x7c, 0xb8, 0xd0, 0x43, 0xc5, 0x19, 0xa5, 0x25, 0x54, 0xac, 0x60, 0x95, 0x5e, 0xdf,
+	0xbf, 0xdf, 0x52, 0x05, 0x9c, 0x27, 0x8b, 0x96, 0x86, 0x5f, 0x74, 0x94, 0x51, 0xf6, 0x78, 0xde,
+	0xe4, 0xff, 0xf7, 0x35, 0x8e, 0x96, 0x61, 0xca, 0xe0, 0x38, 0x32, 0x28, 0xe2, 0xc2, 0x1c, 0x83,
+	0x64, 0x43, 0xd5, 0xab, 0xe1, 0xd8, 0xd4, 0x47, 0x63, 0x53, 0xff, 0x1a, 0x9b, 0xfa, 0x60, 0x62,
+	0x6a, 0xa3, 0x89, 0xa9, 0x7d, 0x4c, 0x4c, 0xed, 0xfe, 0x8c, 0x3f, 0x3a, 0x76, 0xab, 0x27, 0xfa,
+	0x8d, 0x36, 0x75, 0x7d, 0xbb, 0x09, 0xe1, 0xdf, 0xac, 0x06, 0x93, 0x1e, 0x93, 0xe4, 0x29, 0x1a,
+	0x1a, 0xf4, 0x39, 0xc8, 0x7a, 0x3a, 0x7a, 0x02, 0xe7, 0x3f, 0x03, 0x00, 0x6e, 0xc0, 0x56, 0x41,
+	0x96, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +243,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryServiceClient interface {
 	// Params returns the total set of evm parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// BaseFee returns the base fee of the latest finalized gridiron block.
+	BaseFee(ctx context.Context, in *BaseFeeRequest, opts ...grpc.CallOption) (*BaseFeeResponse, error)
 }
 
 type queryServiceClient struct {
@@ -175,10 +264,21 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) BaseFee(ctx context.Context, in *BaseFeeRequest, opts ...grpc.CallOption) (*BaseFeeResponse, error) {
+	out := new(BaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.evm.v1alpha1.QueryService/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	// Params returns the total set of evm parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// BaseFee returns the base fee of the latest finalized gridiron block.
+	BaseFee(context.Context, *BaseFeeRequest) (*BaseFeeResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -188,6 +288,9 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServiceServer) BaseFee(ctx context.Context, req *BaseFeeRequest) (*BaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -211,6 +314,24 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.evm.v1alpha1.QueryService/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BaseFee(ctx, req.(*BaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.evm.v1alpha1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -219,6 +340,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _QueryService_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/evm/v1alpha1/query.proto",
@@ -280,6 +405,59 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		i -= len(m.BaseFee)
+		copy(dAtA[i:], m.BaseFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseFee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -311,6 +489,28 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *BaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -450,6 +650,138 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
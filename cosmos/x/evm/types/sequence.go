@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Furychain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// signedSequencesKey is the context key of the signers whose account sequence was incremented by
+// the ante handler.
+type signedSequencesKey struct{}
+
+// WithSignedSequences returns a copy of the context that records that the account sequences of
+// the given transaction signers were incremented by the ante handler.
+func WithSignedSequences(ctx sdk.Context, signers []sdk.AccAddress) sdk.Context {
+	signed := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		signed[signer.String()] = struct{}{}
+	}
+	return ctx.WithValue(signedSequencesKey{}, signed)
+}
+
+// ConsumeSignedSequence returns true, at most once per transaction, if the account sequence of
+// the given address was incremented by the ante handler as a signer of the transaction.
+func ConsumeSignedSequence(ctx sdk.Context, addr sdk.AccAddress) bool {
+	signed, ok := ctx.Value(signedSequencesKey{}).(map[string]struct{})
+	if !ok {
+		return false
+	}
+	if _, ok = signed[addr.String()]; !ok {
+		return false
+	}
+	delete(signed, addr.String())
+	return true
+}
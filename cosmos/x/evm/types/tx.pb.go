@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -40,7 +41,7 @@ func (m *EthTransactionRequest) Reset()         { *m = EthTransactionRequest{} }
 func (m *EthTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*EthTransactionRequest) ProtoMessage()    {}
 func (*EthTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{0}
+	return fileDescriptor_b1cf39093a2a4a02, []int{0}
 }
 func (m *EthTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +84,7 @@ func (m *EthTransactionResponse) Reset()         { *m = EthTransactionResponse{}
 func (m *EthTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*EthTransactionResponse) ProtoMessage()    {}
 func (*EthTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{1}
+	return fileDescriptor_b1cf39093a2a4a02, []int{1}
 }
 func (m *EthTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,7 +129,7 @@ func (m *UpdateParamsRequest) Reset()         { *m = UpdateParamsRequest{} }
 func (m *UpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateParamsRequest) ProtoMessage()    {}
 func (*UpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{2}
+	return fileDescriptor_b1cf39093a2a4a02, []int{2}
 }
 func (m *UpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +183,7 @@ func (m *UpdateParamsResponse) Reset()         { *m = UpdateParamsResponse{} }
 func (m *UpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateParamsResponse) ProtoMessage()    {}
 func (*UpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{3}
+	return fileDescriptor_b1cf39093a2a4a02, []int{3}
 }
 func (m *UpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,46 +212,344 @@ func (m *UpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateParamsResponse proto.InternalMessageInfo
 
+// `CallContractRequest` is the Msg/CallContract request type. It is executed in the EVM with the
+// Ethereum address of `sender` as the caller.
+type CallContractRequest struct {
+	// sender is the address of the account calling the contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is the hex address of the contract being called.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// data is the call data (ABI encoded method and arguments) sent to the contract.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// value is the amount of the EVM denom sent along with the call.
+	Value cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+	// gas_limit is the maximum amount of gas the EVM execution may consume.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *CallContractRequest) Reset()         { *m = CallContractRequest{} }
+func (m *CallContractRequest) String() string { return proto.CompactTextString(m) }
+func (*CallContractRequest) ProtoMessage()    {}
+func (*CallContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cf39093a2a4a02, []int{4}
+}
+func (m *CallContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallContractRequest.Merge(m, src)
+}
+func (m *CallContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallContractRequest proto.InternalMessageInfo
+
+func (m *CallContractRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CallContractRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// `CallContractResponse` defines the Msg/CallContract response type.
+type CallContractResponse struct {
+	// `gas_used` represents the gas used by the virtual machine execution.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// `vm_error` contains an error message if the virtual machine execution failed.
+	VmError string `protobuf:"bytes,2,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// `return_data` contains the return data of the virtual machine execution.
+	ReturnData []byte `protobuf:"bytes,3,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+}
+
+func (m *CallContractResponse) Reset()         { *m = CallContractResponse{} }
+func (m *CallContractResponse) String() string { return proto.CompactTextString(m) }
+func (*CallContractResponse) ProtoMessage()    {}
+func (*CallContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cf39093a2a4a02, []int{5}
+}
+func (m *CallContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallContractResponse.Merge(m, src)
+}
+func (m *CallContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallContractResponse proto.InternalMessageInfo
+
+func (m *CallContractResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CallContractResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+// `DeployContractRequest` is the Msg/DeployContract request type. It is executed in the EVM with
+// the Ethereum address of `sender` as the deployer.
+type DeployContractRequest struct {
+	// sender is the address of the account deploying the contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// bytecode is the contract creation code, including the ABI encoded constructor arguments.
+	Bytecode []byte `protobuf:"bytes,2,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+	// value is the amount of the EVM denom sent to the contract on creation.
+	Value cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+	// gas_limit is the maximum amount of gas the EVM execution may consume.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *DeployContractRequest) Reset()         { *m = DeployContractRequest{} }
+func (m *DeployContractRequest) String() string { return proto.CompactTextString(m) }
+func (*DeployContractRequest) ProtoMessage()    {}
+func (*DeployContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cf39093a2a4a02, []int{6}
+}
+func (m *DeployContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeployContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployContractRequest.Merge(m, src)
+}
+func (m *DeployContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployContractRequest proto.InternalMessageInfo
+
+func (m *DeployContractRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DeployContractRequest) GetBytecode() []byte {
+	if m != nil {
+		return m.Bytecode
+	}
+	return nil
+}
+
+func (m *DeployContractRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// `DeployContractResponse` defines the Msg/DeployContract response type.
+type DeployContractResponse struct {
+	// `contract_address` is the hex address of the deployed contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// `gas_used` represents the gas used by the virtual machine execution.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// `vm_error` contains an error message if the virtual machine execution failed.
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// `return_data` contains the return data of the virtual machine execution.
+	ReturnData []byte `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+}
+
+func (m *DeployContractResponse) Reset()         { *m = DeployContractResponse{} }
+func (m *DeployContractResponse) String() string { return proto.CompactTextString(m) }
+func (*DeployContractResponse) ProtoMessage()    {}
+func (*DeployContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1cf39093a2a4a02, []int{7}
+}
+func (m *DeployContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeployContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployContractResponse.Merge(m, src)
+}
+func (m *DeployContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployContractResponse proto.InternalMessageInfo
+
+func (m *DeployContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *DeployContractResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *DeployContractResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *DeployContractResponse) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EthTransactionRequest)(nil), "gridiron.evm.v1alpha1.EthTransactionRequest")
 	proto.RegisterType((*EthTransactionResponse)(nil), "gridiron.evm.v1alpha1.EthTransactionResponse")
 	proto.RegisterType((*UpdateParamsRequest)(nil), "gridiron.evm.v1alpha1.UpdateParamsRequest")
 	proto.RegisterType((*UpdateParamsResponse)(nil), "gridiron.evm.v1alpha1.UpdateParamsResponse")
+	proto.RegisterType((*CallContractRequest)(nil), "gridiron.evm.v1alpha1.CallContractRequest")
+	proto.RegisterType((*CallContractResponse)(nil), "gridiron.evm.v1alpha1.CallContractResponse")
+	proto.RegisterType((*DeployContractRequest)(nil), "gridiron.evm.v1alpha1.DeployContractRequest")
+	proto.RegisterType((*DeployContractResponse)(nil), "gridiron.evm.v1alpha1.DeployContractResponse")
 }
 
-func init() { proto.RegisterFile("gridiron/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
-
-var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x41, 0x68, 0xc9, 0xb5, 0xea, 0x70, 0x84, 0x92, 0x5a, 0xe0, 0x94, 0x4c, 0x25, 0x14,
-	0x5b, 0x29, 0x12, 0x43, 0x36, 0x22, 0xca, 0x86, 0x84, 0x5c, 0xba, 0xb0, 0x44, 0xd7, 0xf8, 0xc9,
-	0xb1, 0x5a, 0xfb, 0xcc, 0xbd, 0xb3, 0xd5, 0x6e, 0x88, 0x89, 0x91, 0x95, 0x8d, 0x9f, 0xd0, 0x81,
-	0x1f, 0xd1, 0xb1, 0x62, 0x62, 0x42, 0x28, 0x19, 0xf2, 0x37, 0x90, 0xef, 0x2e, 0x0a, 0xad, 0x3c,
-	0x64, 0xf2, 0x7b, 0xef, 0x7b, 0x9f, 0xbf, 0x77, 0xdf, 0x7b, 0xf4, 0x49, 0x2e, 0xce, 0xb8, 0x4c,
-	0x30, 0x80, 0x32, 0x0d, 0xca, 0x3e, 0x3f, 0xcb, 0x27, 0xbc, 0x1f, 0xa8, 0x73, 0x3f, 0x97, 0x42,
-	0x09, 0xd6, 0xb2, 0xb0, 0x0f, 0x65, 0xea, 0x2f, 0x60, 0xf7, 0xd1, 0x58, 0x60, 0x2a, 0x30, 0x48,
-	0x31, 0x0e, 0xca, 0x7e, 0xf5, 0x31, 0xed, 0xee, 0x8e, 0x01, 0x46, 0x3a, 0x0b, 0x4c, 0x62, 0xa1,
-	0x56, 0x2c, 0x62, 0x61, 0xea, 0x55, 0x64, 0xab, 0x4f, 0x6b, 0xe5, 0x73, 0x2e, 0x79, 0x6a, 0x89,
-	0xdd, 0x3e, 0x7d, 0x78, 0xa8, 0x26, 0x1f, 0x24, 0xcf, 0x90, 0x8f, 0x55, 0x22, 0xb2, 0x10, 0x3e,
-	0x15, 0x80, 0x8a, 0x31, 0xda, 0x88, 0xb8, 0xe2, 0x6d, 0xb2, 0x4b, 0xf6, 0x36, 0x43, 0x1d, 0x0f,
-	0x1a, 0x5f, 0x7f, 0x74, 0x9c, 0x6e, 0x41, 0xb7, 0x6f, 0x53, 0x30, 0x17, 0x19, 0x02, 0xdb, 0xa1,
-	0xf7, 0x63, 0x8e, 0xa3, 0x02, 0x21, 0xd2, 0xbc, 0x46, 0xb8, 0x1e, 0x73, 0x3c, 0x46, 0x88, 0x2a,
-	0xa8, 0x4c, 0x47, 0x20, 0xa5, 0x90, 0xed, 0x3b, 0xbb, 0x64, 0xaf, 0x19, 0xae, 0x97, 0xe9, 0x61,
-	0x95, 0xb2, 0x0e, 0xdd, 0x90, 0xa0, 0x0a, 0x99, 0x8d, 0xb4, 0xe0, 0x5d, 0x2d, 0x48, 0x4d, 0xe9,
-	0xcd, 0x52, 0xf6, 0x3b, 0xa1, 0x0f, 0x8e, 0xf3, 0x88, 0x2b, 0x78, 0xaf, 0x1f, 0xb0, 0x18, 0xf4,
-	0x15, 0x6d, 0xf2, 0x42, 0x4d, 0x84, 0x4c, 0xd4, 0x85, 0x56, 0x6d, 0x0e, 0xdb, 0xbf, 0x7e, 0xbe,
-	0x68, 0x59, 0x7f, 0x5e, 0x47, 0x91, 0x04, 0xc4, 0x23, 0x25, 0x93, 0x2c, 0x0e, 0x97, 0xad, 0x6c,
-	0x40, 0xd7, 0x8c, 0x13, 0x7a, 0x9e, 0x8d, 0x83, 0xc7, 0x7e, 0xdd, 0x36, 0x7c, 0x23, 0x36, 0x6c,
-	0x5c, 0xfd, 0xe9, 0x38, 0xa1, 0x65, 0x0c, 0xb6, 0xbe, 0xcc, 0x2f, 0x7b, 0xcb, 0x7f, 0x75, 0xb7,
-	0x69, 0xeb, 0xe6, 0x68, 0xc6, 0x90, 0x83, 0x39, 0xa1, 0xf4, 0x1d, 0xc6, 0x47, 0x20, 0xcb, 0x64,
-	0x0c, 0xec, 0x94, 0x6e, 0xdd, 0x74, 0x8e, 0x3d, 0xaf, 0x17, 0xad, 0x5d, 0x89, 0xbb, 0xbf, 0x5a,
-	0xb3, 0x5d, 0x06, 0xd0, 0xcd, 0xff, 0x67, 0x62, 0xcf, 0xea, 0xd9, 0x35, 0x96, 0xba, 0xbd, 0x55,
-	0x5a, 0x8d, 0x8c, 0x7b, 0xef, 0xf3, 0xfc, 0xb2, 0x47, 0x86, 0x6f, 0xaf, 0xa6, 0x1e, 0xb9, 0x9e,
-	0x7a, 0xe4, 0xef, 0xd4, 0x23, 0xdf, 0x66, 0x9e, 0x73, 0x3d, 0xf3, 0x9c, 0xdf, 0x33, 0xcf, 0xf9,
-	0xb8, 0x9f, 0x9f, 0xc6, 0xfe, 0x09, 0x48, 0x3e, 0x9e, 0xf0, 0x24, 0xf3, 0x23, 0x28, 0x83, 0xc5,
-	0x59, 0xda, 0x43, 0x3f, 0xd7, 0xf7, 0xa9, 0x2e, 0x72, 0xc0, 0x93, 0x35, 0x7d, 0x96, 0x2f, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x09, 0x4d, 0x5f, 0x76, 0x3a, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("gridiron/evm/v1alpha1/tx.proto", fileDescriptor_b1cf39093a2a4a02) }
+
+var fileDescriptor_b1cf39093a2a4a02 = []byte{
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3d, 0x4f, 0xdb, 0x40,
+	0x18, 0x8e, 0x13, 0xf3, 0x91, 0x17, 0x94, 0x56, 0x26, 0xa1, 0xc1, 0x55, 0x1d, 0xe4, 0x89, 0x42,
+	0x63, 0x37, 0x54, 0xea, 0x40, 0x27, 0xbe, 0x54, 0x21, 0xb5, 0x52, 0x65, 0xca, 0xd2, 0x25, 0x3a,
+	0xec, 0xab, 0x63, 0x11, 0xfb, 0xdc, 0xbb, 0xb3, 0x45, 0xb6, 0xaa, 0x53, 0xc7, 0xce, 0x4c, 0xfd,
+	0x09, 0x0c, 0xfc, 0x83, 0x2e, 0x8c, 0x88, 0x09, 0x75, 0x40, 0x15, 0x0c, 0xfc, 0x8d, 0xca, 0xf6,
+	0x85, 0x90, 0x28, 0xa1, 0x91, 0xda, 0x4e, 0xf6, 0xfb, 0x75, 0xcf, 0xf3, 0x3e, 0xef, 0x7d, 0x80,
+	0xe6, 0x52, 0xcf, 0xf1, 0x28, 0x09, 0x4c, 0x1c, 0xfb, 0x66, 0xdc, 0x40, 0xed, 0xb0, 0x85, 0x1a,
+	0x26, 0x3f, 0x34, 0x42, 0x4a, 0x38, 0x51, 0x2a, 0xdd, 0xb8, 0x81, 0x63, 0xdf, 0xe8, 0xc6, 0xd5,
+	0x47, 0x36, 0x61, 0x3e, 0x61, 0xa6, 0xcf, 0x5c, 0x33, 0x6e, 0x24, 0x9f, 0x2c, 0x5f, 0x5d, 0xc8,
+	0x02, 0xcd, 0xd4, 0x32, 0x33, 0x43, 0x84, 0xca, 0x2e, 0x71, 0x49, 0xe6, 0x4f, 0xfe, 0x84, 0x57,
+	0x1f, 0x4e, 0x20, 0x44, 0x14, 0xf9, 0xa2, 0x52, 0x6f, 0x40, 0x65, 0x9b, 0xb7, 0xde, 0x53, 0x14,
+	0x30, 0x64, 0x73, 0x8f, 0x04, 0x16, 0xfe, 0x14, 0x61, 0xc6, 0x15, 0x05, 0x64, 0x07, 0x71, 0x54,
+	0x95, 0x16, 0xa5, 0xa5, 0x59, 0x2b, 0xfd, 0x5f, 0x93, 0xbf, 0x7e, 0xaf, 0xe5, 0xf4, 0x08, 0xe6,
+	0x07, 0x4b, 0x58, 0x48, 0x02, 0x86, 0x95, 0x05, 0x98, 0x76, 0x11, 0x6b, 0x46, 0x0c, 0x3b, 0x69,
+	0x9d, 0x6c, 0x4d, 0xb9, 0x88, 0xed, 0x31, 0xec, 0x24, 0xa1, 0xd8, 0x6f, 0x62, 0x4a, 0x09, 0xad,
+	0xe6, 0x17, 0xa5, 0xa5, 0xa2, 0x35, 0x15, 0xfb, 0xdb, 0x89, 0xa9, 0xd4, 0x60, 0x86, 0x62, 0x1e,
+	0xd1, 0xa0, 0x99, 0x02, 0x16, 0x52, 0x40, 0xc8, 0x5c, 0x5b, 0x3d, 0xd8, 0x23, 0x09, 0xe6, 0xf6,
+	0x42, 0x07, 0x71, 0xfc, 0x2e, 0x6d, 0xa0, 0x4b, 0xf4, 0x25, 0x14, 0x51, 0xc4, 0x5b, 0x84, 0x7a,
+	0xbc, 0x93, 0xa2, 0x16, 0x37, 0xaa, 0xe7, 0x27, 0xf5, 0xb2, 0x10, 0x68, 0xdd, 0x71, 0x28, 0x66,
+	0x6c, 0x97, 0x53, 0x2f, 0x70, 0xad, 0x5e, 0xaa, 0xf2, 0x0a, 0x26, 0x33, 0x25, 0x52, 0x3e, 0x33,
+	0xab, 0x4f, 0x8c, 0xa1, 0xf3, 0x30, 0x32, 0xb4, 0x0d, 0xf9, 0xf4, 0xb2, 0x96, 0xb3, 0x44, 0xc9,
+	0x5a, 0xe9, 0xcb, 0xcd, 0xf1, 0x72, 0x6f, 0x31, 0x7d, 0x1e, 0xca, 0xfd, 0xdc, 0x32, 0x45, 0xf4,
+	0x0b, 0x09, 0xe6, 0x36, 0x51, 0xbb, 0xbd, 0x49, 0x02, 0x4e, 0x91, 0xcd, 0xbb, 0xa4, 0x9f, 0xc3,
+	0x24, 0xc3, 0x81, 0x83, 0xe9, 0x1f, 0x19, 0x8b, 0x3c, 0xa5, 0x04, 0x79, 0x4e, 0x84, 0x74, 0x79,
+	0x4e, 0x6e, 0xe7, 0x53, 0xe8, 0xcd, 0x47, 0x59, 0x87, 0x89, 0x18, 0xb5, 0x23, 0x5c, 0x95, 0xd3,
+	0x45, 0x57, 0x12, 0xca, 0x3f, 0x2f, 0x6b, 0x95, 0x6c, 0x61, 0xe6, 0x1c, 0x18, 0x1e, 0x31, 0x7d,
+	0xc4, 0x5b, 0xc6, 0x4e, 0xc0, 0xcf, 0x4f, 0xea, 0x20, 0x10, 0x77, 0x02, 0x6e, 0x65, 0x95, 0xca,
+	0x63, 0x28, 0x26, 0x23, 0x6c, 0x7b, 0xbe, 0xc7, 0xab, 0x13, 0xe9, 0x0c, 0x93, 0x99, 0xbe, 0x49,
+	0xec, 0xb5, 0x99, 0xa4, 0x6b, 0x41, 0x48, 0xf7, 0xa1, 0xdc, 0xdf, 0xd9, 0x7f, 0xdd, 0x04, 0xfa,
+	0xb9, 0x04, 0x95, 0x2d, 0x1c, 0xb6, 0x49, 0xe7, 0xef, 0xb5, 0x54, 0x61, 0x7a, 0xbf, 0xc3, 0xb1,
+	0x4d, 0x1c, 0x9c, 0xf2, 0x98, 0xb5, 0x6e, 0xed, 0x9e, 0x86, 0x85, 0x7f, 0xa3, 0xa1, 0x7c, 0x9f,
+	0x86, 0x47, 0x12, 0xcc, 0x0f, 0x36, 0x25, 0x64, 0x7c, 0x0a, 0x0f, 0x6d, 0xe1, 0x6b, 0xa2, 0xac,
+	0x8b, 0xac, 0x3f, 0xeb, 0x41, 0xd7, 0x2f, 0x9a, 0xeb, 0x53, 0x3c, 0x3f, 0x5a, 0xf1, 0xc2, 0xbd,
+	0x8a, 0xcb, 0x83, 0x8a, 0xaf, 0xfe, 0x28, 0x00, 0xbc, 0x65, 0xee, 0x2e, 0xa6, 0xb1, 0x67, 0x63,
+	0xc5, 0x87, 0x52, 0xff, 0xb1, 0x57, 0x9e, 0x8d, 0x38, 0x31, 0x43, 0x2f, 0x14, 0xb5, 0x3e, 0x66,
+	0xb6, 0xe8, 0xdf, 0x85, 0xd9, 0xbb, 0x27, 0x4a, 0x59, 0x1e, 0x51, 0x3e, 0xe4, 0x4a, 0x50, 0x57,
+	0xc6, 0xca, 0xed, 0x01, 0xdd, 0xdd, 0xc7, 0x23, 0x81, 0x86, 0x1c, 0x63, 0x75, 0x65, 0xac, 0x5c,
+	0x01, 0xe4, 0x43, 0xa9, 0x7f, 0xd6, 0x23, 0x05, 0x1c, 0xba, 0xcf, 0xd5, 0xfa, 0x98, 0xd9, 0x19,
+	0x9c, 0x3a, 0xf1, 0xf9, 0xe6, 0x78, 0x59, 0xda, 0x78, 0x7d, 0x7a, 0xa5, 0x49, 0x67, 0x57, 0x9a,
+	0xf4, 0xeb, 0x4a, 0x93, 0xbe, 0x5d, 0x6b, 0xb9, 0xb3, 0x6b, 0x2d, 0x77, 0x71, 0xad, 0xe5, 0x3e,
+	0xd4, 0xc3, 0x03, 0xd7, 0xf8, 0x18, 0xd1, 0x8e, 0xdd, 0x42, 0x5e, 0x60, 0x38, 0x38, 0x36, 0x6f,
+	0x1f, 0x0c, 0xf1, 0x06, 0x1d, 0xa6, 0x2f, 0x07, 0xef, 0x84, 0x98, 0xed, 0x4f, 0xa6, 0x0f, 0xc6,
+	0x8b, 0xdf, 0x03, 0x00, 0xe2, 0x08, 0xb4, 0x12, 0xd7, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -272,6 +571,12 @@ type MsgServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	// `CallContract` defines a method for calling an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error)
+	// `DeployContract` defines a method for deploying an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	DeployContract(ctx context.Context, in *DeployContractRequest, opts ...grpc.CallOption) (*DeployContractResponse, error)
 }
 
 type msgServiceClient struct {
@@ -300,6 +605,24 @@ func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsReq
 	return out, nil
 }
 
+func (c *msgServiceClient) CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error) {
+	out := new(CallContractResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.evm.v1alpha1.MsgService/CallContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DeployContract(ctx context.Context, in *DeployContractRequest, opts ...grpc.CallOption) (*DeployContractResponse, error) {
+	out := new(DeployContractResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.evm.v1alpha1.MsgService/DeployContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
@@ -309,6 +632,12 @@ type MsgServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	// `CallContract` defines a method for calling an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	CallContract(context.Context, *CallContractRequest) (*CallContractResponse, error)
+	// `DeployContract` defines a method for deploying an EVM contract on behalf of a Cosmos account,
+	// without an Ethereum signature.
+	DeployContract(context.Context, *DeployContractRequest) (*DeployContractResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -321,6 +650,12 @@ func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *E
 func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServiceServer) CallContract(ctx context.Context, req *CallContractRequest) (*CallContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallContract not implemented")
+}
+func (*UnimplementedMsgServiceServer) DeployContract(ctx context.Context, req *DeployContractRequest) (*DeployContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployContract not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -362,6 +697,42 @@ func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CallContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CallContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.evm.v1alpha1.MsgService/CallContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CallContract(ctx, req.(*CallContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeployContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeployContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.evm.v1alpha1.MsgService/DeployContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeployContract(ctx, req.(*DeployContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -374,6 +745,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _MsgService_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CallContract",
+			Handler:    _MsgService_CallContract_Handler,
+		},
+		{
+			MethodName: "DeployContract",
+			Handler:    _MsgService_DeployContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/evm/v1alpha1/tx.proto",
@@ -514,49 +893,251 @@ func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CallContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EthTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *CallContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CallContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ReturnData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bytecode) > 0 {
+		i -= len(m.Bytecode)
+		copy(dAtA[i:], m.Bytecode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bytecode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeployContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EthTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
 
 func (m *UpdateParamsRequest) Size() (n int) {
 	if m == nil {
@@ -582,6 +1163,98 @@ func (m *UpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *CallContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *CallContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DeployContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bytecode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *DeployContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -598,26 +1271,474 @@ func (m *EthTransactionRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -651,6 +1772,59 @@ func (m *EthTransactionRequest) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -672,7 +1846,7 @@ func (m *EthTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *CallContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -695,10 +1869,10 @@ func (m *EthTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -807,7 +1981,7 @@ func (m *EthTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeployContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,15 +2004,15 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,13 +2040,13 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -882,25 +2056,79 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytecode == nil {
+				m.Bytecode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -922,7 +2150,7 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *DeployContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -945,12 +2173,129 @@ func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -58,7 +58,8 @@ func (bc *blockchain) GetEVM(
 ) *vm.GethEVM {
 	chainCfg := bc.processor.cp.ChainConfig() // TODO: get chain config at height.
 	return vm.NewGethEVMWithPrecompiles(
-		bc.NewEVMBlockContext(header), txContext, state, chainCfg, *vmConfig, bc.processor.pp,
+		bc.NewEVMBlockContext(header), txContext, state, chainCfg, *vmConfig,
+		bc.processor.PrecompilesAt(state.GetContext()),
 	)
 }
 
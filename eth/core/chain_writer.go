@@ -38,6 +38,14 @@ type ChainWriter interface {
 	// ProcessTransaction processes the given transaction and returns the receipt after applying
 	// the state transition. This method is called for each tx in the block.
 	ProcessTransaction(context.Context, *types.Transaction) (*ExecutionResult, error)
+	// ProcessUnsignedTransaction processes the given transaction on behalf of the given sender,
+	// which has been authorized by the host chain, and returns the execution result and the logs
+	// after applying the state transition. The transaction is not included in the block.
+	ProcessUnsignedTransaction(
+		context.Context, *types.Transaction, common.Address,
+	) (*ExecutionResult, []*types.Log, error)
+	// CurrentBaseFee returns the base fee of the block that is being processed.
+	CurrentBaseFee() *big.Int
 	// Finalize is called after the last tx in the block.
 	Finalize(context.Context) error
 	// SendTx sends the given transaction to the tx pool.
@@ -106,6 +114,25 @@ func (bc *blockchain) ProcessTransaction(ctx context.Context, tx *types.Transact
 	return bc.processor.ProcessTransaction(ctx, tx)
 }
 
+// ProcessUnsignedTransaction processes the given transaction on behalf of `from` and returns the
+// execution result and the logs.
+func (bc *blockchain) ProcessUnsignedTransaction(
+	ctx context.Context, tx *types.Transaction, from common.Address,
+) (*ExecutionResult, []*types.Log, error) {
+	bc.logger.Debug("Processing unsigned transaction", "tx hash", tx.Hash().Hex(), "from", from.Hex())
+
+	// Reset the Gas and State plugins for the tx.
+	bc.gp.Reset(ctx)
+	bc.sp.Reset(ctx)
+
+	return bc.processor.ProcessUnsignedTransaction(ctx, tx, from)
+}
+
+// CurrentBaseFee returns the base fee of the block that is being processed.
+func (bc *blockchain) CurrentBaseFee() *big.Int {
+	return bc.processor.BaseFee()
+}
+
 // Finalize finalizes the current block.
 func (bc *blockchain) Finalize(ctx context.Context) error {
 	block, receipts, logs, err := bc.processor.Finalize(ctx)
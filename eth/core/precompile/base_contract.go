@@ -104,6 +104,32 @@ func (c *baseContract) PrecompileMethods() Methods {
 	return Methods{}
 }
 
+// ABIReceive implements StatefulImpl.
+func (c *baseContract) ABIReceive() *abi.Method {
+	if !c.abi.HasReceive() {
+		return nil
+	}
+	return &c.abi.Receive
+}
+
+// ABIFallback implements StatefulImpl.
+func (c *baseContract) ABIFallback() *abi.Method {
+	if !c.abi.HasFallback() {
+		return nil
+	}
+	return &c.abi.Fallback
+}
+
+// Receive implements StatefulImpl.
+func (c *baseContract) Receive() *Method {
+	return nil
+}
+
+// Fallback implements StatefulImpl.
+func (c *baseContract) Fallback() *Method {
+	return nil
+}
+
 // SetPlugin implements BaseContract.
 func (c *baseContract) SetPlugin(plugin Plugin) {
 	c.plugin = plugin
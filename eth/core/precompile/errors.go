@@ -42,6 +42,10 @@ var (
 	// input.
 	ErrInvalidInputToPrecompile = errors.New("input bytes to precompile container are invalid")
 
+	// ErrInvalidArgument is returned when an argument passed to a precompile method does not have
+	// the Go type of its ABI type.
+	ErrInvalidArgument = errors.New("invalid argument to precompile method")
+
 	// ErrWrongContainerFactory is returned when the wrong precompile container factory is used
 	// to build a precompile contract.
 	ErrWrongContainerFactory = errors.New("this precompile contract implementation is not implemented")
@@ -49,4 +53,8 @@ var (
 	// ErrNoPrecompileMethodForABIMethod is returned when no precompile method is provided for a
 	// corresponding ABI method.
 	ErrNoPrecompileMethodForABIMethod = errors.New("this ABI method does not have a corresponding precompile method")
+
+	// ErrInvalidSpecialMethodOutput is returned when a receive method returns any value or a
+	// fallback method returns anything other than a single byte slice.
+	ErrInvalidSpecialMethodOutput = errors.New("receive or fallback returned invalid output")
 )
@@ -112,7 +112,44 @@ func (sf *StatefulFactory) Build(
 		}
 	}
 
-	return NewStateful(rp, idsToMethods), nil
+	// resolve the receive and fallback functions, if the ABI declares them
+	receive, err := sf.buildSpecialMethod(sci.Receive(), sci.ABIReceive(), receiveSig)
+	if err != nil {
+		return nil, err
+	}
+	fallback, err := sf.buildSpecialMethod(sci.Fallback(), sci.ABIFallback(), fallbackSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStateful(rp, idsToMethods, receive, fallback), nil
+}
+
+// buildSpecialMethod matches the given `receive()` or `fallback()` precompile method, identified
+// by sig, to its ABI method. This function will return an error if only one of the two is
+// provided.
+func (sf *StatefulFactory) buildSpecialMethod(
+	precompileMethod *Method, abiMethod *abi.Method, sig string,
+) (*Method, error) {
+	switch {
+	case precompileMethod == nil && abiMethod == nil:
+		return nil, nil //nolint:nilnil // the contract does not have the special method.
+	case precompileMethod == nil:
+		return nil, errors.Wrap(ErrNoPrecompileMethodForABIMethod, sig)
+	case abiMethod == nil:
+		return nil, errors.Wrap(ErrMethodNotFound, sig)
+	}
+
+	if err := precompileMethod.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if precompileMethod.AbiSig != sig {
+		return nil, errors.Wrap(ErrAbiSigInvalid, precompileMethod.AbiSig)
+	}
+
+	// attach the ABI method to the precompile method for stateful container to handle
+	precompileMethod.AbiMethod = abiMethod
+	return precompileMethod, nil
 }
 
 // buildIdsToMethods builds the stateful precompile container for the given `precompileMethods`
@@ -36,6 +36,9 @@ import (
 
 var (
 	mockPrecompile, _ = solidity.MockPrecompileMetaData.GetAbi()
+	specialABI        = abi.MustUnmarshalJSON(
+		`[{"stateMutability":"payable","type":"receive"},{"stateMutability":"payable","type":"fallback"}]`,
+	)
 )
 
 var _ = Describe("Container Factories", func() {
@@ -74,6 +77,37 @@ var _ = Describe("Container Factories", func() {
 		})
 	})
 
+	Context("Stateful Container with receive and fallback", func() {
+		var scf *precompile.StatefulFactory
+
+		BeforeEach(func() {
+			scf = precompile.NewStatefulFactory()
+		})
+
+		It("should build containers with receive and fallback methods", func() {
+			pc, err := scf.Build(&specialMockStateful{mockStateful: &mockStateful{&mockBase{}}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pc.RequiredGas(nil)).To(Equal(uint64(2)))
+			Expect(pc.RequiredGas([]byte{1, 2, 3, 4})).To(Equal(uint64(3)))
+		})
+
+		It("should error on a missing precompile method for the receive function", func() {
+			_, err := scf.Build(
+				&specialMockStateful{mockStateful: &mockStateful{&mockBase{}}, noReceive: true}, nil,
+			)
+			Expect(err.Error()).To(Equal(
+				"this ABI method does not have a corresponding precompile method: receive()",
+			))
+		})
+
+		It("should error on special methods missing from the ABI", func() {
+			_, err := scf.Build(
+				&specialMockStateful{mockStateful: &mockStateful{&mockBase{}}, noABI: true}, nil,
+			)
+			Expect(err.Error()).To(Equal("precompile method not found in contract ABI: receive()"))
+		})
+	})
+
 	Context("Bad Stateful Container", func() {
 		var scf *precompile.StatefulFactory
 
@@ -165,8 +199,55 @@ func (ms *mockStateful) CustomValueDecoders() precompile.ValueDecoders {
 	return nil
 }
 
+func (ms *mockStateful) ABIReceive() *abi.Method {
+	return nil
+}
+
+func (ms *mockStateful) ABIFallback() *abi.Method {
+	return nil
+}
+
+func (ms *mockStateful) Receive() *precompile.Method {
+	return nil
+}
+
+func (ms *mockStateful) Fallback() *precompile.Method {
+	return nil
+}
+
 func (ms *mockStateful) SetPlugin(precompile.Plugin) {}
 
+type specialMockStateful struct {
+	*mockStateful
+	noReceive bool
+	noABI     bool
+}
+
+func (sms *specialMockStateful) ABIReceive() *abi.Method {
+	if sms.noABI {
+		return nil
+	}
+	return &specialABI.Receive
+}
+
+func (sms *specialMockStateful) ABIFallback() *abi.Method {
+	if sms.noABI {
+		return nil
+	}
+	return &specialABI.Fallback
+}
+
+func (sms *specialMockStateful) Receive() *precompile.Method {
+	if sms.noReceive {
+		return nil
+	}
+	return &precompile.Method{AbiSig: "receive()", Execute: getOutput, RequiredGas: 2}
+}
+
+func (sms *specialMockStateful) Fallback() *precompile.Method {
+	return &precompile.Method{AbiSig: "fallback()", Execute: getOutput, RequiredGas: 3}
+}
+
 type badMockStateful struct {
 	*mockStateful
 }
@@ -50,6 +50,17 @@ type (
 		// EVM.
 		DisableReentrancy(context.Context)
 	}
+
+	// ContextualPlugin is an OPTIONAL extension of `Plugin` for chains which keep the set of
+	// active precompiles in their state, so that it can change between blocks (e.g. by
+	// governance).
+	ContextualPlugin interface {
+		Plugin
+
+		// WithContext returns the plugin limited to the precompiles, at their addresses, which are
+		// active in the state and at the block height of the given context.
+		WithContext(context.Context) Plugin
+	}
 )
 
 type (
@@ -93,6 +104,26 @@ type (
 		// logic.
 		CustomValueDecoders() ValueDecoders
 
+		// ABIReceive should return the Go-Ethereum abi `Method` of the contract's `receive()`
+		// function, or nil if the ABI does not declare one. NOTE: this can be directly loaded from
+		// the `Receive` field of a Go-Ethereum ABI struct.
+		ABIReceive() *abi.Method
+
+		// ABIFallback should return the Go-Ethereum abi `Method` of the contract's `fallback()`
+		// function, or nil if the ABI does not declare one. NOTE: this can be directly loaded from
+		// the `Fallback` field of a Go-Ethereum ABI struct.
+		ABIFallback() *abi.Method
+
+		// Receive should return the precompile method executed for calls with empty calldata,
+		// such as plain value transfers, or nil if the precompile has no `receive()` function.
+		Receive() *Method
+
+		// Fallback should return the precompile method executed for calls which do not match any
+		// ABI method, or nil if the precompile has no `fallback()` function. The fallback is
+		// executed with the raw calldata as its only argument and may return raw return data as
+		// its only return value.
+		Fallback() *Method
+
 		SetPlugin(Plugin)
 	}
 
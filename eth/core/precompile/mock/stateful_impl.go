@@ -43,5 +43,17 @@ func NewStatefulImpl() *StatefulImplMock {
 		CustomValueDecodersFunc: func() precompile.ValueDecoders {
 			return nil
 		},
+		ABIReceiveFunc: func() *abi.Method {
+			return nil
+		},
+		ABIFallbackFunc: func() *abi.Method {
+			return nil
+		},
+		ReceiveFunc: func() *precompile.Method {
+			return nil
+		},
+		FallbackFunc: func() *precompile.Method {
+			return nil
+		},
 	}
 }
@@ -19,15 +19,27 @@ import (
 //			ABIEventsFunc: func() map[string]abi.Event {
 //				panic("mock out the ABIEvents method")
 //			},
+//			ABIFallbackFunc: func() *abi.Method {
+//				panic("mock out the ABIFallback method")
+//			},
 //			ABIMethodsFunc: func() map[string]abi.Method {
 //				panic("mock out the ABIMethods method")
 //			},
+//			ABIReceiveFunc: func() *abi.Method {
+//				panic("mock out the ABIReceive method")
+//			},
 //			CustomValueDecodersFunc: func() precompile.ValueDecoders {
 //				panic("mock out the CustomValueDecoders method")
 //			},
+//			FallbackFunc: func() *precompile.Method {
+//				panic("mock out the Fallback method")
+//			},
 //			PrecompileMethodsFunc: func() precompile.Methods {
 //				panic("mock out the PrecompileMethods method")
 //			},
+//			ReceiveFunc: func() *precompile.Method {
+//				panic("mock out the Receive method")
+//			},
 //			RegistryKeyFunc: func() common.Address {
 //				panic("mock out the RegistryKey method")
 //			},
@@ -44,15 +56,27 @@ type StatefulImplMock struct {
 	// ABIEventsFunc mocks the ABIEvents method.
 	ABIEventsFunc func() map[string]abi.Event
 
+	// ABIFallbackFunc mocks the ABIFallback method.
+	ABIFallbackFunc func() *abi.Method
+
 	// ABIMethodsFunc mocks the ABIMethods method.
 	ABIMethodsFunc func() map[string]abi.Method
 
+	// ABIReceiveFunc mocks the ABIReceive method.
+	ABIReceiveFunc func() *abi.Method
+
 	// CustomValueDecodersFunc mocks the CustomValueDecoders method.
 	CustomValueDecodersFunc func() precompile.ValueDecoders
 
+	// FallbackFunc mocks the Fallback method.
+	FallbackFunc func() *precompile.Method
+
 	// PrecompileMethodsFunc mocks the PrecompileMethods method.
 	PrecompileMethodsFunc func() precompile.Methods
 
+	// ReceiveFunc mocks the Receive method.
+	ReceiveFunc func() *precompile.Method
+
 	// RegistryKeyFunc mocks the RegistryKey method.
 	RegistryKeyFunc func() common.Address
 
@@ -64,15 +88,27 @@ type StatefulImplMock struct {
 		// ABIEvents holds details about calls to the ABIEvents method.
 		ABIEvents []struct {
 		}
+		// ABIFallback holds details about calls to the ABIFallback method.
+		ABIFallback []struct {
+		}
 		// ABIMethods holds details about calls to the ABIMethods method.
 		ABIMethods []struct {
 		}
+		// ABIReceive holds details about calls to the ABIReceive method.
+		ABIReceive []struct {
+		}
 		// CustomValueDecoders holds details about calls to the CustomValueDecoders method.
 		CustomValueDecoders []struct {
 		}
+		// Fallback holds details about calls to the Fallback method.
+		Fallback []struct {
+		}
 		// PrecompileMethods holds details about calls to the PrecompileMethods method.
 		PrecompileMethods []struct {
 		}
+		// Receive holds details about calls to the Receive method.
+		Receive []struct {
+		}
 		// RegistryKey holds details about calls to the RegistryKey method.
 		RegistryKey []struct {
 		}
@@ -83,9 +119,13 @@ type StatefulImplMock struct {
 		}
 	}
 	lockABIEvents           sync.RWMutex
+	lockABIFallback         sync.RWMutex
 	lockABIMethods          sync.RWMutex
+	lockABIReceive          sync.RWMutex
 	lockCustomValueDecoders sync.RWMutex
+	lockFallback            sync.RWMutex
 	lockPrecompileMethods   sync.RWMutex
+	lockReceive             sync.RWMutex
 	lockRegistryKey         sync.RWMutex
 	lockSetPlugin           sync.RWMutex
 }
@@ -117,6 +157,33 @@ func (mock *StatefulImplMock) ABIEventsCalls() []struct {
 	return calls
 }
 
+// ABIFallback calls ABIFallbackFunc.
+func (mock *StatefulImplMock) ABIFallback() *abi.Method {
+	if mock.ABIFallbackFunc == nil {
+		panic("StatefulImplMock.ABIFallbackFunc: method is nil but StatefulImpl.ABIFallback was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockABIFallback.Lock()
+	mock.calls.ABIFallback = append(mock.calls.ABIFallback, callInfo)
+	mock.lockABIFallback.Unlock()
+	return mock.ABIFallbackFunc()
+}
+
+// ABIFallbackCalls gets all the calls that were made to ABIFallback.
+// Check the length with:
+//
+//	len(mockedStatefulImpl.ABIFallbackCalls())
+func (mock *StatefulImplMock) ABIFallbackCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockABIFallback.RLock()
+	calls = mock.calls.ABIFallback
+	mock.lockABIFallback.RUnlock()
+	return calls
+}
+
 // ABIMethods calls ABIMethodsFunc.
 func (mock *StatefulImplMock) ABIMethods() map[string]abi.Method {
 	if mock.ABIMethodsFunc == nil {
@@ -144,6 +211,33 @@ func (mock *StatefulImplMock) ABIMethodsCalls() []struct {
 	return calls
 }
 
+// ABIReceive calls ABIReceiveFunc.
+func (mock *StatefulImplMock) ABIReceive() *abi.Method {
+	if mock.ABIReceiveFunc == nil {
+		panic("StatefulImplMock.ABIReceiveFunc: method is nil but StatefulImpl.ABIReceive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockABIReceive.Lock()
+	mock.calls.ABIReceive = append(mock.calls.ABIReceive, callInfo)
+	mock.lockABIReceive.Unlock()
+	return mock.ABIReceiveFunc()
+}
+
+// ABIReceiveCalls gets all the calls that were made to ABIReceive.
+// Check the length with:
+//
+//	len(mockedStatefulImpl.ABIReceiveCalls())
+func (mock *StatefulImplMock) ABIReceiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockABIReceive.RLock()
+	calls = mock.calls.ABIReceive
+	mock.lockABIReceive.RUnlock()
+	return calls
+}
+
 // CustomValueDecoders calls CustomValueDecodersFunc.
 func (mock *StatefulImplMock) CustomValueDecoders() precompile.ValueDecoders {
 	if mock.CustomValueDecodersFunc == nil {
@@ -171,6 +265,33 @@ func (mock *StatefulImplMock) CustomValueDecodersCalls() []struct {
 	return calls
 }
 
+// Fallback calls FallbackFunc.
+func (mock *StatefulImplMock) Fallback() *precompile.Method {
+	if mock.FallbackFunc == nil {
+		panic("StatefulImplMock.FallbackFunc: method is nil but StatefulImpl.Fallback was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockFallback.Lock()
+	mock.calls.Fallback = append(mock.calls.Fallback, callInfo)
+	mock.lockFallback.Unlock()
+	return mock.FallbackFunc()
+}
+
+// FallbackCalls gets all the calls that were made to Fallback.
+// Check the length with:
+//
+//	len(mockedStatefulImpl.FallbackCalls())
+func (mock *StatefulImplMock) FallbackCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockFallback.RLock()
+	calls = mock.calls.Fallback
+	mock.lockFallback.RUnlock()
+	return calls
+}
+
 // PrecompileMethods calls PrecompileMethodsFunc.
 func (mock *StatefulImplMock) PrecompileMethods() precompile.Methods {
 	if mock.PrecompileMethodsFunc == nil {
@@ -198,6 +319,33 @@ func (mock *StatefulImplMock) PrecompileMethodsCalls() []struct {
 	return calls
 }
 
+// Receive calls ReceiveFunc.
+func (mock *StatefulImplMock) Receive() *precompile.Method {
+	if mock.ReceiveFunc == nil {
+		panic("StatefulImplMock.ReceiveFunc: method is nil but StatefulImpl.Receive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReceive.Lock()
+	mock.calls.Receive = append(mock.calls.Receive, callInfo)
+	mock.lockReceive.Unlock()
+	return mock.ReceiveFunc()
+}
+
+// ReceiveCalls gets all the calls that were made to Receive.
+// Check the length with:
+//
+//	len(mockedStatefulImpl.ReceiveCalls())
+func (mock *StatefulImplMock) ReceiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReceive.RLock()
+	calls = mock.calls.Receive
+	mock.lockReceive.RUnlock()
+	return calls
+}
+
 // RegistryKey calls RegistryKeyFunc.
 func (mock *StatefulImplMock) RegistryKey() common.Address {
 	if mock.RegistryKeyFunc == nil {
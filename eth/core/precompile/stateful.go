@@ -34,6 +34,13 @@ import (
 // NumBytesMethodID is the number of bytes used to represent a ABI method's ID.
 const NumBytesMethodID = 4
 
+const (
+	// receiveSig is the `AbiSig` of a precompile's `receive()` method.
+	receiveSig = "receive()"
+	// fallbackSig is the `AbiSig` of a precompile's `fallback()` method.
+	fallbackSig = "fallback()"
+)
+
 // stateful is a container for running stateful and dynamic precompiled contracts.
 type stateful struct {
 	// Registrable is the base precompile implementation.
@@ -43,18 +50,22 @@ type stateful struct {
 	// precompile creator and must exactly match the signature in the geth abi.Method.Sig field
 	// (geth abi format). Please check core/precompile/container/method.go for more information.
 	idsToMethods map[string]*Method
-	// receive      *Method // TODO: implement
-	// fallback     *Method // TODO: implement
-
+	// receive is executed for calls with empty calldata, if not nil.
+	receive *Method
+	// fallback is executed for calls which do not match any method, if not nil.
+	fallback *Method
 }
 
-// NewStateful creates and returns a new `stateful` with the given method ids precompile functions map.
+// NewStateful creates and returns a new `stateful` with the given method ids precompile functions
+// map and the optional receive and fallback methods.
 func NewStateful(
-	rp Registrable, idsToMethods map[string]*Method,
+	rp Registrable, idsToMethods map[string]*Method, receive, fallback *Method,
 ) vm.PrecompileContainer {
 	return &stateful{
 		Registrable:  rp,
 		idsToMethods: idsToMethods,
+		receive:      receive,
+		fallback:     fallback,
 	}
 }
 
@@ -70,17 +81,14 @@ func (sc *stateful) Run(
 	value *big.Int,
 	readonly bool,
 ) ([]byte, error) {
-	if sc.idsToMethods == nil {
-		return nil, ErrContainerHasNoMethods
-	}
-	if len(input) < NumBytesMethodID {
-		return nil, ErrInvalidInputToPrecompile
+	method, err := sc.methodFor(input)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract the method ID from the input and load the method.
-	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
-	if !found {
-		return nil, ErrMethodNotFound
+	// Calls without a matching method are handled by the receive or fallback functions.
+	if method == sc.receive || method == sc.fallback {
+		return sc.runSpecial(ctx, evm, method, input, caller, value, readonly)
 	}
 
 	// Unpack the args from the input, if any exist.
@@ -117,19 +125,75 @@ func (sc *stateful) Run(
 	return ret, nil
 }
 
+// runSpecial executes the receive or fallback method. The fallback method is given the raw
+// calldata and its return value, if any, is returned as raw return data.
+func (sc *stateful) runSpecial(
+	ctx context.Context,
+	evm EVM,
+	method *Method,
+	input []byte,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+) ([]byte, error) {
+	var args []any
+	if method == sc.fallback {
+		args = []any{input}
+	}
+
+	vals, err := method.Execute(ctx, evm, caller, value, readonly, args...)
+	if err != nil {
+		return nil, errors.Wrapf(
+			vm.ErrExecutionReverted,
+			"vm error [%v] occurred during precompile execution of [%s]",
+			err, debug.GetFnName(method.Execute),
+		)
+	}
+
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	ret, ok := utils.GetAs[[]byte](vals[0])
+	if len(vals) > 1 || !ok || method != sc.fallback {
+		return nil, ErrInvalidSpecialMethodOutput
+	}
+	return ret, nil
+}
+
+// methodFor returns the method to execute for the given input. Empty input is handled by the
+// receive method, if present, and otherwise by the fallback method, which also handles input that
+// does not match any method.
+func (sc *stateful) methodFor(input []byte) (*Method, error) {
+	if len(input) == 0 && sc.receive != nil {
+		return sc.receive, nil
+	}
+
+	if len(input) >= NumBytesMethodID && sc.idsToMethods != nil {
+		// Extract the method ID from the input and load the method.
+		if method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]; found {
+			return method, nil
+		}
+	}
+
+	switch {
+	case sc.fallback != nil:
+		return sc.fallback, nil
+	case sc.idsToMethods == nil && sc.receive == nil:
+		return nil, ErrContainerHasNoMethods
+	case len(input) < NumBytesMethodID:
+		return nil, ErrInvalidInputToPrecompile
+	default:
+		return nil, ErrMethodNotFound
+	}
+}
+
 // RequiredGas checks the Method corresponding to input for the required gas amount.
 //
 // RequiredGas implements PrecompileContainer.
 func (sc *stateful) RequiredGas(input []byte) uint64 {
-	if sc.idsToMethods == nil || len(input) < NumBytesMethodID {
-		return 0
-	}
-
-	// Extract the method ID from the input and load the method.
-	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
-	if !found {
+	method, err := sc.methodFor(input)
+	if err != nil {
 		return 0
 	}
-
 	return method.RequiredGas
 }
@@ -39,6 +39,7 @@ import (
 var _ = Describe("Stateful Container", func() {
 	var sc vm.PrecompileContainer
 	var empty vm.PrecompileContainer
+	var special vm.PrecompileContainer
 	var ctx context.Context
 	var addr common.Address
 	var readonly bool
@@ -48,8 +49,11 @@ var _ = Describe("Stateful Container", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		sc = precompile.NewStateful(&mockStateful{&mockBase{}}, mockIdsToMethods)
-		empty = precompile.NewStateful(nil, nil)
+		sc = precompile.NewStateful(&mockStateful{&mockBase{}}, mockIdsToMethods, nil, nil)
+		empty = precompile.NewStateful(nil, nil, nil, nil)
+		special = precompile.NewStateful(
+			&mockStateful{&mockBase{}}, mockIdsToMethods, mockReceive, mockFallback,
+		)
 	})
 
 	Describe("Test Required Gas", func() {
@@ -70,6 +74,13 @@ var _ = Describe("Stateful Container", func() {
 			Expect(sc.RequiredGas(contractFuncAddrABI.ID)).To(Equal(uint64(100)))
 			Expect(sc.RequiredGas(contractFuncStrABI.ID)).To(Equal(uint64(1000)))
 		})
+
+		It("should return the required gas of the receive and fallback methods", func() {
+			Expect(special.RequiredGas(blank)).To(Equal(uint64(2)))
+			Expect(special.RequiredGas(badInput)).To(Equal(uint64(3)))
+			Expect(special.RequiredGas([]byte{1})).To(Equal(uint64(3)))
+			Expect(special.RequiredGas(getOutputABI.ID)).To(Equal(uint64(1)))
+		})
 	})
 
 	Describe("Test Run", func() {
@@ -124,6 +135,45 @@ var _ = Describe("Stateful Container", func() {
 			Expect(reflect.ValueOf(outputs[0]).Index(0).FieldByName("TimeStamp").
 				Interface().(string)).To(Equal("string"))
 		})
+
+		It("should run the receive method for empty input", func() {
+			ret, err := special.Run(ctx, nil, blank, addr, big.NewInt(1), readonly)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeNil())
+		})
+
+		It("should run the fallback method for unknown input", func() {
+			ret, err := special.Run(ctx, nil, badInput, addr, value, readonly)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal(badInput))
+
+			// the fallback method handles empty input when there is no receive method
+			fallbackOnly := precompile.NewStateful(nil, nil, nil, mockFallback)
+			ret, err = fallbackOnly.Run(ctx, nil, blank, addr, value, readonly)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+		})
+
+		It("should still run matching methods", func() {
+			inputs, err := getOutputABI.Inputs.Pack("string")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = special.Run(ctx, nil, append(getOutputABI.ID, inputs...), addr, value, readonly)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should error on invalid receive output", func() {
+			badReceive := &precompile.Method{
+				AbiSig: "receive()",
+				Execute: func(
+					context.Context, precompile.EVM, common.Address, *big.Int, bool, ...any,
+				) ([]any, error) {
+					return []any{[]byte{1}}, nil
+				},
+			}
+			pc := precompile.NewStateful(nil, nil, badReceive, nil)
+			_, err := pc.Run(ctx, nil, blank, addr, value, readonly)
+			Expect(err).To(MatchError(precompile.ErrInvalidSpecialMethodOutput))
+		})
 	})
 })
 
@@ -163,6 +213,11 @@ var (
 	}
 )
 
+var (
+	mockReceive  = &precompile.Method{AbiSig: "receive()", Execute: receive, RequiredGas: 2}
+	mockFallback = &precompile.Method{AbiSig: "fallback()", Execute: fallback, RequiredGas: 3}
+)
+
 type mockObject struct {
 	CreationHeight *big.Int
 	TimeStamp      string
@@ -231,3 +286,33 @@ func contractFuncStrInput(
 	ans := big.NewInt(int64(len(addr)))
 	return []any{ans}, nil
 }
+
+func receive(
+	ctx context.Context,
+	evm precompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	if value.Sign() <= 0 {
+		return nil, errors.New("no value received")
+	}
+	return nil, nil
+}
+
+// fallback echoes the raw calldata.
+func fallback(
+	ctx context.Context,
+	evm precompile.EVM,
+	caller common.Address,
+	value *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	input, ok := utils.GetAs[[]byte](args[0])
+	if !ok {
+		return nil, errors.New("cast error")
+	}
+	return []any{input}, nil
+}
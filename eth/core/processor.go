@@ -23,10 +23,12 @@ package core
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/trie"
 
+	"pkg.furychain.dev/gridiron/eth/common"
 	"pkg.furychain.dev/gridiron/eth/core/precompile"
 	"pkg.furychain.dev/gridiron/eth/core/types"
 	"pkg.furychain.dev/gridiron/eth/core/vm"
@@ -126,10 +128,12 @@ func (sp *StateProcessor) Prepare(ctx context.Context, evm *vm.GethEVM, header *
 
 	// Setup the EVM for this block.
 	rules := chainConfig.Rules(sp.header.Number, true, sp.header.Time)
-	// We re-register the default geth precompiles every block, this isn't optimal, but since
-	// *technically* the precompiles change based on the chain config rules, to be fully correct,
-	// we should check every block.
+	// We re-register the default geth and the plugin precompiles every block, this isn't optimal,
+	// but since *technically* the precompiles change based on the chain config rules, to be fully
+	// correct, we should check every block. Which of the registered precompiles are active, and
+	// at which address, is resolved by the EVM's precompile plugin for the block's state.
 	sp.BuildAndRegisterPrecompiles(precompile.GetDefaultPrecompiles(&rules))
+	sp.BuildAndRegisterPrecompiles(sp.pp.GetPrecompiles(&rules))
 	sp.vmConfig.ExtraEips = sp.cp.ExtraEips()
 	sp.evm = evm
 }
@@ -138,12 +142,65 @@ func (sp *StateProcessor) Prepare(ctx context.Context, evm *vm.GethEVM, header *
 func (sp *StateProcessor) ProcessTransaction(
 	ctx context.Context, tx *types.Transaction,
 ) (*ExecutionResult, error) {
-	txHash := tx.Hash()
 	msg, err := TransactionToMessage(tx, sp.signer, sp.header.BaseFee)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not apply tx %d [%s]", len(sp.txs), txHash.Hex())
+		return nil, errors.Wrapf(err, "could not apply tx %d [%s]", len(sp.txs), tx.Hash().Hex())
+	}
+
+	result, receipt, err := sp.applyMessage(tx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the block information.
+	sp.txs = append(sp.txs, tx)
+	sp.receipts = append(sp.receipts, receipt)
+
+	// Return the execution result to the caller.
+	return result, nil
+}
+
+// ProcessUnsignedTransaction applies a transaction that was authorized by the host chain, rather
+// than by an Ethereum signature, to the current state of the blockchain. The transaction is
+// executed on behalf of `from` with a zero gas price, since the fees for it are paid on the host
+// chain. As its sender cannot be recovered from it, the transaction is not included in the block,
+// and neither is its receipt. Its logs are returned to the caller instead.
+func (sp *StateProcessor) ProcessUnsignedTransaction(
+	_ context.Context, tx *types.Transaction, from common.Address,
+) (*ExecutionResult, []*types.Log, error) {
+	msg := &Message{
+		To:         tx.To(),
+		From:       from,
+		Nonce:      tx.Nonce(),
+		Value:      tx.Value(),
+		GasLimit:   tx.Gas(),
+		GasPrice:   new(big.Int),
+		GasFeeCap:  new(big.Int),
+		GasTipCap:  new(big.Int),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
 	}
 
+	// The base fee check must be skipped for zero priced messages, in the same way as it is for
+	// `eth_call`. We restore the previous value once the message has been applied.
+	noBaseFee := sp.evm.Config.NoBaseFee
+	sp.evm.Config.NoBaseFee = true
+	defer func() { sp.evm.Config.NoBaseFee = noBaseFee }()
+
+	result, receipt, err := sp.applyMessage(tx, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, receipt.Logs, nil
+}
+
+// applyMessage applies the given message, which was extracted from the given transaction, to the
+// current state of the blockchain and returns its execution result and receipt.
+func (sp *StateProcessor) applyMessage(
+	tx *types.Transaction, msg *Message,
+) (*ExecutionResult, *types.Receipt, error) {
+	txHash := tx.Hash()
+
 	// Create a new context to be used in the EVM environment and tx context for the StateDB.
 	txContext := NewEVMTxContext(msg)
 	sp.evm.Reset(txContext, sp.statedb)
@@ -158,7 +215,7 @@ func (sp *StateProcessor) ProcessTransaction(
 	// Apply the state transition.
 	result, err := ApplyMessage(sp.evm, msg, &gasPool)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not apply message %d [%s]", len(sp.txs), txHash.Hex())
+		return nil, nil, errors.Wrapf(err, "could not apply message %d [%s]", len(sp.txs), txHash.Hex())
 	}
 
 	// If we used more gas than we had remaining on the gas plugin, we treat it as an out of gas error,
@@ -171,7 +228,7 @@ func (sp *StateProcessor) ProcessTransaction(
 	// Consume the gas used by the state transition. In both the out of block gas as well as out of gas on
 	// the plugin cases, the line below will consume the remaining gas for the block and transaction respectively.
 	if err = sp.gp.ConsumeGas(result.UsedGas); err != nil {
-		return nil, errors.Wrapf(err, "could not consume gas used %d [%s]", len(sp.txs), txHash.Hex())
+		return nil, nil, errors.Wrapf(err, "could not consume gas used %d [%s]", len(sp.txs), txHash.Hex())
 	}
 
 	// Create a new receipt for the transaction.
@@ -202,12 +259,16 @@ func (sp *StateProcessor) ProcessTransaction(
 	// in the finalized state.
 	sp.statedb.Finalize()
 
-	// Update the block information.
-	sp.txs = append(sp.txs, tx)
-	sp.receipts = append(sp.receipts, receipt)
+	return result, receipt, nil
+}
 
-	// Return the execution result to the caller.
-	return result, nil
+// BaseFee returns the base fee of the block that is being processed, or nil if no block is being
+// processed.
+func (sp *StateProcessor) BaseFee() *big.Int {
+	if sp.header == nil {
+		return nil
+	}
+	return sp.header.BaseFee
 }
 
 // Finalize finalizes the block in the state processor and returns the receipts and bloom filter.
@@ -254,6 +315,16 @@ func (sp *StateProcessor) Finalize(
 // Utilities
 // ===========================================================================
 
+// PrecompilesAt returns the precompile plugin limited to the precompiles active in the state and
+// at the block height of the given context, if the plugin keeps the active precompiles in state.
+// Otherwise, the plugin itself is returned.
+func (sp *StateProcessor) PrecompilesAt(ctx context.Context) PrecompilePlugin {
+	if cpp, ok := utils.GetAs[precompile.ContextualPlugin](sp.pp); ok {
+		return cpp.WithContext(ctx)
+	}
+	return sp.pp
+}
+
 // BuildPrecompiles builds the given precompiles and registers them with the precompile plugins.
 func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.Registrable) {
 	for _, pc := range precompiles {
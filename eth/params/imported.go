@@ -29,6 +29,11 @@ type (
 	Rules = params.Rules
 )
 
+const (
+	// TxGas is the intrinsic gas of a transaction that does not create a contract.
+	TxGas = params.TxGas
+)
+
 var (
 	// BloomBitsBlocks is the number of blocks a single bloom bit section vector contains on the server side.
 	BloomBitsBlocks = params.BloomBitsBlocks
@@ -38,4 +43,8 @@ var (
 	VersionWithCommit = params.VersionWithCommit
 	// InitialBaseFee is the initial base fee for the first block of the chain.
 	InitialBaseFee = params.InitialBaseFee
+	// DefaultBaseFeeChangeDenominator bounds the amount the base fee can change between blocks.
+	DefaultBaseFeeChangeDenominator = params.DefaultBaseFeeChangeDenominator
+	// DefaultElasticityMultiplier bounds the maximum gas limit an EIP-1559 block may have.
+	DefaultElasticityMultiplier = params.DefaultElasticityMultiplier
 )
@@ -29,8 +29,12 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/magefile/mage/mg"
+
+	"pkg.furychain.dev/gridiron/magefiles/precompilegen"
 )
 
 var (
@@ -77,6 +81,26 @@ func (Contracts) Clean() error {
 	return forgeWrapper(forgeClean)
 }
 
+// Generates a typed stateful precompile skeleton of type typ from the forge ABI output of a
+// Solidity interface, e.g. `mage contracts:generatePrecompile Staking.sol IStakingModule
+// StakingModule staking ./cosmos/precompile/staking/staking.gen.go`. Requires
+// `mage contracts:build` first.
+func (Contracts) GeneratePrecompile(file, iface, typ, pkg, out string) error {
+	LogGreen("Generating precompile skeleton for " + iface + "...")
+	code, err := precompilegen.Generate(precompilegen.Config{
+		ABIPath: filepath.Join("contracts", "out", file, iface+".abi.json"),
+		Package: pkg,
+		Type:    typ,
+	})
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(out, code, 0o644)
+}
+
 // ===========================================================================
 // Test
 // ===========================================================================
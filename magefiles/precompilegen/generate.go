@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Furychain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Package precompilegen generates the typed Go skeleton of a stateful precompile from the ABI of
+// its Solidity interface. The generated code declares an interface with one strongly typed Go
+// method per ABI function, the `PrecompileMethods` wiring which decodes the ABI arguments and
+// encodes the return values of each method, and an emitter for every ABI event.
+package precompilegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Config configures the generation of a precompile skeleton.
+type Config struct {
+	// ABIPath is the path of the ABI JSON file of the Solidity interface, e.g.
+	// `contracts/out/Staking.sol/IStakingModule.abi.json`.
+	ABIPath string
+	// Package is the Go package name of the generated file.
+	Package string
+	// Type is the name prefix of the generated declarations, e.g. `StakingModule`.
+	Type string
+}
+
+// reserved are the names of the parameters and variables of the generated functions, which ABI
+// argument names may not shadow.
+var reserved = map[string]bool{
+	"ctx": true, "evm": true, "caller": true, "value": true, "readonly": true, "args": true,
+	"impl": true, "ok": true, "err": true, "input": true, "address": true, "event": true,
+	"topics": true, "indexed": true, "topic": true, "data": true, "abi": true, "common": true,
+	"big": true, "context": true, "utils": true, "errors": true, "ethprecompile": true, "coretypes": true,
+}
+
+// implMethods are the lower cased names of the methods of `ethprecompile.StatefulImpl` and of the
+// receive and fallback methods of the generated interface, which ABI function names may not
+// collide with.
+var implMethods = map[string]bool{
+	"registrykey": true, "abimethods": true, "precompilemethods": true, "abievents": true,
+	"customvaluedecoders": true, "abireceive": true, "abifallback": true, "receive": true,
+	"fallback": true, "setplugin": true, "onreceive": true, "onfallback": true,
+}
+
+type argument struct {
+	Name    string
+	Type    string
+	Indexed bool
+}
+
+type method struct {
+	Name    string
+	Sig     string
+	Doc     string
+	Inputs  []argument
+	Outputs []argument
+}
+
+type event struct {
+	Name      string
+	RawName   string
+	Doc       string
+	Anonymous bool
+	Inputs    []argument
+}
+
+type data struct {
+	Package     string
+	Type        string
+	ABI         string
+	Methods     []method
+	Events      []event
+	HasReceive  bool
+	HasFallback bool
+}
+
+// NeedsUtils returns true if any method has arguments to decode.
+func (d data) NeedsUtils() bool {
+	for _, m := range d.Methods {
+		if len(m.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsContext returns true if any precompile method is generated.
+func (d data) NeedsContext() bool {
+	return len(d.Methods) > 0 || d.HasReceive || d.HasFallback
+}
+
+// NeedsBig returns true if the generated code uses `math/big`.
+func (d data) NeedsBig() bool {
+	if d.NeedsContext() {
+		return true
+	}
+	for _, e := range d.Events {
+		for _, in := range e.Inputs {
+			if strings.Contains(in.Type, "big.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Generate returns the formatted Go source of the precompile skeleton for the given config.
+func Generate(cfg Config) ([]byte, error) {
+	bz, err := os.ReadFile(cfg.ABIPath)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateFromABI(cfg.Package, cfg.Type, string(bz))
+}
+
+// GenerateFromABI returns the formatted Go source of the precompile skeleton for the given ABI
+// JSON.
+func GenerateFromABI(pkg, typ, abiJSON string) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	d := data{
+		Package:     pkg,
+		Type:        typ,
+		ABI:         strings.TrimSpace(abiJSON),
+		HasReceive:  parsed.HasReceive(),
+		HasFallback: parsed.HasFallback(),
+	}
+	for _, name := range sortedKeys(parsed.Methods) {
+		m := parsed.Methods[name]
+		if implMethods[strings.ToLower(m.Name)] {
+			return nil, fmt.Errorf("ABI function %s collides with a precompile method", m.Name)
+		}
+		d.Methods = append(d.Methods, method{
+			Name:    abi.ToCamelCase(m.Name),
+			Sig:     m.Sig,
+			Doc:     m.String(),
+			Inputs:  arguments(m.Inputs),
+			Outputs: arguments(m.Outputs),
+		})
+	}
+	for _, name := range sortedKeys(parsed.Events) {
+		e := parsed.Events[name]
+		d.Events = append(d.Events, event{
+			Name:      abi.ToCamelCase(e.Name),
+			RawName:   e.Name,
+			Doc:       e.String(),
+			Anonymous: e.Anonymous,
+			Inputs:    arguments(e.Inputs),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, buf.String())
+	}
+	return src, nil
+}
+
+// arguments returns the Go names and types of the given ABI arguments. The Go type of an argument
+// is the type that the ABI decoder produces for it.
+func arguments(args abi.Arguments) []argument {
+	out := make([]argument, len(args))
+	for i, arg := range args {
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		name = abi.ToCamelCase(name)
+		name = strings.ToLower(name[:1]) + name[1:]
+		if token.IsKeyword(name) || reserved[name] {
+			name += "Arg"
+		}
+		out[i] = argument{Name: name, Type: arg.Type.GetType().String(), Indexed: arg.Indexed}
+	}
+	return out
+}
+
+// sortedKeys returns the keys of m in sorted order, so that the generated code is deterministic.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Furychain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package precompilegen_test
+
+import (
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"testing"
+
+	"pkg.furychain.dev/gridiron/magefiles/precompilegen"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// stakingBinding is the abigen binding of `IStakingModule`, which holds its ABI.
+	stakingBinding = "../../contracts/bindings/cosmos/precompile/staking/i_staking_module.abigen.go"
+	// stakingGolden is the skeleton generated for `IStakingModule`.
+	stakingGolden = "testdata/staking.gen.go.golden"
+)
+
+var update = flag.Bool("update", false, "update the golden generated precompile skeleton")
+
+func TestPrecompileGen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "magefiles/precompilegen")
+}
+
+var _ = Describe("GenerateFromABI", func() {
+	It("should generate the staking precompile skeleton", func() {
+		code, err := precompilegen.GenerateFromABI("staking", "StakingModule", bindingABI(stakingBinding))
+		Expect(err).ToNot(HaveOccurred())
+
+		if *update {
+			Expect(os.WriteFile(stakingGolden, code, 0o600)).To(Succeed())
+		}
+		golden, err := os.ReadFile(stakingGolden)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(code)).To(Equal(string(golden)),
+			"the golden skeleton is out of date, run `go test ./precompilegen -update`")
+	})
+
+	It("should generate receive, fallback and anonymous events", func() {
+		code, err := precompilegen.GenerateFromABI("test", "Test", `[
+			{"type":"receive","stateMutability":"payable"},
+			{"type":"fallback","stateMutability":"nonpayable"},
+			{"type":"event","name":"Moved","anonymous":true,"inputs":[
+				{"name":"from","type":"address","indexed":true},
+				{"name":"amount","type":"uint256","indexed":false}
+			]}
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(code)).To(ContainSubstring("func TestReceive(impl TestImpl)"))
+		Expect(string(code)).To(ContainSubstring("func TestFallback(impl TestImpl)"))
+		Expect(string(code)).To(ContainSubstring("func EmitMoved("))
+		Expect(string(code)).ToNot(ContainSubstring("topics = append(topics, event.ID)"))
+	})
+
+	It("should reject ABI functions colliding with the precompile methods", func() {
+		for _, name := range []string{"registryKey", "abiMethods", "setPlugin", "onReceive"} {
+			_, err := precompilegen.GenerateFromABI("test", "Test", `[{"type":"function","name":"`+
+				name+`","inputs":[],"outputs":[],"stateMutability":"view"}]`)
+			Expect(err).To(MatchError(ContainSubstring("collides")), name)
+		}
+	})
+})
+
+// bindingABI returns the ABI held by the `MetaData` of the abigen binding at path.
+func bindingABI(path string) string {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	Expect(err).ToNot(HaveOccurred())
+
+	var abiJSON string
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		if key, isIdent := kv.Key.(*ast.Ident); isIdent && key.Name == "ABI" {
+			lit := kv.Value.(*ast.BasicLit)
+			abiJSON, err = strconv.Unquote(lit.Value)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		return true
+	})
+	Expect(abiJSON).ToNot(BeEmpty())
+	return abiJSON
+}
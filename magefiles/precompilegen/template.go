@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Furychain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package precompilegen
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// tmpl is the template of the generated precompile skeleton.
+var tmpl = template.Must(template.New("precompile").Funcs(template.FuncMap{
+	"lower": func(s string) string { return strings.ToLower(s[:1]) + s[1:] },
+	"quote": strconv.Quote,
+}).Parse(source))
+
+const source = `// Code generated by precompilegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .NeedsContext}}
+	"context"
+{{- end}}
+{{- if .NeedsBig}}
+	"math/big"
+{{- end}}
+{{- if or .NeedsContext .NeedsBig}}{{"\n"}}{{end}}
+{{- if .Events}}
+	"pkg.furychain.dev/gridiron/eth/accounts/abi"
+{{- end}}
+{{- if or .NeedsContext .Events}}
+	"pkg.furychain.dev/gridiron/eth/common"
+{{- end}}
+	ethprecompile "pkg.furychain.dev/gridiron/eth/core/precompile"
+{{- if .Events}}
+	coretypes "pkg.furychain.dev/gridiron/eth/core/types"
+{{- end}}
+{{- if .NeedsUtils}}
+	"pkg.furychain.dev/gridiron/lib/errors"
+	"pkg.furychain.dev/gridiron/lib/utils"
+{{- end}}
+)
+
+// {{.Type}}ABI is the ABI of the {{.Type}} precompile, to be passed to ` + "`ethprecompile.NewBaseContract`" + `.
+const {{.Type}}ABI = {{quote .ABI}}
+
+{{- if .Events}}
+
+// {{lower .Type}}ABI is the parsed ABI of the {{.Type}} precompile.
+var {{lower .Type}}ABI = abi.MustUnmarshalJSON({{.Type}}ABI)
+{{- end}}
+
+// {{.Type}}Impl is the interface implemented by the {{.Type}} precompile contract, with one
+// method per ABI function.
+type {{.Type}}Impl interface {
+	ethprecompile.StatefulImpl
+{{range .Methods}}
+	// {{.Name}} implements ` + "`{{.Doc}}`" + `.
+	{{.Name}}(
+		ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int, readonly bool,
+		{{- range .Inputs}} {{.Name}} {{.Type}},{{end}}
+	) ({{range .Outputs}}{{.Type}}, {{end}}error)
+{{- end}}
+{{- if .HasReceive}}
+
+	// OnReceive is executed for calls with empty calldata.
+	OnReceive(ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int, readonly bool) error
+{{- end}}
+{{- if .HasFallback}}
+
+	// OnFallback is executed for calls which do not match any ABI function.
+	OnFallback(
+		ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int, readonly bool,
+		input []byte,
+	) ([]byte, error)
+{{- end}}
+}
+
+// {{.Type}}PrecompileMethods returns the precompile methods of impl, which decode the ABI arguments
+// and encode the return values of the typed methods.
+func {{.Type}}PrecompileMethods(impl {{.Type}}Impl) ethprecompile.Methods {
+	return ethprecompile.Methods{
+{{- range .Methods}}
+		{
+			AbiSig: {{quote .Sig}},
+			Execute: func(
+				ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int,
+				readonly bool, args ...any,
+			) ([]any, error) {
+{{- range $i, $in := .Inputs}}
+				{{$in.Name}}, ok := utils.GetAs[{{$in.Type}}](args[{{$i}}])
+				if !ok {
+					return nil, errors.Wrap(ethprecompile.ErrInvalidArgument, {{quote $in.Name}})
+				}
+{{- end}}
+				{{range $i, $out := .Outputs}}ret{{$i}}, {{end}}err := impl.{{.Name}}(
+					ctx, evm, caller, value, readonly,{{range .Inputs}} {{.Name}},{{end}}
+				)
+				if err != nil {
+					return nil, err
+				}
+				return []any{ {{- range $i, $out := .Outputs}}{{if $i}}, {{end}}ret{{$i}}{{end -}} }, nil
+			},
+		},
+{{- end}}
+	}
+}
+{{- if .HasReceive}}
+
+// {{.Type}}Receive returns the precompile method executing the receive function of impl.
+func {{.Type}}Receive(impl {{.Type}}Impl) *ethprecompile.Method {
+	return &ethprecompile.Method{
+		AbiSig: "receive()",
+		Execute: func(
+			ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int,
+			readonly bool, _ ...any,
+		) ([]any, error) {
+			return nil, impl.OnReceive(ctx, evm, caller, value, readonly)
+		},
+	}
+}
+{{- end}}
+{{- if .HasFallback}}
+
+// {{.Type}}Fallback returns the precompile method executing the fallback function of impl.
+func {{.Type}}Fallback(impl {{.Type}}Impl) *ethprecompile.Method {
+	return &ethprecompile.Method{
+		AbiSig: "fallback()",
+		Execute: func(
+			ctx context.Context, evm ethprecompile.EVM, caller common.Address, value *big.Int,
+			readonly bool, args ...any,
+		) ([]any, error) {
+			input, _ := args[0].([]byte)
+			ret, err := impl.OnFallback(ctx, evm, caller, value, readonly, input)
+			if err != nil {
+				return nil, err
+			}
+			return []any{ret}, nil
+		},
+	}
+}
+{{- end}}
+{{- range .Events}}
+
+// Emit{{.Name}} adds a ` + "`{{.Doc}}`" + ` log, emitted by the precompile at
+// address, to the StateDB of evm.
+func Emit{{.Name}}(
+	evm ethprecompile.EVM, address common.Address,{{range .Inputs}} {{.Name}} {{.Type}},{{end}}
+) error {
+	event := {{lower $.Type}}ABI.Events[{{quote .RawName}}]
+	var topics []common.Hash
+{{- if not .Anonymous}}
+	topics = append(topics, event.ID)
+{{- end}}
+	indexed, err := abi.MakeTopics({{range .Inputs}}{{if .Indexed}}[]any{ {{- .Name -}} }, {{end}}{{end}})
+	if err != nil {
+		return err
+	}
+	for _, topic := range indexed {
+		topics = append(topics, topic[0])
+	}
+	data, err := event.Inputs.NonIndexed().Pack({{range .Inputs}}{{if not .Indexed}}{{.Name}}, {{end}}{{end}})
+	if err != nil {
+		return err
+	}
+	evm.GetStateDB().AddLog(&coretypes.Log{Address: address, Topics: topics, Data: data})
+	return nil
+}
+{{- end}}
+`